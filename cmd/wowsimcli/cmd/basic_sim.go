@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 
 	"github.com/spf13/cobra"
 	"github.com/wowsims/sod/sim/core"
@@ -11,6 +13,14 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+var (
+	replayIteration int32
+	replaySeed      int64
+
+	iterationsOutfile string
+	iterationsFormat  string
+)
+
 var simCmd = &cobra.Command{
 	Use:   "sim",
 	Short: "simulate items & settings",
@@ -21,6 +31,11 @@ func init() {
 	simCmd.Flags().StringVar(&infile, "infile", "input.json", "location of input file (RaidSimRequest in protojson format)")
 	simCmd.Flags().StringVar(&outfile, "outfile", "", "location of output file, defaults to stdout")
 	simCmd.Flags().BoolVar(&verbose, "verbose", false, "print information during runtime")
+	simCmd.Flags().Int32Var(&replayIteration, "replay-iteration", -1, "only run this iteration (0-indexed) of the sim, with debug logs")
+	simCmd.Flags().Int64Var(&replaySeed, "replay-seed", 0, "only run the iteration with this seed (e.g. maxSeed from the results), with debug logs")
+	simCmd.MarkFlagsMutuallyExclusive("replay-iteration", "replay-seed")
+	simCmd.Flags().StringVar(&iterationsOutfile, "iterations-outfile", "", "write the results of every unit in every iteration to this file, instead of the main output")
+	simCmd.Flags().StringVar(&iterationsFormat, "iterations-format", "", "format of the iterations outfile, csv or ndjson. Defaults to csv for .csv files and ndjson otherwise")
 	simCmd.MarkFlagRequired("infile")
 }
 
@@ -36,9 +51,25 @@ func simMain(cmd *cobra.Command, args []string) {
 		log.Fatalf("failed to load input json file: %s", err)
 	}
 
+	if input.SimOptions == nil {
+		input.SimOptions = &proto.SimOptions{}
+	}
+	if cmd.Flags().Changed("replay-iteration") {
+		input.SimOptions.Replay = &proto.ReplayOptions{IterationId: &proto.ReplayOptions_Iteration{Iteration: replayIteration}}
+	} else if cmd.Flags().Changed("replay-seed") {
+		input.SimOptions.Replay = &proto.ReplayOptions{IterationId: &proto.ReplayOptions_Seed{Seed: replaySeed}}
+	}
+	if iterationsOutfile != "" {
+		input.SimOptions.SaveIterationResults = true
+	}
+
+	// Ctrl+C stops the sim early, and still outputs the results of the iterations run so far.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
 	var output []byte
 	reporter := make(chan *proto.ProgressMetrics, 10)
-	core.RunRaidSimAsync(input, reporter)
+	core.RunRaidSimAsync(ctx, input, reporter)
 
 	var finalResult *proto.RaidSimResult
 	for v := range reporter {
@@ -51,6 +82,13 @@ func simMain(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if iterationsOutfile != "" && finalResult.ErrorResult == "" {
+		if err := writeIterationResults(iterationsOutfile, iterationsFormat, finalResult.IterationResults); err != nil {
+			log.Fatalf("failed to write iterations outfile: %s", err)
+		}
+		finalResult.IterationResults = nil
+	}
+
 	output, err = protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(finalResult)
 	if err != nil {
 		log.Fatalf("failed to marshal final results: %s", err)
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/gym"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	gymListen string
+	gymWait   time.Duration
+)
+
+var gymCmd = &cobra.Command{
+	Use:   "gym",
+	Short: "serve a reinforcement learning environment over the first player's combat",
+	Long:  "serve a reinforcement learning environment over the first player's combat, as one JSON command and response per line on stdin/stdout, or on a local socket with --listen",
+	RunE:  gymMain,
+}
+
+func init() {
+	gymCmd.Flags().StringVar(&infile, "infile", "input.json", "location of input file (RaidSimRequest in protojson format)")
+	gymCmd.Flags().StringVar(&gymListen, "listen", "", "serve connections on this address, e.g. localhost:5555, instead of stdin/stdout")
+	gymCmd.Flags().DurationVar(&gymWait, "wait", 0, "duration of the wait action, defaults to 100ms")
+	gymCmd.MarkFlagRequired("infile")
+}
+
+func gymMain(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(infile)
+	if err != nil {
+		return fmt.Errorf("failed to load input json file %q: %w", infile, err)
+	}
+	input := &proto.RaidSimRequest{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, input); err != nil {
+		return fmt.Errorf("failed to load input json file: %w", err)
+	}
+
+	newEnv := func() *gym.Env {
+		return gym.NewEnv(input, gym.Options{Wait: gymWait})
+	}
+	if gymListen != "" {
+		return gym.ListenAndServe(gymListen, newEnv)
+	}
+	return gym.Serve(newEnv(), os.Stdin, os.Stdout)
+}
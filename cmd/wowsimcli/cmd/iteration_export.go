@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Writes the per-iteration results to path, as CSV if the format is "csv" or
+// newline-delimited JSON if it's "ndjson". An empty format is inferred from the
+// file extension.
+func writeIterationResults(path string, format string, results []*proto.IterationUnitResult) error {
+	if format == "" {
+		format = "ndjson"
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = "csv"
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	switch format {
+	case "csv":
+		err = writeIterationResultsCSV(w, results)
+	case "ndjson":
+		err = writeIterationResultsNDJSON(w, results)
+	default:
+		err = fmt.Errorf("unknown iteration results format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func writeIterationResultsNDJSON(w io.Writer, results []*proto.IterationUnitResult) error {
+	for _, result := range results {
+		line, err := protojson.Marshal(result)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The CSV has a fixed set of columns for the unit's totals, followed by columns
+// for every action and aura which shows up in any row.
+func writeIterationResultsCSV(w io.Writer, results []*proto.IterationUnitResult) error {
+	header := []string{"iteration", "seed", "duration", "unit", "name", "dps", "hps", "tps", "dtps", "oom_seconds", "died"}
+	columns := make(map[string]int)
+	addColumn := func(name string) {
+		if _, ok := columns[name]; !ok {
+			columns[name] = len(header)
+			header = append(header, name)
+		}
+	}
+	for _, result := range results {
+		for _, action := range result.Actions {
+			key := actionKey(action.Id)
+			addColumn(key + " casts")
+			addColumn(key + " damage")
+			addColumn(key + " healing")
+		}
+		for _, aura := range result.Auras {
+			addColumn(actionKey(aura.Id) + " uptime")
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	formatFloat := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	for _, result := range results {
+		row := make([]string, len(header))
+		copy(row, []string{
+			strconv.Itoa(int(result.Iteration)),
+			strconv.FormatInt(result.Seed, 10),
+			formatFloat(result.DurationSeconds),
+			unitKey(result.Unit),
+			result.Name,
+			formatFloat(result.Dps),
+			formatFloat(result.Hps),
+			formatFloat(result.Tps),
+			formatFloat(result.Dtps),
+			formatFloat(result.OomSeconds),
+			strconv.FormatBool(result.Died),
+		})
+		for _, action := range result.Actions {
+			key := actionKey(action.Id)
+			row[columns[key+" casts"]] = strconv.Itoa(int(action.Casts))
+			row[columns[key+" damage"]] = formatFloat(action.Damage)
+			row[columns[key+" healing"]] = formatFloat(action.Healing)
+		}
+		for _, aura := range result.Auras {
+			row[columns[actionKey(aura.Id)+" uptime"]] = formatFloat(aura.UptimeSeconds)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func actionKey(id *proto.ActionID) string {
+	var key string
+	switch rawID := id.RawId.(type) {
+	case *proto.ActionID_SpellId:
+		key = "spell:" + strconv.Itoa(int(rawID.SpellId))
+	case *proto.ActionID_ItemId:
+		key = "item:" + strconv.Itoa(int(rawID.ItemId))
+	case *proto.ActionID_OtherId:
+		key = "other:" + rawID.OtherId.String()
+	}
+	if id.Tag != 0 {
+		key += "#" + strconv.Itoa(int(id.Tag))
+	}
+	return key
+}
+
+func unitKey(ref *proto.UnitReference) string {
+	switch ref.Type {
+	case proto.UnitReference_Player:
+		return "player:" + strconv.Itoa(int(ref.Index))
+	case proto.UnitReference_Target:
+		return "target:" + strconv.Itoa(int(ref.Index))
+	case proto.UnitReference_Pet:
+		return unitKey(ref.Owner) + "/pet:" + strconv.Itoa(int(ref.Index))
+	}
+	return "unknown"
+}
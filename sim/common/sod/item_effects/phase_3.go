@@ -347,7 +347,7 @@ func init() {
 				TickLength:    time.Second * 1,
 
 				OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
-					for _, aoeTarget := range sim.Encounter.TargetUnits {
+					for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 						tickSpell.Cast(sim, aoeTarget)
 					}
 				},
@@ -140,7 +140,7 @@ func init() {
 					Period:   time.Second * 2,
 					Priority: core.ActionPriorityDOT, // High prio
 					OnAction: func(sim *core.Simulation) {
-						for _, aoeTarget := range sim.Encounter.TargetUnits {
+						for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 							spell.Cast(sim, aoeTarget)
 						}
 					},
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"time"
+)
+
+// One-off actions scheduled by core code which never hands them out, so they
+// can be recycled as soon as they've run.
+type pooledAction struct {
+	PendingAction
+
+	run func(sim *Simulation, pa *pooledAction)
+
+	// Arguments for run.
+	callback       func(sim *Simulation)
+	resultCallback func(sim *Simulation, spell *Spell, result *SpellResult)
+	spell          *Spell
+	result         *SpellResult
+	unit           *Unit
+	metrics        *ResourceMetrics
+}
+
+type actionPool struct {
+	actions []*pooledAction
+	free    []*pooledAction
+}
+
+// Makes all actions available again, for the start of a new iteration.
+func (ap *actionPool) reset() {
+	ap.free = append(ap.free[:0], ap.actions...)
+}
+
+func (ap *actionPool) get() *pooledAction {
+	if n := len(ap.free); n > 0 {
+		pa := ap.free[n-1]
+		ap.free = ap.free[:n-1]
+		*pa = pooledAction{PendingAction: PendingAction{OnAction: pa.OnAction}}
+		return pa
+	}
+
+	pa := &pooledAction{}
+	pa.OnAction = func(sim *Simulation) {
+		pa.run(sim, pa)
+		ap.free = append(ap.free, pa)
+	}
+	ap.actions = append(ap.actions, pa)
+	return pa
+}
+
+// Schedules a pooled action, whose arguments must be set by the caller.
+func (sim *Simulation) schedulePooledAction(doAt time.Duration, run func(sim *Simulation, pa *pooledAction)) *pooledAction {
+	pa := sim.actionPool.get()
+	pa.NextActionAt = doAt
+	pa.run = run
+	sim.AddPendingAction(&pa.PendingAction)
+	return pa
+}
@@ -29,13 +29,13 @@ func ComputeStats(csr *proto.ComputeStatsRequest) *proto.ComputeStatsResult {
  * Returns stat weights and EP values, with standard deviations, for all stats.
  */
 func StatWeights(request *proto.StatWeightsRequest) *proto.StatWeightsResult {
-	result := CalcStatWeight(request, stats.Stat(request.EpReferenceStat), nil)
+	result := CalcStatWeight(context.Background(), request, stats.Stat(request.EpReferenceStat), nil)
 	return result.ToProto()
 }
 
-func StatWeightsAsync(request *proto.StatWeightsRequest, progress chan *proto.ProgressMetrics) {
+func StatWeightsAsync(ctx context.Context, request *proto.StatWeightsRequest, progress chan *proto.ProgressMetrics) {
 	go func() {
-		result := CalcStatWeight(request, stats.Stat(request.EpReferenceStat), progress)
+		result := CalcStatWeight(ctx, request, stats.Stat(request.EpReferenceStat), progress)
 		progress <- &proto.ProgressMetrics{
 			FinalWeightResult: result.ToProto(),
 		}
@@ -46,11 +46,11 @@ func StatWeightsAsync(request *proto.StatWeightsRequest, progress chan *proto.Pr
  * Runs multiple iterations of the sim with a full raid.
  */
 func RunRaidSim(request *proto.RaidSimRequest) *proto.RaidSimResult {
-	return RunSim(request, nil)
+	return RunSim(context.Background(), request, nil)
 }
 
-func RunRaidSimAsync(request *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) {
-	go RunSim(request, progress)
+func RunRaidSimAsync(ctx context.Context, request *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) {
+	go RunSim(ctx, request, progress)
 }
 
 func RunBulkSim(request *proto.BulkSimRequest) *proto.BulkSimResult {
@@ -60,3 +60,11 @@ func RunBulkSim(request *proto.BulkSimRequest) *proto.BulkSimResult {
 func RunBulkSimAsync(ctx context.Context, request *proto.BulkSimRequest, progress chan *proto.ProgressMetrics) {
 	go BulkSim(ctx, request, progress)
 }
+
+func RunGearOptimizer(request *proto.GearOptimizerRequest) *proto.GearOptimizerResult {
+	return GearOptimizer(context.Background(), request, nil)
+}
+
+func RunGearOptimizerAsync(ctx context.Context, request *proto.GearOptimizerRequest, progress chan *proto.ProgressMetrics) {
+	go GearOptimizer(ctx, request, progress)
+}
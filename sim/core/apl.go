@@ -29,6 +29,9 @@ type APLRotation struct {
 	// Used to avoid recursive APL loops.
 	inLoop bool
 
+	// Cached result of allAPLActions(), for resetting the actions.
+	allActions []*APLAction
+
 	// Validation warnings that occur during proto parsing.
 	// We return these back to the user for display in the UI.
 	curWarnings          []string
@@ -163,11 +166,14 @@ func (rot *APLRotation) allPrepullActions() []*APLAction {
 }
 
 func (rot *APLRotation) reset(sim *Simulation) {
-	rot.controllingActions = nil
+	rot.controllingActions = rot.controllingActions[:0]
 	rot.inLoop = false
 	rot.interruptChannelIf = nil
 	rot.allowChannelRecastOnInterrupt = false
-	for _, action := range rot.allAPLActions() {
+	if rot.allActions == nil {
+		rot.allActions = rot.allAPLActions()
+	}
+	for _, action := range rot.allActions {
 		action.impl.Reset(sim)
 	}
 }
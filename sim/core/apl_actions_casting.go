@@ -156,8 +156,8 @@ func (action *APLActionMultidot) IsReady(sim *Simulation) bool {
 			}
 		}
 	} else {
-		for i := int32(0); i < action.maxDots; i++ {
-			target := sim.Encounter.TargetUnits[i]
+		for i := int32(0); i < min(action.maxDots, sim.ActiveTargetCount()); i++ {
+			target := sim.Encounter.ActiveTargetUnits[i]
 			dot := action.spell.Dot(target)
 			if (!dot.IsActive() || dot.RemainingDuration(sim) < maxOverlap) && action.spell.CanCast(sim, target) {
 				action.nextTarget = target
@@ -28,13 +28,11 @@ func (rot *APLRotation) newActionChangeTarget(config *proto.APLActionChangeTarge
 	}
 }
 func (action *APLActionChangeTarget) IsReady(sim *Simulation) bool {
-	return action.unit.CurrentTarget != action.newTarget.Get()
+	newTarget := action.newTarget.Get()
+	return action.unit.CurrentTarget != newTarget && (newTarget.Type != EnemyUnit || newTarget.IsEnabled())
 }
 func (action *APLActionChangeTarget) Execute(sim *Simulation) {
-	if sim.Log != nil {
-		action.unit.Log(sim, "Changing target to %s", action.newTarget.Get().Label)
-	}
-	action.unit.CurrentTarget = action.newTarget.Get()
+	action.unit.changeTarget(sim, action.newTarget.Get())
 }
 func (action *APLActionChangeTarget) String() string {
 	return fmt.Sprintf("Change Target(%s)", action.newTarget.Get().Label)
@@ -5,10 +5,13 @@ import (
 )
 
 // Struct for handling unit references, to account for values that can
-// change dynamically (e.g. CurrentTarget).
+// change dynamically (e.g. CurrentTarget or heal targets).
 type UnitReference struct {
 	fixedUnit       *Unit
 	curTargetSource *Unit
+
+	healTargetEnv  *Environment
+	healTargetType proto.UnitReference_Type
 }
 
 func (ur UnitReference) Get() *Unit {
@@ -16,6 +19,8 @@ func (ur UnitReference) Get() *Unit {
 		return ur.fixedUnit
 	} else if ur.curTargetSource != nil {
 		return ur.curTargetSource.CurrentTarget
+	} else if ur.healTargetEnv != nil {
+		return ur.healTargetEnv.getHealTarget(ur.healTargetType)
 	} else {
 		return nil
 	}
@@ -32,6 +37,11 @@ func NewUnitReference(ref *proto.UnitReference, contextUnit *Unit) UnitReference
 		return UnitReference{
 			curTargetSource: contextUnit,
 		}
+	} else if isHealTargetType(ref.Type) {
+		return UnitReference{
+			healTargetEnv:  contextUnit.Env,
+			healTargetType: ref.Type,
+		}
 	} else {
 		return UnitReference{
 			fixedUnit: contextUnit.GetUnit(ref),
@@ -60,15 +70,15 @@ func (rot *APLRotation) GetTargetUnit(ref *proto.UnitReference) UnitReference {
 type AuraReference struct {
 	fixedAura *Aura
 
-	curTargetSource *Unit
-	curTargetAuras  AuraArray
+	dynamicSource UnitReference
+	dynamicAuras  AuraArray
 }
 
 func (ar *AuraReference) Get() *Aura {
 	if ar.fixedAura != nil {
 		return ar.fixedAura
-	} else if ar.curTargetSource != nil {
-		return ar.curTargetAuras.Get(ar.curTargetSource.CurrentTarget)
+	} else if ar.dynamicAuras != nil {
+		return ar.dynamicAuras.Get(ar.dynamicSource.Get())
 	} else {
 		return nil
 	}
@@ -91,8 +101,8 @@ func newAuraReferenceHelper(sourceUnit UnitReference, auraId *proto.ActionID, au
 			auras[unit.UnitIndex] = auraGetter(unit, ProtoToActionID(auraId))
 		}
 		return AuraReference{
-			curTargetSource: sourceUnit.curTargetSource,
-			curTargetAuras:  auras,
+			dynamicSource: sourceUnit,
+			dynamicAuras:  auras,
 		}
 	}
 }
@@ -93,6 +93,12 @@ func (rot *APLRotation) newAPLValue(config *proto.APLValue) APLValue {
 		return rot.newValueIsExecutePhase(config.GetIsExecutePhase())
 	case *proto.APLValue_NumberTargets:
 		return rot.newValueNumberTargets(config.GetNumberTargets())
+	case *proto.APLValue_TargetIsActive:
+		return rot.newValueTargetIsActive(config.GetTargetIsActive())
+	case *proto.APLValue_CurrentPhase:
+		return rot.newValueCurrentPhase(config.GetCurrentPhase())
+	case *proto.APLValue_TimeUntilNextPhase:
+		return rot.newValueTimeUntilNextPhase(config.GetTimeUntilNextPhase())
 
 	// Resources
 	case *proto.APLValue_CurrentHealth:
@@ -86,12 +86,71 @@ func (value *APLValueNumberTargets) Type() proto.APLValueType {
 	return proto.APLValueType_ValueTypeInt
 }
 func (value *APLValueNumberTargets) GetInt(sim *Simulation) int32 {
-	return sim.GetNumTargets()
+	return sim.ActiveTargetCount()
 }
 func (value *APLValueNumberTargets) String() string {
 	return "Num Targets"
 }
 
+type APLValueTargetIsActive struct {
+	DefaultAPLValueImpl
+	target UnitReference
+}
+
+func (rot *APLRotation) newValueTargetIsActive(config *proto.APLValueTargetIsActive) APLValue {
+	target := rot.GetTargetUnit(config.TargetUnit)
+	if target.Get() == nil {
+		return nil
+	}
+	return &APLValueTargetIsActive{
+		target: target,
+	}
+}
+func (value *APLValueTargetIsActive) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeBool
+}
+func (value *APLValueTargetIsActive) GetBool(sim *Simulation) bool {
+	return value.target.Get().IsEnabled()
+}
+func (value *APLValueTargetIsActive) String() string {
+	return fmt.Sprintf("Target Is Active(%s)", value.target.Get().Label)
+}
+
+type APLValueCurrentPhase struct {
+	DefaultAPLValueImpl
+}
+
+func (rot *APLRotation) newValueCurrentPhase(config *proto.APLValueCurrentPhase) APLValue {
+	return &APLValueCurrentPhase{}
+}
+func (value *APLValueCurrentPhase) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeInt
+}
+func (value *APLValueCurrentPhase) GetInt(sim *Simulation) int32 {
+	// Phases are numbered from 1 in the UI.
+	return int32(sim.Encounter.CurrentPhase()) + 1
+}
+func (value *APLValueCurrentPhase) String() string {
+	return "Current Phase"
+}
+
+type APLValueTimeUntilNextPhase struct {
+	DefaultAPLValueImpl
+}
+
+func (rot *APLRotation) newValueTimeUntilNextPhase(config *proto.APLValueTimeUntilNextPhase) APLValue {
+	return &APLValueTimeUntilNextPhase{}
+}
+func (value *APLValueTimeUntilNextPhase) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueTimeUntilNextPhase) GetDuration(sim *Simulation) time.Duration {
+	return sim.TimeUntilNextPhase()
+}
+func (value *APLValueTimeUntilNextPhase) String() string {
+	return "Time Until Next Phase"
+}
+
 type APLValueIsExecutePhase struct {
 	DefaultAPLValueImpl
 	threshold proto.APLValueIsExecutePhase_ExecutePhaseThreshold
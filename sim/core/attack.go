@@ -447,9 +447,7 @@ func (unit *Unit) EnableAutoAttacks(agent Agent, options AutoAttackOptions) {
 			baseDamage := spell.Unit.RangedWeaponDamage(sim, spell.RangedAttackPower(target))
 			result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeRangedHitAndCrit)
 
-			spell.WaitTravelTime(sim, func(sim *Simulation) {
-				spell.DealDamage(sim, result)
-			})
+			spell.DealDamageAfterTravelTime(sim, result)
 		},
 	}
 
@@ -175,7 +175,7 @@ func (aura *Aura) Refresh(sim *Simulation) {
 	}
 
 	if sim.Log != nil && aura.IsActive() && !aura.ActionID.IsEmptyAction() {
-		aura.Unit.Log(sim, "Aura refreshed: %s", aura.ActionID)
+		sim.logAura(proto.CombatEvent_TypeAuraRefreshed, aura)
 	}
 }
 
@@ -204,7 +204,7 @@ func (aura *Aura) SetStacks(sim *Simulation, newStacks int32) {
 	}
 
 	if sim.Log != nil {
-		aura.Unit.Log(sim, "%s stacks: %d --> %d", aura.ActionID, oldStacks, newStacks)
+		sim.logAuraStacks(aura, oldStacks, newStacks)
 	}
 	aura.stacks = newStacks
 	if aura.OnStacksChange != nil {
@@ -476,17 +476,24 @@ func (at *auraTracker) RegisterResetEffect(resetEffect ResetEffect) {
 }
 
 func (at *auraTracker) reset(sim *Simulation) {
-	at.activeAuras = at.activeAuras[:0]
-	at.onCastCompleteAuras = at.onCastCompleteAuras[:0]
-	at.onSpellHitDealtAuras = at.onSpellHitDealtAuras[:0]
-	at.onSpellHitTakenAuras = at.onSpellHitTakenAuras[:0]
-	at.onPeriodicDamageDealtAuras = at.onPeriodicDamageDealtAuras[:0]
-	at.onPeriodicDamageTakenAuras = at.onPeriodicDamageTakenAuras[:0]
-	at.onHealDealtAuras = at.onHealDealtAuras[:0]
-	at.onHealTakenAuras = at.onHealTakenAuras[:0]
-	at.onPeriodicHealDealtAuras = at.onPeriodicHealDealtAuras[:0]
-	at.onPeriodicHealTakenAuras = at.onPeriodicHealTakenAuras[:0]
-	at.onRageChangeAuras = at.onRageChangeAuras[:0]
+	// Sized for all registered auras, so activating auras never grows these.
+	presize := func(auras []*Aura) []*Aura {
+		if cap(auras) < len(at.auras) {
+			return make([]*Aura, 0, len(at.auras))
+		}
+		return auras[:0]
+	}
+	at.activeAuras = presize(at.activeAuras)
+	at.onCastCompleteAuras = presize(at.onCastCompleteAuras)
+	at.onSpellHitDealtAuras = presize(at.onSpellHitDealtAuras)
+	at.onSpellHitTakenAuras = presize(at.onSpellHitTakenAuras)
+	at.onPeriodicDamageDealtAuras = presize(at.onPeriodicDamageDealtAuras)
+	at.onPeriodicDamageTakenAuras = presize(at.onPeriodicDamageTakenAuras)
+	at.onHealDealtAuras = presize(at.onHealDealtAuras)
+	at.onHealTakenAuras = presize(at.onHealTakenAuras)
+	at.onPeriodicHealDealtAuras = presize(at.onPeriodicHealDealtAuras)
+	at.onPeriodicHealTakenAuras = presize(at.onPeriodicHealTakenAuras)
+	at.onRageChangeAuras = presize(at.onRageChangeAuras)
 
 	for _, resetEffect := range at.resetEffects {
 		resetEffect(sim)
@@ -529,7 +536,29 @@ restart:
 	at.minExpires = NeverExpires
 }
 
+// Like expireAll, but keeps permanent auras, e.g. raid debuffs, active.
+func (at *auraTracker) expireTemporary(sim *Simulation) {
+restart:
+	at.minExpires = NeverExpires
+	for _, aura := range at.activeAuras {
+		if aura.expires != NeverExpires {
+			aura.Deactivate(sim)
+			goto restart
+		}
+	}
+}
+
 func (at *auraTracker) doneIteration(sim *Simulation) {
+	at.endIteration(sim)
+
+	for _, aura := range at.auras {
+		aura.metrics.doneIteration()
+	}
+}
+
+// Like doneIteration, but without recording aura metrics. Used to abandon an
+// iteration, see Simulation.Restore().
+func (at *auraTracker) endIteration(sim *Simulation) {
 	// deactivate all auras, even permanent ones
 restart:
 	for _, aura := range at.auras {
@@ -542,10 +571,6 @@ restart:
 	for _, aura := range at.auras {
 		aura.doneIteration(sim)
 	}
-
-	for _, aura := range at.auras {
-		aura.metrics.doneIteration()
-	}
 }
 
 // Adds a new aura to the simulation. If an aura with the same ID already
@@ -636,7 +661,7 @@ func (aura *Aura) Activate(sim *Simulation) {
 	}
 
 	if sim.Log != nil && !aura.ActionID.IsEmptyAction() {
-		aura.Unit.Log(sim, "Aura gained: %s", aura.ActionID)
+		sim.logAura(proto.CombatEvent_TypeAuraGained, aura)
 	}
 
 	// don't invoke possible callbacks until the internal state is consistent
@@ -658,7 +683,7 @@ func (aura *Aura) Deactivate(sim *Simulation) {
 		sim.CurrentTime = min(sim.CurrentTime, aura.expires)
 		aura.metrics.Uptime += sim.CurrentTime - max(aura.startTime, 0)
 		if sim.Log != nil {
-			aura.Unit.Log(sim, "Aura faded: %s", aura.ActionID)
+			sim.logAura(proto.CombatEvent_TypeAuraFaded, aura)
 		}
 		sim.CurrentTime = oldTime
 	}
@@ -873,6 +898,23 @@ func (at *auraTracker) GetMetricsProto() []*proto.AuraMetrics {
 	return metrics
 }
 
+// Merges in the aura metrics of the same unit from another Simulation.
+func (at *auraTracker) mergeMetrics(other *auraTracker) {
+	for i, otherAura := range other.auras {
+		// Auras are normally registered in the same order, but fall back to a
+		// lookup for any which were registered on the fly.
+		var aura *Aura
+		if i < len(at.auras) && at.auras[i].Label == otherAura.Label {
+			aura = at.auras[i]
+		} else {
+			aura = at.GetAura(otherAura.Label)
+		}
+		if aura != nil {
+			aura.metrics.merge(&otherAura.metrics)
+		}
+	}
+}
+
 // Invokes the OnRageChange for all tracked auras
 func (at *auraTracker) OnRageChange(sim *Simulation, metrics *ResourceMetrics) {
 	for _, aura := range at.onRageChangeAuras {
@@ -9,6 +9,7 @@ import (
 	"runtime/debug"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -19,10 +20,13 @@ import (
 
 const (
 	defaultIterationsPerCombo = 1000
+
+	// TODO(Riotdog-GehennasEU): Make this configurable?
+	maxBulkResults = 30
 )
 
 // raidSimRunner runs a standard raid simulation.
-type raidSimRunner func(*proto.RaidSimRequest, chan *proto.ProgressMetrics, bool) *proto.RaidSimResult
+type raidSimRunner func(context.Context, *proto.RaidSimRequest, chan *proto.ProgressMetrics, bool) *proto.RaidSimResult
 
 // bulkSimRunner runs a bulk simulation.
 type bulkSimRunner struct {
@@ -30,6 +34,10 @@ type bulkSimRunner struct {
 	SingleRaidSimRunner raidSimRunner
 	// Request used for this bulk simulation.
 	Request *proto.BulkSimRequest
+
+	// Searches try combos nobody picked by hand, some of which can make the sim
+	// fail. If set, those are dropped instead of failing the whole bulk sim.
+	skipFailedSims bool
 }
 
 func BulkSim(ctx context.Context, request *proto.BulkSimRequest, progress chan *proto.ProgressMetrics) *proto.BulkSimResult {
@@ -96,6 +104,15 @@ func (b *bulkSimRunner) Run(pctx context.Context, progress chan *proto.ProgressM
 	// clean to reduce memory
 	player.Database = nil
 
+	// Sim every combo with the same seed, so that each iteration sees the same random
+	// numbers in every combo. Combos can then be compared iteration by iteration,
+	// which cancels out most of the noise.
+	simOptions := b.Request.BaseSettings.SimOptions
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+	simOptions.SaveAllValues = true
+
 	// Gemming for now can happen before slots are decided.
 	// We might have to add logic after slot decisions if we want to enforce keeping meta gem active.
 
@@ -104,6 +121,13 @@ func (b *bulkSimRunner) Run(pctx context.Context, progress chan *proto.ProgressM
 		iterations = defaultIterationsPerCombo
 	}
 
+	if search := b.Request.GetBulkSettings().GetTalentRuneSearch(); search.GetSearchTalents() || search.GetSearchRunes() {
+		return b.runTalentRuneSearch(ctx, player, int64(iterations), progress)
+	}
+	if b.Request.GetBulkSettings().GetConsumesSearch() != nil {
+		return b.runConsumesSearch(ctx, int64(iterations), progress)
+	}
+
 	items := b.Request.GetBulkSettings().GetItems()
 	// numItems := len(items)
 	// if b.Request.BulkSettings.Combinations && numItems > maxItemCount {
@@ -145,11 +169,11 @@ func (b *bulkSimRunner) Run(pctx context.Context, progress chan *proto.ProgressM
 		}
 	}
 
-	// TODO(Riotdog-GehennasEU): Make this configurable?
-	maxResults := 30
+	maxResults := maxBulkResults
 
 	var rankedResults []*itemSubstitutionSimResult
 	var baseResult *itemSubstitutionSimResult
+	var cancelled bool
 	newIters := int64(iterations)
 	if b.Request.BulkSettings.FastMode {
 		newIters /= 100
@@ -182,6 +206,12 @@ func (b *bulkSimRunner) Run(pctx context.Context, progress chan *proto.ProgressM
 			baseResult = tempBase
 		}
 
+		// Keep whatever was simmed so far.
+		if ctx.Err() != nil {
+			cancelled = true
+			break
+		}
+
 		// If we aren't doing fast mode, or if halving our results will be less than the maxResults, be done.
 		if !b.Request.BulkSettings.FastMode || len(rankedResults) <= maxResults*2 {
 			break
@@ -206,37 +236,31 @@ func (b *bulkSimRunner) Run(pctx context.Context, progress chan *proto.ProgressM
 		}
 	}
 
-	if baseResult == nil {
+	if baseResult == nil && !cancelled {
 		return nil, fmt.Errorf("no base result for equipped gear found in bulk sim")
 	}
 
+	var bestResult *itemSubstitutionSimResult
+	if len(rankedResults) > 0 {
+		bestResult = rankedResults[0]
+	}
+
 	if len(rankedResults) > maxResults {
 		rankedResults = rankedResults[:maxResults]
 	}
 
-	bum := baseResult.Result.GetRaidMetrics().GetParties()[0].GetPlayers()[0]
-	bum.Actions = nil
-	bum.Auras = nil
-	bum.Resources = nil
-	bum.Pets = nil
-
 	result = &proto.BulkSimResult{
-		EquippedGearResult: &proto.BulkComboResult{
-			UnitMetrics: bum,
-		},
+		Cancelled: cancelled,
 	}
 
-	for _, r := range rankedResults {
-		um := r.Result.GetRaidMetrics().GetParties()[0].GetPlayers()[0]
-		um.Actions = nil
-		um.Auras = nil
-		um.Resources = nil
-		um.Pets = nil
+	if baseResult != nil {
+		result.EquippedGearResult = newBulkComboResult(baseResult, baseResult, bestResult)
+	}
 
-		result.Results = append(result.Results, &proto.BulkComboResult{
-			ItemsAdded:  r.ChangeLog.AddedItems,
-			UnitMetrics: um,
-		})
+	for _, r := range rankedResults {
+		comboResult := newBulkComboResult(r, baseResult, bestResult)
+		comboResult.ItemsAdded = r.ChangeLog.AddedItems
+		result.Results = append(result.Results, comboResult)
 	}
 
 	if progress != nil {
@@ -268,31 +292,63 @@ func (b *bulkSimRunner) getRankedResults(pctx context.Context, validCombos []sin
 	var totalCompletedSims int32
 
 	ctx, cancel := context.WithCancel(pctx)
-	// reporter for all sims combined.
-	go func() {
-		for ctx.Err() == nil {
-			complIters := atomic.LoadInt32(&totalCompletedIterations)
-			complSims := atomic.LoadInt32(&totalCompletedSims)
 
-			// stop reporting
-			if complIters == int32(totalIterationsUpperBound) || numCombinations == complSims {
-				return
-			}
+	// reporter for all sims combined. It is stopped and joined before returning, so
+	// callers may close progress as soon as this function returns.
+	var reporter sync.WaitGroup
+	stopReporter := func() {
+		cancel()
+		reporter.Wait()
+	}
+	if progress != nil {
+		reporter.Add(1)
+		go func() {
+			defer reporter.Done()
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
+			for {
+				complIters := atomic.LoadInt32(&totalCompletedIterations)
+				complSims := atomic.LoadInt32(&totalCompletedSims)
+
+				// stop reporting
+				if complIters == int32(totalIterationsUpperBound) || numCombinations == complSims {
+					return
+				}
 
-			progress <- &proto.ProgressMetrics{
-				TotalSims:           numCombinations,
-				CompletedSims:       complSims,
-				CompletedIterations: complIters,
-				TotalIterations:     int32(totalIterationsUpperBound),
+				select {
+				case progress <- &proto.ProgressMetrics{
+					TotalSims:           numCombinations,
+					CompletedSims:       complSims,
+					CompletedIterations: complIters,
+					TotalIterations:     int32(totalIterationsUpperBound),
+				}:
+				case <-ctx.Done():
+					return
+				}
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return
+				}
 			}
-			time.Sleep(time.Second)
-		}
-	}()
+		}()
+	}
 
 	// launcher for all combos (limited by concurrency max)
 	go func() {
 		for _, singleCombo := range validCombos {
 			<-tickets
+			if ctx.Err() != nil {
+				// Don't start any more sims once cancelled, but still hand back the combo so it gets counted.
+				results <- &itemSubstitutionSimResult{
+					Request:      singleCombo.req,
+					Substitution: singleCombo.eq,
+					ChangeLog:    singleCombo.cl,
+				}
+				tickets <- struct{}{}
+				continue
+			}
 			singleSimProgress := make(chan *proto.ProgressMetrics)
 			// watches this progress and pushes up to main reporter.
 			go func(prog chan *proto.ProgressMetrics) {
@@ -309,10 +365,11 @@ func (b *bulkSimRunner) getRankedResults(pctx context.Context, validCombos []sin
 			// actually run the sim in here.
 			go func(sub singleBulkSim) {
 				// overwrite the requests iterations with the input for this function.
+				// With SimOptions.Convergence set, this is the maximum and each combo stops once its DPS is resolved.
 				sub.req.SimOptions.Iterations = int32(iterations)
 				results <- &itemSubstitutionSimResult{
 					Request:      sub.req,
-					Result:       b.SingleRaidSimRunner(sub.req, singleSimProgress, false),
+					Result:       b.SingleRaidSimRunner(withoutShards(ctx), sub.req, singleSimProgress, false),
 					Substitution: sub.eq,
 					ChangeLog:    sub.cl,
 				}
@@ -322,21 +379,44 @@ func (b *bulkSimRunner) getRankedResults(pctx context.Context, validCombos []sin
 		}
 	}()
 
-	rankedResults := make([]*itemSubstitutionSimResult, numCombinations)
+	rankedResults := make([]*itemSubstitutionSimResult, 0, numCombinations)
 	var baseResult *itemSubstitutionSimResult
 
-	for i := range rankedResults {
+	for i := int32(0); i < numCombinations; i++ {
 		result := <-results
-		if result.Result == nil || result.Result.ErrorResult != "" {
-			cancel() // cancel reporter
+		if result.Result == nil {
+			// Skipped because the bulk sim was cancelled.
+			continue
+		}
+		if result.Result.ErrorResult != "" && b.skipFailedSims {
+			continue
+		}
+		if result.Result.ErrorResult != "" {
+			stopReporter()
+			// Let the remaining sims, which stop early now that ctx is cancelled, hand back their results.
+			go func(remaining int32) {
+				for ; remaining > 0; remaining-- {
+					<-results
+				}
+			}(numCombinations - i - 1)
 			return nil, nil, errors.New("simulation failed: " + result.Result.ErrorResult)
 		}
 		if !result.Substitution.HasItemReplacements() {
 			baseResult = result
 		}
-		rankedResults[i] = result
+		rankedResults = append(rankedResults, result)
+
+		if progress != nil {
+			progress <- &proto.ProgressMetrics{
+				TotalSims:           numCombinations,
+				CompletedSims:       atomic.LoadInt32(&totalCompletedSims),
+				CompletedIterations: atomic.LoadInt32(&totalCompletedIterations),
+				TotalIterations:     int32(totalIterationsUpperBound),
+				BulkComboResult:     newPartialBulkComboResult(result),
+			}
+		}
 	}
-	cancel() // cancel reporter
+	stopReporter()
 
 	sort.Slice(rankedResults, func(i, j int) bool {
 		return rankedResults[i].Score() > rankedResults[j].Score()
@@ -354,12 +434,155 @@ type itemSubstitutionSimResult struct {
 	ChangeLog    *raidSimRequestChangeLog
 }
 
-// Score used to rank results.
+// Score used to rank results, see scoreMetric.
 func (r *itemSubstitutionSimResult) Score() float64 {
 	if r.Result == nil || r.Result.ErrorResult != "" {
 		return 0
 	}
-	return r.Result.RaidMetrics.Dps.Avg
+	return r.scoreMetrics().GetAvg()
+}
+
+// Returns the metric results of rsr are scored by, which depends on the role of
+// its player: HPS for healers, TPS for tanks and DPS for everyone else.
+func scoreMetric(rsr *proto.RaidSimRequest) proto.ConvergenceOptions_Metric {
+	parties := rsr.GetRaid().GetParties()
+	if len(parties) == 0 || len(parties[0].Players) == 0 {
+		return proto.ConvergenceOptions_Dps
+	}
+
+	switch player := parties[0].Players[0]; {
+	case isHealingSpec(player):
+		return proto.ConvergenceOptions_Hps
+	case isTankSpec(player) || len(rsr.Raid.Tanks) > 0:
+		return proto.ConvergenceOptions_Tps
+	}
+	return proto.ConvergenceOptions_Dps
+}
+
+func isHealingSpec(player *proto.Player) bool {
+	switch player.Spec.(type) {
+	case *proto.Player_RestorationDruid, *proto.Player_HolyPaladin, *proto.Player_HealingPriest, *proto.Player_RestorationShaman:
+		return true
+	}
+	return false
+}
+
+func isTankSpec(player *proto.Player) bool {
+	switch player.Spec.(type) {
+	case *proto.Player_FeralTankDruid, *proto.Player_ProtectionPaladin, *proto.Player_TankRogue, *proto.Player_ProtectionWarrior, *proto.Player_TankWarlock:
+		return true
+	}
+	return false
+}
+
+// Metrics of the score, nil if the result doesn't have them.
+func (r *itemSubstitutionSimResult) scoreMetrics() *proto.DistributionMetrics {
+	raidMetrics := r.Result.GetRaidMetrics()
+	switch scoreMetric(r.Request) {
+	case proto.ConvergenceOptions_Hps:
+		return raidMetrics.GetHps()
+	case proto.ConvergenceOptions_Tps:
+		// Bulk sims only have a single player.
+		if parties := raidMetrics.GetParties(); len(parties) > 0 && len(parties[0].Players) > 0 {
+			return parties[0].Players[0].Threat
+		}
+		return nil
+	}
+	return raidMetrics.GetDps()
+}
+
+// Confidence interval of the score, nil if there are no values.
+func (r *itemSubstitutionSimResult) scoreInterval() *proto.ConfidenceInterval {
+	var score aggregator
+	for _, value := range r.scoreValues() {
+		score.add(value)
+	}
+	if score.n == 0 {
+		return nil
+	}
+	return score.confidenceInterval()
+}
+
+// Per-iteration values of the score, in iteration order.
+func (r *itemSubstitutionSimResult) scoreValues() []float64 {
+	return r.scoreMetrics().GetAllValues()
+}
+
+// Compares the scores of r and other iteration by iteration. Returns the
+// confidence interval of the difference, and the p-value of the difference
+// under the hypothesis that both have the same score.
+func (r *itemSubstitutionSimResult) compareScores(other *itemSubstitutionSimResult) (*proto.ConfidenceInterval, float64) {
+	values := r.scoreValues()
+	otherValues := other.scoreValues()
+
+	// Results can have different numbers of iterations, e.g. when the equipped gear
+	// was last simmed in an earlier fast mode round. Iterations always use the same
+	// seeds though, so the ones both results ran still line up.
+	var difference aggregator
+	for i := 0; i < min(len(values), len(otherValues)); i++ {
+		difference.add(values[i] - otherValues[i])
+	}
+	if difference.n == 0 {
+		return nil, 1
+	}
+
+	confidenceInterval := difference.confidenceInterval()
+	if confidenceInterval.StdError == 0 {
+		if confidenceInterval.Mean == 0 {
+			return confidenceInterval, 1
+		}
+		return confidenceInterval, 0
+	}
+
+	// Two-sided test, with the normal approximation which is fine for the number of iterations we run.
+	z := math.Abs(confidenceInterval.Mean / confidenceInterval.StdError)
+	return confidenceInterval, math.Erfc(z / math.Sqrt2)
+}
+
+// newBulkComboResult builds the result for r, including how it compares to the
+// equipped gear and the best combo.
+func newBulkComboResult(r *itemSubstitutionSimResult, baseResult *itemSubstitutionSimResult, bestResult *itemSubstitutionSimResult) *proto.BulkComboResult {
+	comboResult := &proto.BulkComboResult{
+		PValueVsEquippedGear: 1,
+		Score:                r.scoreInterval(),
+	}
+
+	if baseResult != nil {
+		comboResult.DifferenceToEquippedGear, comboResult.PValueVsEquippedGear = r.compareScores(baseResult)
+	}
+	if bestResult != nil {
+		_, pValue := r.compareScores(bestResult)
+		comboResult.IndistinguishableFromBest = r == bestResult || pValue >= 0.05
+	}
+
+	um := r.Result.GetRaidMetrics().GetParties()[0].GetPlayers()[0]
+	um.Actions = nil
+	um.Auras = nil
+	um.Resources = nil
+	um.Pets = nil
+	// Only needed for the comparisons above, and far too large to send back.
+	for _, distMetrics := range []*proto.DistributionMetrics{um.Dps, um.Dpasp, um.Threat, um.Dtps, um.Tmi, um.Hps, um.Tto} {
+		if distMetrics != nil {
+			distMetrics.AllValues = nil
+		}
+	}
+	comboResult.UnitMetrics = um
+
+	return comboResult
+}
+
+// Result of a single combo, reported as soon as it's simmed. Unlike
+// newBulkComboResult, this doesn't touch r.Result, which later comparisons
+// still need.
+func newPartialBulkComboResult(r *itemSubstitutionSimResult) *proto.BulkComboResult {
+	comboResult := &proto.BulkComboResult{
+		PValueVsEquippedGear: 1,
+		Score:                r.scoreInterval(),
+	}
+	if r.ChangeLog != nil {
+		comboResult.ItemsAdded = r.ChangeLog.AddedItems
+	}
+	return comboResult
 }
 
 // equipmentSubstitution specifies all items to be used as replacements for the equipped gear.
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -91,7 +92,7 @@ func createEquipmentFromItems(items ...*itemWithSlot) *proto.EquipmentSpec {
 func TestBulkSim(t *testing.T) {
 	t.Skip("TODO: Implement")
 
-	fakeRunSim := func(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool) *proto.RaidSimResult {
+	fakeRunSim := func(ctx context.Context, rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool) *proto.RaidSimResult {
 		return &proto.RaidSimResult{}
 	}
 
@@ -113,6 +114,149 @@ func TestBulkSim(t *testing.T) {
 	}
 }
 
+func TestRankedResultsReportEachCombo(t *testing.T) {
+	fakeRunSim := func(ctx context.Context, rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool) *proto.RaidSimResult {
+		// Combos already run in parallel, so their sims shouldn't be sharded as well.
+		if ctx.Value(noShardsKey{}) == nil {
+			t.Errorf("Expected bulk sim combos to run without shards")
+		}
+		result := &proto.RaidSimResult{
+			RaidMetrics: &proto.RaidMetrics{
+				Dps: &proto.DistributionMetrics{AllValues: []float64{float64(rsr.SimOptions.RandomSeed)}},
+			},
+		}
+		progress <- &proto.ProgressMetrics{CompletedIterations: 1, FinalRaidResult: result}
+		close(progress)
+		return result
+	}
+	bulk := &bulkSimRunner{
+		SingleRaidSimRunner: fakeRunSim,
+		Request:             &proto.BulkSimRequest{},
+	}
+
+	added := []*proto.ItemSpecWithSlot{{Item: &proto.ItemSpec{Id: itemIronmender}, Slot: proto.ItemSlot_ItemSlotOffHand}}
+	combos := []singleBulkSim{
+		{req: &proto.RaidSimRequest{SimOptions: &proto.SimOptions{RandomSeed: 100}}, cl: &raidSimRequestChangeLog{}, eq: &equipmentSubstitution{}},
+		{req: &proto.RaidSimRequest{SimOptions: &proto.SimOptions{RandomSeed: 200}}, cl: &raidSimRequestChangeLog{AddedItems: added}, eq: &equipmentSubstitution{Items: []*itemWithSlot{ironmender}}},
+	}
+
+	progress := make(chan *proto.ProgressMetrics, 100)
+	if _, _, err := bulk.getRankedResults(context.Background(), combos, 1, progress); err != nil {
+		t.Fatalf("getRankedResults() returned error: %v", err)
+	}
+	close(progress)
+
+	scores := map[float64]*proto.BulkComboResult{}
+	for p := range progress {
+		if p.BulkComboResult != nil {
+			scores[p.BulkComboResult.Score.Mean] = p.BulkComboResult
+		}
+	}
+	if len(scores) != 2 || scores[100] == nil || scores[200] == nil {
+		t.Fatalf("Expected a progress report for each combo, got %v", scores)
+	}
+	if len(scores[200].ItemsAdded) != 1 || scores[100].ItemsAdded != nil {
+		t.Fatalf("Expected combo reports to list their items")
+	}
+}
+
+func TestRankedResultsScoreByRole(t *testing.T) {
+	// The combo with the higher DPS has the lower HPS and TPS.
+	fakeRunSim := func(ctx context.Context, rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool) *proto.RaidSimResult {
+		seed := float64(rsr.SimOptions.RandomSeed)
+		other := &proto.DistributionMetrics{Avg: 300 - seed, AllValues: []float64{300 - seed}}
+		result := &proto.RaidSimResult{
+			RaidMetrics: &proto.RaidMetrics{
+				Dps:     &proto.DistributionMetrics{Avg: seed, AllValues: []float64{seed}},
+				Hps:     other,
+				Parties: []*proto.PartyMetrics{{Players: []*proto.UnitMetrics{{Threat: other}}}},
+			},
+		}
+		close(progress)
+		return result
+	}
+	bulk := &bulkSimRunner{SingleRaidSimRunner: fakeRunSim}
+
+	for _, test := range []struct {
+		name      string
+		player    *proto.Player
+		tanks     []*proto.UnitReference
+		wantSeed  int64
+		wantScore float64
+	}{
+		{name: "DPS", player: &proto.Player{Spec: &proto.Player_Mage{}}, wantSeed: 200, wantScore: 200},
+		{name: "Healer", player: &proto.Player{Spec: &proto.Player_HealingPriest{}}, wantSeed: 100, wantScore: 200},
+		{name: "Tank", player: &proto.Player{Spec: &proto.Player_ProtectionWarrior{}}, wantSeed: 100, wantScore: 200},
+		{name: "Assigned Tank", player: &proto.Player{Spec: &proto.Player_Warrior{}}, tanks: []*proto.UnitReference{{Type: proto.UnitReference_Player}}, wantSeed: 100, wantScore: 200},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var combos []singleBulkSim
+			for _, seed := range []int64{100, 200} {
+				combos = append(combos, singleBulkSim{
+					req: &proto.RaidSimRequest{
+						Raid:       &proto.Raid{Parties: []*proto.Party{{Players: []*proto.Player{test.player}}}, Tanks: test.tanks},
+						SimOptions: &proto.SimOptions{RandomSeed: seed},
+					},
+					cl: &raidSimRequestChangeLog{},
+					eq: &equipmentSubstitution{},
+				})
+			}
+
+			results, _, err := bulk.getRankedResults(context.Background(), combos, 1, nil)
+			if err != nil {
+				t.Fatalf("getRankedResults() returned error: %v", err)
+			}
+			best := results[0]
+			if best.Request.SimOptions.RandomSeed != test.wantSeed || best.Score() != test.wantScore || best.scoreInterval().Mean != test.wantScore {
+				t.Fatalf("Expected the combo with seed %d to rank first with score %0.0f, got seed %d with score %0.0f",
+					test.wantSeed, test.wantScore, best.Request.SimOptions.RandomSeed, best.Score())
+			}
+		})
+	}
+}
+
+func TestCompareScores(t *testing.T) {
+	newResult := func(values []float64) *itemSubstitutionSimResult {
+		return &itemSubstitutionSimResult{
+			Result: &proto.RaidSimResult{
+				RaidMetrics: &proto.RaidMetrics{
+					Dps: &proto.DistributionMetrics{AllValues: values},
+				},
+			},
+		}
+	}
+
+	// Iterations vary a lot, but the difference between both results barely does.
+	var baseValues, betterValues, noisyValues []float64
+	for i := 0; i < 1000; i++ {
+		value := 1000 + float64(i%50)*20
+		baseValues = append(baseValues, value)
+		betterValues = append(betterValues, value+3+float64(i%3-1))
+		noisyValues = append(noisyValues, value+float64(i%7-3))
+	}
+	base := newResult(baseValues)
+
+	difference, pValue := newResult(betterValues).compareScores(base)
+	if math.Abs(difference.Mean-3) > 0.01 || difference.Lower <= 2.9 || pValue > 0.001 {
+		t.Fatalf("Expected a significant difference of 3, got %v with p-value %f", difference, pValue)
+	}
+
+	difference, pValue = newResult(noisyValues).compareScores(base)
+	if difference.Lower >= 0 || difference.Upper <= 0 || pValue < 0.05 {
+		t.Fatalf("Expected no significant difference, got %v with p-value %f", difference, pValue)
+	}
+
+	// Only the iterations both results ran are compared.
+	difference, pValue = newResult(betterValues[:100]).compareScores(base)
+	if math.Abs(difference.Mean-3) > 0.01 || pValue > 0.001 {
+		t.Fatalf("Expected a significant difference of 3, got %v with p-value %f", difference, pValue)
+	}
+
+	if _, pValue := base.compareScores(base); pValue != 1 {
+		t.Fatalf("Expected p-value 1 when comparing a result to itself, got %f", pValue)
+	}
+}
+
 func TestGenerateAllEquipmentSubstitutions(t *testing.T) {
 	baseItems := make([]*proto.ItemSpec, len(proto.ItemSlot_name))
 	for i := range baseItems {
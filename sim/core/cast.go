@@ -73,6 +73,15 @@ func (spell *Spell) castFailureHelper(sim *Simulation, message string, vals ...a
 	return false
 }
 
+// Whether castFailureHelper records anything. Failures with formatted arguments
+// check this first, so the arguments aren't built when nobody reads them.
+func (spell *Spell) castFailureRecorded(sim *Simulation) bool {
+	if sim.CurrentTime < 0 && spell.Unit.Rotation != nil {
+		return true
+	}
+	return sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs)
+}
+
 func (unit *Unit) applySpellPushback() {
 	unit.RegisterAura(Aura{
 		Label:    "Spell Pushback",
@@ -133,7 +142,53 @@ func (unit *Unit) applySpellPushback() {
 	})
 }
 
+// The action of a queued cast-while-casting spell, reused for later casts once
+// it has run.
+type castWhileCastingAction struct {
+	PendingAction
+	target  *Unit
+	running bool
+}
+
 func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
+	onHardcastComplete := func(sim *Simulation, target *Unit) {
+		if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
+			sim.logCastComplete(spell)
+		}
+
+		if spell.Cost != nil {
+			spell.Cost.SpendCost(sim, spell)
+		}
+
+		spell.applyEffects(sim, target)
+
+		if !spell.Flags.Matches(SpellFlagNoOnCastComplete) {
+			spell.Unit.OnCastComplete(sim, spell)
+		}
+
+		if !sim.Options.Interactive {
+			spell.Unit.Rotation.DoNextAction(sim)
+		}
+	}
+
+	onCastWhileCastingComplete := func(sim *Simulation, target *Unit) {
+		if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
+			sim.logCastBegin(spell, max(0, spell.CurCast.Cost), spell.CurCast.CastTime, spell.CurCast.EffectiveTime())
+			sim.logCastComplete(spell)
+		}
+
+		if spell.Cost != nil {
+			spell.Cost.SpendCost(sim, spell)
+		}
+
+		spell.applyEffects(sim, target)
+
+		if !spell.Flags.Matches(SpellFlagNoOnCastComplete) {
+			spell.Unit.OnCastComplete(sim, spell)
+		}
+	}
+	var castWhileCasting *castWhileCastingAction
+
 	return func(sim *Simulation, target *Unit) bool {
 		spell.CurCast = spell.DefaultCast
 
@@ -155,7 +210,7 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 
 		if spell.Cost != nil {
 			if !spell.Cost.MeetsRequirement(sim, spell) {
-				return spell.castFailureHelper(sim, spell.Cost.CostFailureReason(sim, spell))
+				return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, spell.Cost.CostFailureReason(sim, spell))
 			}
 		}
 
@@ -168,7 +223,7 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 		if config.CD.Timer != nil {
 			// By panicking if spell is on CD, we force each sim to properly check for their own CDs.
 			if !spell.CD.IsReady(sim) {
-				return spell.castFailureHelper(sim, "still on cooldown for %s, curTime = %s", spell.CD.TimeToReady(sim), sim.CurrentTime)
+				return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, "still on cooldown for %s, curTime = %s", spell.CD.TimeToReady(sim), sim.CurrentTime)
 			}
 			spell.CD.Set(sim.CurrentTime + spell.CurCast.CastTime + spell.CD.Duration)
 		}
@@ -176,14 +231,14 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 		if config.SharedCD.Timer != nil {
 			// By panicking if spell is on CD, we force each sim to properly check for their own CDs.
 			if !spell.SharedCD.IsReady(sim) {
-				return spell.castFailureHelper(sim, "still on shared cooldown for %s, curTime = %s", spell.SharedCD.TimeToReady(sim), sim.CurrentTime)
+				return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, "still on shared cooldown for %s, curTime = %s", spell.SharedCD.TimeToReady(sim), sim.CurrentTime)
 			}
 			spell.SharedCD.Set(sim.CurrentTime + spell.CurCast.CastTime + spell.SharedCD.Duration)
 		}
 
 		// By panicking if spell is on CD, we force each sim to properly check for their own CDs.
 		if spell.CurCast.GCD != 0 && !spell.Unit.GCD.IsReady(sim) {
-			return spell.castFailureHelper(sim, "GCD on cooldown for %s, curTime = %s", spell.Unit.GCD.TimeToReady(sim), sim.CurrentTime)
+			return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, "GCD on cooldown for %s, curTime = %s", spell.Unit.GCD.TimeToReady(sim), sim.CurrentTime)
 		}
 
 		if hc := spell.Unit.Hardcast; hc.Expires > sim.CurrentTime {
@@ -192,7 +247,7 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 				cwc.OnAction(sim)
 			}
 
-			return spell.castFailureHelper(sim, "casting/channeling %v for %s, curTime = %s", hc.ActionID, hc.Expires-sim.CurrentTime, sim.CurrentTime)
+			return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, "casting/channeling %v for %s, curTime = %s", hc.ActionID, hc.Expires-sim.CurrentTime, sim.CurrentTime)
 		}
 
 		if effectiveTime := spell.CurCast.EffectiveTime(); effectiveTime != 0 {
@@ -216,62 +271,36 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 		// Castable-while-casting spells
 		if spell.Flags.Matches(SpellFlagCastWhileCasting) {
 			// Queue cast-while-casting spells to cast 750 ms into the next hard-cast
-			pa := &PendingAction{
-				NextActionAt: sim.CurrentTime + GCDDefault/2,
-				OnAction: func(sim *Simulation) {
-					if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-						spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s, Effective Time = %s)",
-							spell.ActionID, max(0, spell.CurCast.Cost), spell.CurCast.CastTime, spell.CurCast.EffectiveTime())
-						spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
-					}
-
-					if spell.Cost != nil {
-						spell.Cost.SpendCost(sim, spell)
-					}
-
-					spell.applyEffects(sim, target)
-
-					if !spell.Flags.Matches(SpellFlagNoOnCastComplete) {
-						spell.Unit.OnCastComplete(sim, spell)
-					}
-				},
+			pa := castWhileCasting
+			if pa == nil || pa.queueIndex != 0 || pa.running {
+				pa = &castWhileCastingAction{}
+				pa.OnAction = func(sim *Simulation) {
+					pa.running = true
+					onCastWhileCastingComplete(sim, pa.target)
+					pa.running = false
+				}
+				castWhileCasting = pa
 			}
-			spell.Unit.castWhileCastingAction = pa
-			sim.AddPendingAction(pa)
+			pa.NextActionAt = sim.CurrentTime + GCDDefault/2
+			pa.cancelled = false
+			pa.target = target
+			spell.Unit.castWhileCastingAction = &pa.PendingAction
+			sim.AddPendingAction(&pa.PendingAction)
 			return true
 		}
 
 		// Hardcasts
 		if spell.CurCast.CastTime > 0 {
 			if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-				spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s, Effective Time = %s)",
-					spell.ActionID, max(0, spell.CurCast.Cost), spell.CurCast.CastTime, spell.CurCast.EffectiveTime())
+				sim.logCastBegin(spell, max(0, spell.CurCast.Cost), spell.CurCast.CastTime, spell.CurCast.EffectiveTime())
 			}
 
 			spell.Unit.Hardcast = Hardcast{
-				Expires:  sim.CurrentTime + spell.CurCast.CastTime,
-				ActionID: spell.ActionID,
-				Pushback: 1.0,
-				OnComplete: func(sim *Simulation, target *Unit) {
-					if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-						spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
-					}
-
-					if spell.Cost != nil {
-						spell.Cost.SpendCost(sim, spell)
-					}
-
-					spell.applyEffects(sim, target)
-
-					if !spell.Flags.Matches(SpellFlagNoOnCastComplete) {
-						spell.Unit.OnCastComplete(sim, spell)
-					}
-
-					if !sim.Options.Interactive {
-						spell.Unit.Rotation.DoNextAction(sim)
-					}
-				},
-				Target: target,
+				Expires:    sim.CurrentTime + spell.CurCast.CastTime,
+				ActionID:   spell.ActionID,
+				Pushback:   1.0,
+				OnComplete: onHardcastComplete,
+				Target:     target,
 			}
 
 			if spell.Unit.Hardcast.Expires != spell.Unit.NextGCDAt() {
@@ -282,9 +311,8 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 		}
 
 		if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-			spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s, Effective Time = %s)",
-				spell.ActionID, max(0, spell.CurCast.Cost), spell.CurCast.CastTime, spell.CurCast.EffectiveTime())
-			spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
+			sim.logCastBegin(spell, max(0, spell.CurCast.Cost), spell.CurCast.CastTime, spell.CurCast.EffectiveTime())
+			sim.logCastComplete(spell)
 		}
 
 		if spell.Cost != nil {
@@ -312,7 +340,7 @@ func (spell *Spell) makeCastFuncSimple() CastSuccessFunc {
 		if spell.CD.Timer != nil {
 			// By panicking if spell is on CD, we force each sim to properly check for their own CDs.
 			if !spell.CD.IsReady(sim) {
-				return spell.castFailureHelper(sim, "still on cooldown for %s, curTime = %s", spell.CD.TimeToReady(sim), sim.CurrentTime)
+				return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, "still on cooldown for %s, curTime = %s", spell.CD.TimeToReady(sim), sim.CurrentTime)
 			}
 
 			spell.CD.Set(sim.CurrentTime + spell.CD.Duration)
@@ -321,16 +349,15 @@ func (spell *Spell) makeCastFuncSimple() CastSuccessFunc {
 		if spell.SharedCD.Timer != nil {
 			// By panicking if spell is on CD, we force each sim to properly check for their own CDs.
 			if !spell.SharedCD.IsReady(sim) {
-				return spell.castFailureHelper(sim, "still on shared cooldown for %s, curTime = %s", spell.SharedCD.TimeToReady(sim), sim.CurrentTime)
+				return spell.castFailureRecorded(sim) && spell.castFailureHelper(sim, "still on shared cooldown for %s, curTime = %s", spell.SharedCD.TimeToReady(sim), sim.CurrentTime)
 			}
 
 			spell.SharedCD.Set(sim.CurrentTime + spell.SharedCD.Duration)
 		}
 
 		if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-			spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s, Effective Time = %s)",
-				spell.ActionID, 0.0, "0s", "0s")
-			spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
+			sim.logCastBegin(spell, 0, 0, 0)
+			sim.logCastComplete(spell)
 		}
 
 		spell.applyEffects(sim, target)
@@ -346,9 +373,8 @@ func (spell *Spell) makeCastFuncSimple() CastSuccessFunc {
 func (spell *Spell) makeCastFuncAutosOrProcs() CastSuccessFunc {
 	return func(sim *Simulation, target *Unit) bool {
 		if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-			spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s, Effective Time = %s)",
-				spell.ActionID, 0.0, "0s", "0s")
-			spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
+			sim.logCastBegin(spell, 0, 0, 0)
+			sim.logCastComplete(spell)
 		}
 
 		spell.applyEffects(sim, target)
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"math"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+// Combat events are the typed counterpart of the text logs. Each of the helpers
+// below writes a log message and records the matching event, so that the two
+// can't drift apart. Callers only use them while logging is enabled.
+
+func (sim *Simulation) recordEvent(event *proto.CombatEvent) {
+	event.Timestamp = sim.CurrentTime.Seconds()
+	sim.events = append(sim.events, event)
+}
+
+func (sim *Simulation) logCastBegin(spell *Spell, cost float64, castTime time.Duration, effectiveTime time.Duration) {
+	spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s, Effective Time = %s)", spell.ActionID, cost, castTime, effectiveTime)
+	sim.recordEvent(&proto.CombatEvent{
+		Type:     proto.CombatEvent_TypeCastBegin,
+		Source:   spell.Unit.unitReference(),
+		ActionId: spell.ActionID.ToProto(),
+		Amount:   cost,
+		Schools:  spell.SpellSchool.toProto(),
+		CastTime: castTime.Seconds(),
+	})
+}
+
+func (sim *Simulation) logCastComplete(spell *Spell) {
+	spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
+	sim.recordEvent(&proto.CombatEvent{
+		Type:     proto.CombatEvent_TypeCastComplete,
+		Source:   spell.Unit.unitReference(),
+		ActionId: spell.ActionID.ToProto(),
+		Schools:  spell.SpellSchool.toProto(),
+	})
+}
+
+func (sim *Simulation) logSpellResult(eventType proto.CombatEvent_Type, spell *Spell, result *SpellResult, isPeriodic bool) {
+	amountString := result.DamageString()
+	if eventType == proto.CombatEvent_TypeHealing {
+		amountString = result.HealingString()
+	}
+	if isPeriodic {
+		spell.Unit.Log(sim, "%s %s tick %s. (Threat: %0.3f)", result.Target.LogLabel(), spell.ActionID, amountString, result.Threat)
+	} else {
+		spell.Unit.Log(sim, "%s %s %s. (Threat: %0.3f)", result.Target.LogLabel(), spell.ActionID, amountString, result.Threat)
+	}
+
+	sim.recordEvent(&proto.CombatEvent{
+		Type:            eventType,
+		Source:          spell.Unit.unitReference(),
+		Target:          result.Target.unitReference(),
+		ActionId:        spell.ActionID.ToProto(),
+		Amount:          result.Damage,
+		Threat:          result.Threat,
+		Outcome:         result.Outcome.toProto(),
+		ResistedPercent: result.Outcome.resistedPercent(),
+		Schools:         spell.SpellSchool.toProto(),
+		Periodic:        isPeriodic,
+	})
+}
+
+func (sim *Simulation) logAura(eventType proto.CombatEvent_Type, aura *Aura) {
+	switch eventType {
+	case proto.CombatEvent_TypeAuraGained:
+		aura.Unit.Log(sim, "Aura gained: %s", aura.ActionID)
+	case proto.CombatEvent_TypeAuraFaded:
+		aura.Unit.Log(sim, "Aura faded: %s", aura.ActionID)
+	case proto.CombatEvent_TypeAuraRefreshed:
+		aura.Unit.Log(sim, "Aura refreshed: %s", aura.ActionID)
+	}
+
+	sim.recordEvent(&proto.CombatEvent{
+		Type:     eventType,
+		Source:   aura.Unit.unitReference(),
+		ActionId: aura.ActionID.ToProto(),
+	})
+}
+
+func (sim *Simulation) logAuraStacks(aura *Aura, oldStacks int32, newStacks int32) {
+	aura.Unit.Log(sim, "%s stacks: %d --> %d", aura.ActionID, oldStacks, newStacks)
+	sim.recordEvent(&proto.CombatEvent{
+		Type:      proto.CombatEvent_TypeAuraStacksChanged,
+		Source:    aura.Unit.unitReference(),
+		ActionId:  aura.ActionID.ToProto(),
+		OldStacks: oldStacks,
+		NewStacks: newStacks,
+	})
+}
+
+var resourceLogNames = map[proto.ResourceType]string{
+	proto.ResourceType_ResourceTypeMana:        "mana",
+	proto.ResourceType_ResourceTypeEnergy:      "energy",
+	proto.ResourceType_ResourceTypeRage:        "rage",
+	proto.ResourceType_ResourceTypeComboPoints: "combo points",
+	proto.ResourceType_ResourceTypeFocus:       "focus",
+	proto.ResourceType_ResourceTypeHealth:      "health",
+}
+
+// Amount is positive for gains and negative (or -0) for spends.
+func (sim *Simulation) logResourceChange(unit *Unit, actionID ActionID, resourceType proto.ResourceType, amount float64, oldValue float64, newValue float64) {
+	verb := "Gained"
+	if math.Signbit(amount) {
+		verb = "Spent"
+	}
+	if resourceType == proto.ResourceType_ResourceTypeComboPoints {
+		unit.Log(sim, "%s %d %s from %s (%d --> %d).", verb, int32(math.Abs(amount)), resourceLogNames[resourceType], actionID, int32(oldValue), int32(newValue))
+	} else {
+		unit.Log(sim, "%s %0.3f %s from %s (%0.3f --> %0.3f).", verb, math.Abs(amount), resourceLogNames[resourceType], actionID, oldValue, newValue)
+	}
+
+	sim.recordEvent(&proto.CombatEvent{
+		Type:         proto.CombatEvent_TypeResourceChanged,
+		Source:       unit.unitReference(),
+		ActionId:     actionID.ToProto(),
+		Amount:       amount,
+		ResourceType: resourceType,
+		OldValue:     oldValue,
+		NewValue:     newValue,
+	})
+}
+
+func (sim *Simulation) logUnitEvent(eventType proto.CombatEvent_Type, unit *Unit, target *Unit) {
+	event := &proto.CombatEvent{
+		Type:   eventType,
+		Source: unit.unitReference(),
+	}
+	switch eventType {
+	case proto.CombatEvent_TypePetSummoned:
+		unit.Log(sim, "Pet summoned")
+	case proto.CombatEvent_TypePetDismissed:
+		unit.Log(sim, "Pet dismissed")
+	case proto.CombatEvent_TypeTargetChanged:
+		unit.Log(sim, "Changing target to %s", target.Label)
+	}
+
+	if target != nil {
+		event.Target = target.unitReference()
+	}
+	sim.recordEvent(event)
+}
+
+// Reference to this unit, in the format used by APLs.
+func (unit *Unit) unitReference() *proto.UnitReference {
+	switch unit.Type {
+	case PlayerUnit:
+		return &proto.UnitReference{Type: proto.UnitReference_Player, Index: unit.Index}
+	case EnemyUnit:
+		return &proto.UnitReference{Type: proto.UnitReference_Target, Index: unit.Index}
+	}
+
+	petAgent, ok := unit.Env.Raid.GetPlayerFromUnit(unit).(PetAgent)
+	if !ok {
+		return &proto.UnitReference{Type: proto.UnitReference_Unknown}
+	}
+	owner := petAgent.GetPet().Owner
+	for i, ownerPet := range owner.PetAgents {
+		if ownerPet == petAgent {
+			return &proto.UnitReference{
+				Type:  proto.UnitReference_Pet,
+				Index: int32(i),
+				Owner: owner.unitReference(),
+			}
+		}
+	}
+	return &proto.UnitReference{Type: proto.UnitReference_Unknown}
+}
+
+func (ss SpellSchool) toProto() []proto.SpellSchool {
+	var schools []proto.SpellSchool
+	for i := stats.SchoolIndexPhysical; i < stats.SchoolLen; i++ {
+		if ss.Matches(SpellSchoolFromIndex(i)) {
+			schools = append(schools, proto.SpellSchool(i-stats.SchoolIndexPhysical))
+		}
+	}
+	return schools
+}
+
+func (ho HitOutcome) toProto() proto.CombatEvent_Outcome {
+	if ho.Matches(OutcomeMiss) {
+		return proto.CombatEvent_OutcomeMiss
+	} else if ho.Matches(OutcomeDodge) {
+		return proto.CombatEvent_OutcomeDodge
+	} else if ho.Matches(OutcomeParry) {
+		return proto.CombatEvent_OutcomeParry
+	} else if ho.Matches(OutcomeGlance) {
+		return proto.CombatEvent_OutcomeGlance
+	} else if ho.Matches(OutcomeBlock) {
+		if ho.Matches(OutcomeCrit) {
+			return proto.CombatEvent_OutcomeCriticalBlock
+		}
+		return proto.CombatEvent_OutcomeBlock
+	} else if ho.Matches(OutcomeCrit) {
+		return proto.CombatEvent_OutcomeCrit
+	} else if ho.Matches(OutcomeHit) {
+		return proto.CombatEvent_OutcomeHit
+	} else if ho.Matches(OutcomeCrush) {
+		return proto.CombatEvent_OutcomeCrush
+	}
+	return proto.CombatEvent_OutcomeNone
+}
+
+func (ho HitOutcome) resistedPercent() int32 {
+	if ho.Matches(OutcomePartial1_4) {
+		return 25
+	} else if ho.Matches(OutcomePartial2_4) {
+		return 50
+	} else if ho.Matches(OutcomePartial3_4) {
+		return 75
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func TestCombatEventsMatchLogs(t *testing.T) {
+	request := newMeleeTargetDummyRequest(1, 101)
+	result := RunSim(context.Background(), request, nil)
+	if len(result.Events) != 0 {
+		t.Fatalf("Expected no events without logging, got %d", len(result.Events))
+	}
+
+	request.SimOptions.DebugFirstIteration = true
+	result = RunSim(context.Background(), request, nil)
+	if result.ErrorResult != "" {
+		t.Fatalf("Sim failed: %s", result.ErrorResult)
+	}
+
+	totalDamage := 0.0
+	for _, event := range result.Events {
+		if event.Type != proto.CombatEvent_TypeDamage {
+			continue
+		}
+		if event.Source.Type != proto.UnitReference_Target || event.Target.Type != proto.UnitReference_Player {
+			t.Fatalf("Expected the boss to damage the dummy, got %s --> %s", event.Source, event.Target)
+		}
+		if event.Outcome == proto.CombatEvent_OutcomeNone {
+			t.Fatalf("Expected damage events to have an outcome")
+		}
+		if len(event.Schools) != 1 || event.Schools[0] != proto.SpellSchool_SpellSchoolPhysical {
+			t.Fatalf("Expected physical damage, got %v", event.Schools)
+		}
+		totalDamage += event.Amount
+	}
+
+	expectedDamage := result.EncounterMetrics.Targets[0].Dps.Avg * result.FirstIterationDuration
+	if totalDamage == 0 || math.Abs(totalDamage-expectedDamage) > 0.01 {
+		t.Fatalf("Expected events to add up to %0.2f damage, got %0.2f", expectedDamage, totalDamage)
+	}
+
+	eventCounts := map[proto.CombatEvent_Type]int{}
+	for _, event := range result.Events {
+		eventCounts[event.Type]++
+	}
+	logCounts := map[proto.CombatEvent_Type]int{
+		proto.CombatEvent_TypeCastComplete:  strings.Count(result.Logs, "Completed cast "),
+		proto.CombatEvent_TypeAuraGained:    strings.Count(result.Logs, "Aura gained: "),
+		proto.CombatEvent_TypeAuraFaded:     strings.Count(result.Logs, "Aura faded: "),
+		proto.CombatEvent_TypeAuraRefreshed: strings.Count(result.Logs, "Aura refreshed: "),
+	}
+	for eventType, logCount := range logCounts {
+		if eventCounts[eventType] != logCount {
+			t.Fatalf("Expected %d %s events to match the logs, got %d", logCount, eventType, eventCounts[eventType])
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// consumeRestriction limits which players can use one value of a consumes field.
+// Mirrors the level, class and profession checks of the consumable pickers in the UI.
+type consumeRestriction struct {
+	minLevel int32
+	maxLevel int32 // 0 if there's no maximum.
+
+	classes    []proto.Class
+	profession proto.Profession
+}
+
+func (cr consumeRestriction) allows(player *proto.Player) bool {
+	if player.Level < cr.minLevel || (cr.maxLevel > 0 && player.Level > cr.maxLevel) {
+		return false
+	}
+	if len(cr.classes) > 0 && !slices.Contains(cr.classes, player.Class) {
+		return false
+	}
+	if cr.profession != proto.Profession_ProfessionUnknown && player.Profession1 != cr.profession && player.Profession2 != cr.profession {
+		return false
+	}
+	return true
+}
+
+var weaponImbueRestrictions = map[int32]consumeRestriction{
+	int32(proto.WeaponImbue_MinorWizardOil):           {minLevel: 5},
+	int32(proto.WeaponImbue_LesserWizardOil):          {minLevel: 30},
+	int32(proto.WeaponImbue_WizardOil):                {minLevel: 40},
+	int32(proto.WeaponImbue_BrillianWizardOil):        {minLevel: 45},
+	int32(proto.WeaponImbue_MinorManaOil):             {minLevel: 20},
+	int32(proto.WeaponImbue_LesserManaOil):            {minLevel: 40},
+	int32(proto.WeaponImbue_BrilliantManaOil):         {minLevel: 45},
+	int32(proto.WeaponImbue_BlackfathomManaOil):       {minLevel: 25},
+	int32(proto.WeaponImbue_SolidSharpeningStone):     {minLevel: 35},
+	int32(proto.WeaponImbue_DenseSharpeningStone):     {minLevel: 35},
+	int32(proto.WeaponImbue_ElementalSharpeningStone): {minLevel: 50},
+	int32(proto.WeaponImbue_SolidWeightstone):         {minLevel: 35},
+	int32(proto.WeaponImbue_DenseWeightstone):         {minLevel: 35},
+	int32(proto.WeaponImbue_ShadowOil):                {minLevel: 25},
+	int32(proto.WeaponImbue_FrostOil):                 {minLevel: 40},
+	int32(proto.WeaponImbue_ConductiveShieldCoating):  {minLevel: 40},
+	int32(proto.WeaponImbue_Windfury):                 {minLevel: 32},
+	int32(proto.WeaponImbue_RockbiterWeapon):          {classes: []proto.Class{proto.Class_ClassShaman}},
+	int32(proto.WeaponImbue_FlametongueWeapon):        {minLevel: 10, classes: []proto.Class{proto.Class_ClassShaman}},
+	int32(proto.WeaponImbue_FrostbrandWeapon):         {minLevel: 20, classes: []proto.Class{proto.Class_ClassShaman}},
+	int32(proto.WeaponImbue_WindfuryWeapon):           {minLevel: 30, classes: []proto.Class{proto.Class_ClassShaman}},
+	int32(proto.WeaponImbue_InstantPoison):            {minLevel: 20, classes: []proto.Class{proto.Class_ClassRogue}},
+	int32(proto.WeaponImbue_DeadlyPoison):             {minLevel: 30, classes: []proto.Class{proto.Class_ClassRogue}},
+	int32(proto.WeaponImbue_WoundPoison):              {minLevel: 32, classes: []proto.Class{proto.Class_ClassRogue}},
+	int32(proto.WeaponImbue_OccultPoison):             {minLevel: 56, classes: []proto.Class{proto.Class_ClassRogue}},
+	int32(proto.WeaponImbue_SebaciousPoison):          {minLevel: 60, classes: []proto.Class{proto.Class_ClassRogue}},
+}
+
+// Restrictions of each value of the consumes fields, keyed by field path. Values
+// which aren't listed can be used by anyone.
+var consumeRestrictions = map[string]map[int32]consumeRestriction{
+	"consumes.flask": {
+		int32(proto.Flask_FlaskOfTheTitans):             {minLevel: 51},
+		int32(proto.Flask_FlaskOfDistilledWisdom):       {minLevel: 51},
+		int32(proto.Flask_FlaskOfSupremePower):          {minLevel: 51},
+		int32(proto.Flask_FlaskOfChromaticResistance):   {minLevel: 51},
+		int32(proto.Flask_FlaskOfRestlessDreams):        {minLevel: 50, maxLevel: 59, profession: proto.Profession_Alchemy},
+		int32(proto.Flask_FlaskOfEverlastingNightmares): {minLevel: 50, maxLevel: 59, profession: proto.Profession_Alchemy},
+	},
+	"consumes.food": {
+		int32(proto.Food_FoodDirgesKickChimaerokChops): {minLevel: 55},
+		int32(proto.Food_FoodGrilledSquid):             {minLevel: 50},
+		int32(proto.Food_FoodSmokedDesertDumpling):     {minLevel: 51},
+		int32(proto.Food_FoodRunnTumTuberSurprise):     {minLevel: 51},
+		int32(proto.Food_FoodBlessSunfruit):            {minLevel: 45},
+		int32(proto.Food_FoodBlessedSunfruitJuice):     {minLevel: 45},
+		int32(proto.Food_FoodNightfinSoup):             {minLevel: 35},
+		int32(proto.Food_FoodTenderWolfSteak):          {minLevel: 40},
+		int32(proto.Food_FoodSagefishDelight):          {minLevel: 30},
+		int32(proto.Food_FoodHotWolfRibs):              {minLevel: 25},
+		int32(proto.Food_FoodSmokedSagefish):           {minLevel: 10},
+	},
+	"consumes.agility_elixir": {
+		int32(proto.AgilityElixir_ElixirOfTheMongoose):    {minLevel: 46},
+		int32(proto.AgilityElixir_ElixirOfGreaterAgility): {minLevel: 38},
+		int32(proto.AgilityElixir_ElixirOfAgility):        {minLevel: 27},
+		int32(proto.AgilityElixir_ElixirOfLesserAgility):  {minLevel: 18},
+		int32(proto.AgilityElixir_ScrollOfAgility):        {minLevel: 10},
+	},
+	"consumes.mana_regen_elixir": {
+		int32(proto.ManaRegenElixir_MagebloodPotion): {minLevel: 51},
+	},
+	"consumes.strength_buff": {
+		int32(proto.StrengthBuff_JujuPower):             {minLevel: 55},
+		int32(proto.StrengthBuff_ElixirOfGiants):        {minLevel: 46},
+		int32(proto.StrengthBuff_ElixirOfOgresStrength): {minLevel: 20},
+		int32(proto.StrengthBuff_ScrollOfStrength):      {minLevel: 10},
+	},
+	"consumes.attack_power_buff": {
+		int32(proto.AttackPowerBuff_JujuMight):           {minLevel: 55},
+		int32(proto.AttackPowerBuff_WinterfallFirewater): {minLevel: 45},
+	},
+	"consumes.spell_power_buff": {
+		int32(proto.SpellPowerBuff_GreaterArcaneElixir): {minLevel: 46},
+		int32(proto.SpellPowerBuff_ArcaneElixir):        {minLevel: 37},
+		int32(proto.SpellPowerBuff_LesserArcaneElixir):  {minLevel: 28},
+	},
+	"consumes.shadow_power_buff": {
+		int32(proto.ShadowPowerBuff_ElixirOfShadowPower): {minLevel: 40},
+	},
+	"consumes.fire_power_buff": {
+		int32(proto.FirePowerBuff_ElixirOfGreaterFirepower): {minLevel: 51},
+		int32(proto.FirePowerBuff_ElixirOfFirepower):        {minLevel: 18},
+	},
+	"consumes.frost_power_buff": {
+		int32(proto.FrostPowerBuff_ElixirOfFrostPower): {minLevel: 40},
+	},
+	"consumes.sapper": {
+		1: {minLevel: 50, profession: proto.Profession_Engineering},
+	},
+	"consumes.filler_explosive": {
+		int32(proto.Explosive_ExplosiveSolidDynamite):          {minLevel: 40, profession: proto.Profession_Engineering},
+		int32(proto.Explosive_ExplosiveGoblinLandMine):         {minLevel: 40, profession: proto.Profession_Engineering},
+		int32(proto.Explosive_ExplosiveDenseDynamite):          {minLevel: 50, profession: proto.Profession_Engineering},
+		int32(proto.Explosive_ExplosiveThoriumGrenade):         {minLevel: 50, profession: proto.Profession_Engineering},
+		int32(proto.Explosive_ExplosiveEzThroRadiationBomb):    {minLevel: 40},
+		int32(proto.Explosive_ExplosiveHighYieldRadiationBomb): {minLevel: 40, profession: proto.Profession_Engineering},
+	},
+	"consumes.main_hand_imbue": weaponImbueRestrictions,
+	"consumes.off_hand_imbue":  weaponImbueRestrictions,
+	"consumes.default_potion": {
+		int32(proto.Potions_ManaPotion):               {minLevel: 22},
+		int32(proto.Potions_GreaterManaPotion):        {minLevel: 31},
+		int32(proto.Potions_SuperiorManaPotion):       {minLevel: 41},
+		int32(proto.Potions_MajorManaPotion):          {minLevel: 49},
+		int32(proto.Potions_RagePotion):               {minLevel: 4, classes: []proto.Class{proto.Class_ClassWarrior}},
+		int32(proto.Potions_GreatRagePotion):          {minLevel: 25, classes: []proto.Class{proto.Class_ClassWarrior}},
+		int32(proto.Potions_MightyRagePotion):         {minLevel: 46, classes: []proto.Class{proto.Class_ClassWarrior}},
+		int32(proto.Potions_LesserStoneshieldPotion):  {minLevel: 33},
+		int32(proto.Potions_GreaterStoneshieldPotion): {minLevel: 46},
+	},
+	"consumes.default_conjured": {
+		int32(proto.Conjured_ConjuredDemonicRune):     {minLevel: 40},
+		int32(proto.Conjured_ConjuredRogueThistleTea): {minLevel: 25, classes: []proto.Class{proto.Class_ClassRogue}},
+	},
+	"consumes.enchanted_sigil": {
+		int32(proto.EnchantedSigil_InnovationSigil):   {minLevel: 40, profession: proto.Profession_Enchanting},
+		int32(proto.EnchantedSigil_LivingDreamsSigil): {minLevel: 50, profession: proto.Profession_Enchanting},
+	},
+	"consumes.mildly_irradiated_rejuv_pot": {
+		1: {minLevel: 35, profession: proto.Profession_Alchemy},
+	},
+	"consumes.dragon_breath_chili": {
+		1: {minLevel: 35},
+	},
+	"consumes.misc_consumes.catnip": {
+		1: {minLevel: 20, classes: []proto.Class{proto.Class_ClassDruid}},
+	},
+	"consumes.zanza_buff": {
+		int32(proto.ZanzaBuff_SpiritOfZanza):              {minLevel: 55},
+		int32(proto.ZanzaBuff_ROIDS):                      {minLevel: 45},
+		int32(proto.ZanzaBuff_GroundScorpokAssay):         {minLevel: 45},
+		int32(proto.ZanzaBuff_LungJuiceCocktail):          {minLevel: 45},
+		int32(proto.ZanzaBuff_CerebralCortexCompound):     {minLevel: 45},
+		int32(proto.ZanzaBuff_GizzardGum):                 {minLevel: 45},
+		int32(proto.ZanzaBuff_AtalaiMojoOfWar):            {minLevel: 50, maxLevel: 50},
+		int32(proto.ZanzaBuff_AtalaiMojoOfForbiddenMagic): {minLevel: 50, maxLevel: 50},
+		int32(proto.ZanzaBuff_AtalaiMojoOfLife):           {minLevel: 50, maxLevel: 50},
+	},
+	"consumes.armor_elixir": {
+		int32(proto.ArmorElixir_ElixirOfSuperiorDefense): {minLevel: 43},
+		int32(proto.ArmorElixir_ElixirOfGreaterDefense):  {minLevel: 29},
+		int32(proto.ArmorElixir_ElixirOfDefense):         {minLevel: 16},
+	},
+	"consumes.health_elixir": {
+		int32(proto.HealthElixir_ElixirOfFortitude):      {minLevel: 25},
+		int32(proto.HealthElixir_ElixirOfMinorFortitude): {minLevel: 2},
+	},
+	"consumes.alcohol": {
+		int32(proto.Alcohol_AlcoholGordokGreenGrog):     {minLevel: 56},
+		int32(proto.Alcohol_AlcoholKreegsStoutBeatdown): {minLevel: 56},
+	},
+}
+
+// canUseConsume returns true if player can use value for the consumes field at path.
+func canUseConsume(player *proto.Player, path string, value int32) bool {
+	restriction, ok := consumeRestrictions[path][value]
+	return !ok || restriction.allows(player)
+}
@@ -0,0 +1,251 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// consumeField is a field of Consumes, RaidBuffs or IndividualBuffs which can be
+// changed by a consumes search, possibly nested in another message.
+type consumeField struct {
+	// e.g. "consumes.flask" or "consumes.misc_consumes.bogling_root".
+	path string
+	// Descriptors from the root message down to the field.
+	fds []protoreflect.FieldDescriptor
+}
+
+// Root messages a consumes search changes, keyed by their path prefix.
+func consumeRoots(request *proto.RaidSimRequest) map[string]protoreflect.Message {
+	player := request.Raid.Parties[0].Players[0]
+	if player.Consumes == nil {
+		player.Consumes = &proto.Consumes{}
+	}
+	if player.Buffs == nil {
+		player.Buffs = &proto.IndividualBuffs{}
+	}
+	if request.Raid.Buffs == nil {
+		request.Raid.Buffs = &proto.RaidBuffs{}
+	}
+	return map[string]protoreflect.Message{
+		"consumes":         player.Consumes.ProtoReflect(),
+		"individual_buffs": player.Buffs.ProtoReflect(),
+		"raid_buffs":       request.Raid.Buffs.ProtoReflect(),
+	}
+}
+
+// listConsumeFields returns all enum, bool and int32 fields of md, recursing into message fields.
+func listConsumeFields(path string, md protoreflect.MessageDescriptor, parents []protoreflect.FieldDescriptor) []consumeField {
+	var fields []consumeField
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fd.IsList() || fd.IsMap() || fd.Options().(*descriptorpb.FieldOptions).GetDeprecated() {
+			continue
+		}
+
+		fieldPath := path + "." + string(fd.Name())
+		fds := append(slices.Clone(parents), fd)
+		switch fd.Kind() {
+		case protoreflect.EnumKind, protoreflect.BoolKind, protoreflect.Int32Kind:
+			fields = append(fields, consumeField{path: fieldPath, fds: fds})
+		case protoreflect.MessageKind:
+			fields = append(fields, listConsumeFields(fieldPath, fd.Message(), fds)...)
+		}
+	}
+	return fields
+}
+
+// get returns the value of the field in root, as an int32.
+func (cf consumeField) get(root protoreflect.Message) int32 {
+	msg := root
+	for _, fd := range cf.fds[:len(cf.fds)-1] {
+		if !msg.Has(fd) {
+			return 0
+		}
+		msg = msg.Get(fd).Message()
+	}
+
+	fd := cf.fds[len(cf.fds)-1]
+	value := msg.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		return int32(value.Enum())
+	case protoreflect.BoolKind:
+		if value.Bool() {
+			return 1
+		}
+		return 0
+	default:
+		return int32(value.Int())
+	}
+}
+
+// set sets the field in root to value, creating parent messages as needed.
+func (cf consumeField) set(root protoreflect.Message, value int32) {
+	msg := root
+	for _, fd := range cf.fds[:len(cf.fds)-1] {
+		msg = msg.Mutable(fd).Message()
+	}
+
+	fd := cf.fds[len(cf.fds)-1]
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		msg.Set(fd, protoreflect.ValueOfEnum(protoreflect.EnumNumber(value)))
+	case protoreflect.BoolKind:
+		msg.Set(fd, protoreflect.ValueOfBool(value != 0))
+	default:
+		msg.Set(fd, protoreflect.ValueOfInt32(value))
+	}
+}
+
+// values returns every value the field can be changed to from current. Counts are
+// only ever removed, since there's no sensible range to try.
+func (cf consumeField) values(current int32) []int32 {
+	fd := cf.fds[len(cf.fds)-1]
+	var values []int32
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		enumValues := fd.Enum().Values()
+		for i := 0; i < enumValues.Len(); i++ {
+			if enumValues.Get(i).Options().(*descriptorpb.EnumValueOptions).GetDeprecated() {
+				continue
+			}
+			if value := int32(enumValues.Get(i).Number()); value != current {
+				values = append(values, value)
+			}
+		}
+	case protoreflect.BoolKind:
+		values = append(values, 1-current)
+	default:
+		if current != 0 {
+			values = append(values, 0)
+		}
+	}
+	return values
+}
+
+func (cf consumeField) valueName(value int32) string {
+	fd := cf.fds[len(cf.fds)-1]
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(protoreflect.EnumNumber(value)); enumValue != nil {
+			return string(enumValue.Name())
+		}
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(value != 0)
+	}
+	return strconv.Itoa(int(value))
+}
+
+// runConsumesSearch sims the base settings with every change of a single consumable
+// or buff the player can use, and ranks the changes.
+func (b *bulkSimRunner) runConsumesSearch(ctx context.Context, iterations int64, progress chan *proto.ProgressMetrics) (*proto.BulkSimResult, error) {
+	settings := b.Request.BulkSettings.ConsumesSearch
+
+	baseRequest := b.Request.BaseSettings
+	baseRoots := consumeRoots(baseRequest)
+	player := baseRequest.Raid.Parties[0].Players[0]
+
+	var fields []consumeField
+	for _, prefix := range []string{"consumes", "individual_buffs", "raid_buffs"} {
+		for _, field := range listConsumeFields(prefix, baseRoots[prefix].Descriptor(), nil) {
+			if len(settings.Fields) == 0 || slices.Contains(settings.Fields, field.path) {
+				fields = append(fields, field)
+			}
+		}
+	}
+	for _, path := range settings.Fields {
+		if !slices.ContainsFunc(fields, func(field consumeField) bool { return field.path == path }) {
+			return nil, fmt.Errorf("unknown consumes search field %s", path)
+		}
+	}
+
+	goldCost := func(path string, value int32) float64 {
+		for _, cost := range settings.Costs {
+			if cost.Field == path && cost.Value == value {
+				return cost.Gold
+			}
+		}
+		return 0
+	}
+
+	// Each change is a copy of the base request with one field changed.
+	changes := map[*proto.RaidSimRequest]*proto.ConsumeChange{}
+	sims := []singleBulkSim{{req: baseRequest, cl: &raidSimRequestChangeLog{}, eq: &equipmentSubstitution{}}}
+	for _, field := range fields {
+		root, _, _ := strings.Cut(field.path, ".")
+		current := field.get(baseRoots[root])
+
+		for _, value := range field.values(current) {
+			// Consumables the player can't use are never simmed.
+			if !canUseConsume(player, field.path, value) {
+				continue
+			}
+
+			req, changeLog := createNewRequestWithSubstitution(baseRequest, &equipmentSubstitution{}, false)
+			field.set(consumeRoots(req)[root], value)
+			changes[req] = &proto.ConsumeChange{
+				Field:              field.path,
+				OldValue:           current,
+				NewValue:           value,
+				OldValueName:       field.valueName(current),
+				NewValueName:       field.valueName(value),
+				GoldCostDifference: goldCost(field.path, value) - goldCost(field.path, current),
+			}
+			sims = append(sims, singleBulkSim{req: req, cl: changeLog, eq: &equipmentSubstitution{}})
+		}
+	}
+
+	results, _, err := b.getRankedResults(ctx, sims, iterations, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	baseIdx := slices.IndexFunc(results, func(r *itemSubstitutionSimResult) bool { return r.Request == baseRequest })
+	if baseIdx == -1 {
+		if ctx.Err() != nil {
+			return &proto.BulkSimResult{Cancelled: true}, nil
+		}
+		return nil, fmt.Errorf("no base result found in consumes search")
+	}
+	baseResult := results[baseIdx]
+	bestResult := results[0]
+
+	result := &proto.BulkSimResult{
+		Cancelled:          ctx.Err() != nil,
+		EquippedGearResult: newBulkComboResult(baseResult, baseResult, bestResult),
+	}
+	for _, r := range results {
+		change, ok := changes[r.Request]
+		if !ok {
+			continue
+		}
+		comboResult := newBulkComboResult(r, baseResult, bestResult)
+		if change.GoldCostDifference != 0 && comboResult.DifferenceToEquippedGear != nil {
+			change.DpsPerGold = comboResult.DifferenceToEquippedGear.Mean / change.GoldCostDifference
+		}
+		comboResult.ConsumeChange = change
+		result.Results = append(result.Results, comboResult)
+	}
+
+	if settings.RankByDpsPerGold {
+		// Changes without a cost difference can't be ranked by DPS per gold, so they go last.
+		sort.SliceStable(result.Results, func(i, j int) bool {
+			ci, cj := result.Results[i].ConsumeChange, result.Results[j].ConsumeChange
+			if (ci.GoldCostDifference == 0) != (cj.GoldCostDifference == 0) {
+				return cj.GoldCostDifference == 0
+			}
+			return ci.DpsPerGold > cj.DpsPerGold
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func TestConsumeFields(t *testing.T) {
+	request := &proto.RaidSimRequest{
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{{Players: []*proto.Player{{
+				Consumes: &proto.Consumes{Flask: proto.Flask_FlaskOfSupremePower},
+			}}}},
+		},
+	}
+	roots := consumeRoots(request)
+
+	fieldsByPath := map[string]consumeField{}
+	for _, prefix := range []string{"consumes", "individual_buffs", "raid_buffs"} {
+		for _, field := range listConsumeFields(prefix, roots[prefix].Descriptor(), nil) {
+			fieldsByPath[field.path] = field
+		}
+	}
+
+	// Enums, nested messages and toggles are all searchable.
+	flask, ok := fieldsByPath["consumes.flask"]
+	if !ok {
+		t.Fatalf("Expected consumes.flask to be searchable")
+	}
+	boglingRoot, ok := fieldsByPath["consumes.misc_consumes.bogling_root"]
+	if !ok {
+		t.Fatalf("Expected consumes.misc_consumes.bogling_root to be searchable")
+	}
+	if _, ok := fieldsByPath["raid_buffs.gift_of_the_wild"]; !ok {
+		t.Errorf("Expected raid_buffs.gift_of_the_wild to be searchable")
+	}
+
+	current := flask.get(roots["consumes"])
+	if current != int32(proto.Flask_FlaskOfSupremePower) {
+		t.Errorf("Got flask %d, want %d", current, proto.Flask_FlaskOfSupremePower)
+	}
+	values := flask.values(current)
+	if !slices.Contains(values, int32(proto.Flask_FlaskUnknown)) || slices.Contains(values, current) {
+		t.Errorf("Expected every other flask including none, got %v", values)
+	}
+	if name := flask.valueName(int32(proto.Flask_FlaskUnknown)); name != "FlaskUnknown" {
+		t.Errorf("Got value name %s, want FlaskUnknown", name)
+	}
+
+	// Toggles are flipped, creating the nested message as needed.
+	if values := boglingRoot.values(boglingRoot.get(roots["consumes"])); !slices.Equal(values, []int32{1}) {
+		t.Fatalf("Expected bogling root to be toggled on, got %v", values)
+	}
+	boglingRoot.set(roots["consumes"], 1)
+	if !request.Raid.Parties[0].Players[0].Consumes.GetMiscConsumes().GetBoglingRoot() {
+		t.Errorf("Expected bogling root to be set")
+	}
+}
+
+func TestCanUseConsume(t *testing.T) {
+	warrior := &proto.Player{Class: proto.Class_ClassWarrior, Level: 60}
+	mage := &proto.Player{Class: proto.Class_ClassMage, Level: 25, Profession1: proto.Profession_Alchemy}
+
+	for _, tc := range []struct {
+		comment string
+		player  *proto.Player
+		path    string
+		value   int32
+		want    bool
+	}{
+		{"Rage potions are for warriors", warrior, "consumes.default_potion", int32(proto.Potions_MightyRagePotion), true},
+		{"Mages have no rage", mage, "consumes.default_potion", int32(proto.Potions_RagePotion), false},
+		{"Flasks need level 51", mage, "consumes.flask", int32(proto.Flask_FlaskOfSupremePower), false},
+		{"Alchemist flasks need alchemy", warrior, "consumes.flask", int32(proto.Flask_FlaskOfRestlessDreams), false},
+		{"Level 60 flasks", warrior, "consumes.flask", int32(proto.Flask_FlaskOfTheTitans), true},
+		{"Poisons are for rogues", warrior, "consumes.off_hand_imbue", int32(proto.WeaponImbue_InstantPoison), false},
+		{"Removing a consumable is always possible", mage, "consumes.flask", int32(proto.Flask_FlaskUnknown), true},
+		{"Unrestricted values", mage, "consumes.misc_consumes.bogling_root", 1, true},
+	} {
+		if got := canUseConsume(tc.player, tc.path, tc.value); got != tc.want {
+			t.Errorf("%s: canUseConsume() = %v, want %v", tc.comment, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Convergence is checked after every this many iterations. Shards are a multiple
+// of this size, so when a sim converges doesn't depend on how it was sharded.
+const convergenceCheckInterval = 100
+
+// Returns the metric whose standard error decides when the sim has converged.
+// This is the raid's DPS if no convergence options are set.
+func (sim *Simulation) convergenceMetric() *DistributionMetrics {
+	convergence := sim.Options.Convergence
+	if convergence == nil {
+		return &sim.Raid.dpsMetrics
+	}
+
+	if convergence.Unit == nil {
+		switch convergence.Metric {
+		case proto.ConvergenceOptions_Dps:
+			return &sim.Raid.dpsMetrics
+		case proto.ConvergenceOptions_Hps:
+			return &sim.Raid.hpsMetrics
+		}
+		panic(fmt.Sprintf("Convergence on %s requires a unit", convergence.Metric))
+	}
+
+	unit := sim.GetUnit(convergence.Unit, nil)
+	if unit == nil {
+		panic(fmt.Sprintf("Invalid unit for convergence: %s", convergence.Unit))
+	}
+	switch convergence.Metric {
+	case proto.ConvergenceOptions_Hps:
+		return &unit.Metrics.hps
+	case proto.ConvergenceOptions_Tps:
+		return &unit.Metrics.threat
+	default:
+		return &unit.Metrics.dps
+	}
+}
+
+// Returns whether the tracked metric is known precisely enough to stop running iterations.
+func (sim *Simulation) hasConverged() bool {
+	convergence := sim.Options.Convergence
+	if convergence == nil {
+		return false
+	}
+
+	metric := sim.convergenceMetric()
+	if metric.n < 2 || int32(metric.n) < convergence.MinIterations {
+		return false
+	}
+	return metric.stdError() <= convergence.TargetStdError
+}
+
+// runIterationsUntilConverged runs iterations [start, end) like runIterations, but
+// stops early once the sim has converged.
+//
+// Returns the combined duration and number of iterations run, and whether the sim converged.
+func (sim *Simulation) runIterationsUntilConverged(ctx context.Context, start int32, end int32, completedIterations *int32) (time.Duration, int32, bool) {
+	if sim.Options.Convergence == nil {
+		duration, numIterations := sim.runIterations(ctx, start, end, completedIterations)
+		return duration, numIterations, false
+	}
+
+	var totalDuration time.Duration
+	var totalIterations int32
+	for batchStart := start; batchStart < end; {
+		batchEnd := min((batchStart/convergenceCheckInterval+1)*convergenceCheckInterval, end)
+		duration, numIterations := sim.runIterations(ctx, batchStart, batchEnd, completedIterations)
+		totalDuration += duration
+		totalIterations += numIterations
+
+		if numIterations < batchEnd-batchStart {
+			// Cancelled.
+			break
+		}
+		if sim.hasConverged() {
+			return totalDuration, totalIterations, true
+		}
+		batchStart = batchEnd
+	}
+	return totalDuration, totalIterations, false
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func newConvergenceTestRequest(maxIterations int32, targetStdError float64) *proto.RaidSimRequest {
+	rsr := newMeleeTargetDummyRequest(maxIterations, 101)
+	rsr.SimOptions.Convergence = &proto.ConvergenceOptions{
+		TargetStdError: targetStdError,
+		MinIterations:  300,
+		Metric:         proto.ConvergenceOptions_Dps,
+		Unit:           &proto.UnitReference{Type: proto.UnitReference_Target, Index: 0},
+	}
+	return rsr
+}
+
+func TestConvergenceStopsEarly(t *testing.T) {
+	defer func(oldWorkers int) { numShardWorkers = oldWorkers }(numShardWorkers)
+
+	// Converging in the first shard, and in a later one.
+	for _, targetStdError := range []float64{0.15, 0.1} {
+		var results []*proto.RaidSimResult
+		for _, workers := range []int{1, 4} {
+			numShardWorkers = workers
+			result := RunRaidSim(newConvergenceTestRequest(iterationsPerShard*20, targetStdError))
+			if result.ErrorResult != "" {
+				t.Fatalf("Sim failed: %s", result.ErrorResult)
+			}
+			if result.Cancelled || result.CompletedIterations >= iterationsPerShard*20 || result.CompletedIterations%convergenceCheckInterval != 0 {
+				t.Fatalf("Expected the sim to stop early, after a convergence check, but ran %d iterations", result.CompletedIterations)
+			}
+			if result.ConfidenceInterval.StdError > targetStdError {
+				t.Fatalf("Expected standard error at most %f, got %f", targetStdError, result.ConfidenceInterval.StdError)
+			}
+			results = append(results, result)
+		}
+
+		if results[0].CompletedIterations != results[1].CompletedIterations ||
+			!googleProto.Equal(results[0].EncounterMetrics.Targets[0].Dps, results[1].EncounterMetrics.Targets[0].Dps) {
+			t.Fatalf("Results with 4 workers differ from results with 1 worker")
+		}
+	}
+}
+
+func TestConvergenceRespectsMaxIterations(t *testing.T) {
+	result := RunRaidSim(newConvergenceTestRequest(1500, 0.001))
+	if result.ErrorResult != "" {
+		t.Fatalf("Sim failed: %s", result.ErrorResult)
+	}
+	if result.Cancelled || result.CompletedIterations != 1500 {
+		t.Fatalf("Expected all 1500 iterations to run, got %d", result.CompletedIterations)
+	}
+}
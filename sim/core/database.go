@@ -15,6 +15,8 @@ var rwMutex = sync.RWMutex{}
 var ItemsByID = map[int32]Item{}
 var RandomSuffixesByID = map[int32]RandomSuffix{}
 var EnchantsByEffectID = map[int32]Enchant{}
+var RunesByID = map[int32]Rune{}
+var ClassProficienciesByClass = map[proto.Class]*proto.ClassProficiency{}
 
 func addToDatabase(newDB *proto.SimDatabase) {
 	for _, v := range newDB.Items {
@@ -42,7 +44,19 @@ func addToDatabase(newDB *proto.SimDatabase) {
 	}
 
 	for _, v := range newDB.Runes {
-		fmt.Println(RuneFromProto(v))
+		rwMutex.Lock()
+		if _, ok := RunesByID[v.Id]; !ok {
+			RunesByID[v.Id] = RuneFromProto(v)
+		}
+		rwMutex.Unlock()
+	}
+
+	for _, v := range newDB.ClassProficiencies {
+		rwMutex.Lock()
+		if _, ok := ClassProficienciesByClass[v.Class]; !ok {
+			ClassProficienciesByClass[v.Class] = v
+		}
+		rwMutex.Unlock()
 	}
 }
 
@@ -67,6 +81,13 @@ type Item struct {
 	SetName      string // Empty string if not part of a set.
 	WeaponSkills stats.WeaponSkills
 
+	// Only used for filtering the database, e.g. by the gear optimizer.
+	Ilvl          int32
+	Phase         int32
+	Unique        bool
+	Sources       []proto.ItemSourceType
+	SourceZoneIDs []int32
+
 	// Modified for each instance of the item.
 	RandomSuffix RandomSuffix
 	Enchant      Enchant
@@ -93,6 +114,12 @@ func ItemFromProto(pData *proto.SimItem) Item {
 		Stats:            stats.FromFloatArray(pData.Stats),
 		SetName:          pData.SetName,
 		WeaponSkills:     stats.WeaponSkillsFloatArray(pData.WeaponSkills),
+		Quality:          pData.Quality,
+		Ilvl:             pData.Ilvl,
+		Phase:            pData.Phase,
+		Unique:           pData.Unique,
+		Sources:          pData.Sources,
+		SourceZoneIDs:    pData.SourceZoneIds,
 	}
 }
 
@@ -133,12 +160,18 @@ func EnchantFromProto(pData *proto.SimEnchant) Enchant {
 }
 
 type Rune struct {
-	ID int32
+	ID             int32
+	Type           proto.ItemType
+	RequiresLevel  int32
+	ClassAllowlist []proto.Class
 }
 
 func RuneFromProto(pData *proto.SimRune) Rune {
 	return Rune{
-		ID: pData.Id,
+		ID:             pData.Id,
+		Type:           pData.Type,
+		RequiresLevel:  pData.RequiresLevel,
+		ClassAllowlist: pData.ClassAllowlist,
 	}
 }
 
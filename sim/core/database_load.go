@@ -17,9 +17,13 @@ func init() {
 		Items:          make([]*proto.SimItem, len(db.Items)),
 		Enchants:       make([]*proto.SimEnchant, len(db.Enchants)),
 		RandomSuffixes: make([]*proto.ItemRandomSuffix, len(db.RandomSuffixes)),
+		Runes:          make([]*proto.SimRune, len(db.Runes)),
+
+		ClassProficiencies: db.ClassProficiencies,
 	}
 
 	for i, item := range db.Items {
+		sources, sourceZoneIDs := simItemSources(item.Sources)
 		simDB.Items[i] = &proto.SimItem{
 			Id:               item.Id,
 			RequiresLevel:    item.RequiresLevel,
@@ -36,6 +40,12 @@ func init() {
 			WeaponSpeed:      item.WeaponSpeed,
 			SetName:          item.SetName,
 			WeaponSkills:     item.WeaponSkills,
+			Ilvl:             item.Ilvl,
+			Phase:            item.Phase,
+			Quality:          item.Quality,
+			Unique:           item.Unique,
+			Sources:          sources,
+			SourceZoneIds:    sourceZoneIDs,
 		}
 	}
 
@@ -54,5 +64,40 @@ func init() {
 		}
 	}
 
+	for i, r := range db.Runes {
+		simDB.Runes[i] = &proto.SimRune{
+			Id:             r.Id,
+			Type:           r.Type,
+			RequiresLevel:  r.RequiresLevel,
+			ClassAllowlist: r.ClassAllowlist,
+		}
+	}
+
 	addToDatabase(simDB)
 }
+
+func simItemSources(uiSources []*proto.UIItemSource) ([]proto.ItemSourceType, []int32) {
+	var sources []proto.ItemSourceType
+	var zoneIDs []int32
+	for _, source := range uiSources {
+		switch src := source.Source.(type) {
+		case *proto.UIItemSource_Crafted:
+			sources = append(sources, proto.ItemSourceType_ItemSourceTypeCrafted)
+		case *proto.UIItemSource_Drop:
+			sources = append(sources, proto.ItemSourceType_ItemSourceTypeDrop)
+			if src.Drop.ZoneId != 0 {
+				zoneIDs = append(zoneIDs, src.Drop.ZoneId)
+			}
+		case *proto.UIItemSource_Quest:
+			sources = append(sources, proto.ItemSourceType_ItemSourceTypeQuest)
+		case *proto.UIItemSource_SoldBy:
+			sources = append(sources, proto.ItemSourceType_ItemSourceTypeSoldBy)
+			if src.SoldBy.ZoneId != 0 {
+				zoneIDs = append(zoneIDs, src.SoldBy.ZoneId)
+			}
+		case *proto.UIItemSource_Rep:
+			sources = append(sources, proto.ItemSourceType_ItemSourceTypeRep)
+		}
+	}
+	return sources, zoneIDs
+}
@@ -279,23 +279,24 @@ func ExternalIsbCaster(_ *proto.Debuffs, target *Unit) {
 	baseStacks := TernaryInt32(isbConfig.hasShadowflameRune, ISBNumStacksShadowflame, ISBNumStacksBase)
 	isbAura := ImprovedShadowBoltAura(target, 5, baseStacks)
 	isbCrit := isbConfig.casterCrit / 100.0
+	periodicOptions := PeriodicActionOptions{
+		Period: DurationFromSeconds(isbConfig.shadowBoltFrequency),
+		OnAction: func(sim *Simulation) {
+			for i := 0; i < int(isbConfig.isbWarlocks); i++ {
+				if sim.Proc(isbCrit, "External Isb Crit") {
+					isbAura.Activate(sim)
+					isbAura.SetStacks(sim, baseStacks)
+				} else if isbAura.IsActive() {
+					isbAura.RemoveStack(sim)
+				}
+			}
+		},
+	}
 	var pa *PendingAction
 	MakePermanent(target.GetOrRegisterAura(Aura{
 		Label: "Isb External Proc Aura",
 		OnGain: func(aura *Aura, sim *Simulation) {
-			pa = NewPeriodicAction(sim, PeriodicActionOptions{
-				Period: DurationFromSeconds(isbConfig.shadowBoltFrequency),
-				OnAction: func(s *Simulation) {
-					for i := 0; i < int(isbConfig.isbWarlocks); i++ {
-						if sim.Proc(isbCrit, "External Isb Crit") {
-							isbAura.Activate(sim)
-							isbAura.SetStacks(sim, baseStacks)
-						} else if isbAura.IsActive() {
-							isbAura.RemoveStack(sim)
-						}
-					}
-				},
-			})
+			pa = ReusePeriodicAction(sim, pa, periodicOptions)
 			sim.AddPendingAction(pa)
 		},
 		OnExpire: func(aura *Aura, sim *Simulation) {
@@ -410,9 +411,11 @@ func ShadowWeavingAura(unit *Unit, rank int) *Aura {
 }
 
 func SchedulePeriodicDebuffApplication(aura *Aura, options PeriodicActionOptions, _ *proto.Raid) {
+	var pa *PendingAction
 	aura.OnReset = func(aura *Aura, sim *Simulation) {
 		aura.Duration = NeverExpires
-		StartPeriodicAction(sim, options)
+		pa = ReusePeriodicAction(sim, pa, options)
+		sim.AddPendingAction(pa)
 	}
 }
 
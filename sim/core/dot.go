@@ -52,6 +52,10 @@ type Dot struct {
 	tickAction *PendingAction
 	tickPeriod time.Duration
 
+	// Options for tickAction, and the last tickAction for reuse.
+	tickOptions        PeriodicActionOptions
+	reusableTickAction *PendingAction
+
 	// Number of ticks since last call to Apply().
 	TickCount int32
 
@@ -109,9 +113,7 @@ func (dot *Dot) Rollover(sim *Simulation) {
 	dot.tickAction.Cancel(sim) // remove old PA ticker
 
 	// recreate with new period, resetting the next tick.
-	periodicOptions := dot.basePeriodicOptions()
-	periodicOptions.Period = dot.tickPeriod
-	dot.tickAction = NewPeriodicAction(sim, periodicOptions)
+	dot.tickAction = dot.newTickAction(sim)
 	dot.tickAction.NextActionAt = oldNextTick
 	sim.AddPendingAction(dot.tickAction)
 }
@@ -122,9 +124,7 @@ func (dot *Dot) RescheduleNextTick(sim *Simulation) {
 	dot.tickAction.Cancel(sim) // remove old PA ticker
 
 	// recreate with new period, resetting the next tick.
-	periodicOptions := dot.basePeriodicOptions()
-	periodicOptions.Period = dot.tickPeriod
-	dot.tickAction = NewPeriodicAction(sim, periodicOptions)
+	dot.tickAction = dot.newTickAction(sim)
 	dot.tickAction.NextActionAt = dot.lastTickTime + dot.tickPeriod
 	sim.AddPendingAction(dot.tickAction)
 }
@@ -159,9 +159,7 @@ func (dot *Dot) ApplyOrReset(sim *Simulation) {
 	oldTickAction.Cancel(sim) // remove old PA ticker
 
 	// recreate with new period, resetting the next tick.
-	periodicOptions := dot.basePeriodicOptions()
-	periodicOptions.Period = dot.tickPeriod
-	dot.tickAction = NewPeriodicAction(sim, periodicOptions)
+	dot.tickAction = dot.newTickAction(sim)
 	sim.AddPendingAction(dot.tickAction)
 }
 
@@ -237,8 +235,21 @@ func (dot *Dot) ManualTick(sim *Simulation) {
 	}
 }
 
-func (dot *Dot) basePeriodicOptions() PeriodicActionOptions {
-	return PeriodicActionOptions{
+// Returns a new periodic action ticking the dot every tickPeriod.
+func (dot *Dot) newTickAction(sim *Simulation) *PendingAction {
+	dot.tickOptions.Period = dot.tickPeriod
+	dot.reusableTickAction = ReusePeriodicAction(sim, dot.reusableTickAction, dot.tickOptions)
+	return dot.reusableTickAction
+}
+
+func newDot(config Dot) *Dot {
+	dot := &Dot{}
+	*dot = config
+
+	dot.tickPeriod = dot.TickLength
+	dot.Aura.Duration = dot.TickLength * time.Duration(dot.NumberOfTicks)
+
+	dot.tickOptions = PeriodicActionOptions{
 		//Priority: ActionPriorityDOT,
 		OnAction: func(sim *Simulation) {
 			if dot.lastTickTime != sim.CurrentTime {
@@ -257,20 +268,10 @@ func (dot *Dot) basePeriodicOptions() PeriodicActionOptions {
 			}
 		},
 	}
-}
-
-func newDot(config Dot) *Dot {
-	dot := &Dot{}
-	*dot = config
-
-	dot.tickPeriod = dot.TickLength
-	dot.Aura.Duration = dot.TickLength * time.Duration(dot.NumberOfTicks)
 
 	dot.Aura.ApplyOnGain(func(aura *Aura, sim *Simulation) {
 		dot.lastTickTime = sim.CurrentTime
-		periodicOptions := dot.basePeriodicOptions()
-		periodicOptions.Period = dot.tickPeriod
-		dot.tickAction = NewPeriodicAction(sim, periodicOptions)
+		dot.tickAction = dot.newTickAction(sim)
 		sim.AddPendingAction(dot.tickAction)
 		if dot.isChanneled {
 			dot.Spell.Unit.ChanneledDot = dot
@@ -24,8 +24,9 @@ func init() {
 }
 
 type FakeAgent struct {
-	Spell *Spell
-	Dot   *Dot
+	Spell  *Spell
+	Dot    *Dot
+	Shield *Spell
 	Character
 	Init func()
 }
@@ -87,6 +88,23 @@ func NewFakeElementalShaman(char *Character, _ *proto.Player) Agent {
 			},
 		})
 		fa.Dot = fa.Spell.CurDot()
+
+		fa.Shield = fa.RegisterSpell(SpellConfig{
+			ActionID:    ActionID{SpellID: 43},
+			SpellSchool: SpellSchoolHoly,
+			ProcMask:    ProcMaskSpellHealing,
+			Flags:       SpellFlagHelpful,
+
+			DamageMultiplier: 1,
+			ThreatMultiplier: 0.5,
+
+			Shield: ShieldConfig{
+				Aura: Aura{
+					Label:    "fakeshield",
+					Duration: time.Second * 30,
+				},
+			},
+		})
 	}
 
 	return fa
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+// encounterPhases runs the phases of a multi-phase encounter. Each phase enables
+// its targets and disables all others.
+type encounterPhases struct {
+	configs []*proto.EncounterPhase
+
+	// Whether each target is active, per phase.
+	activeTargets [][]bool
+
+	current      int
+	currentStart time.Duration
+
+	// Starts the next phase, either at its start time or after its health trigger.
+	nextPhaseAction *PendingAction
+}
+
+func newEncounterPhases(configs []*proto.EncounterPhase, numTargets int) *encounterPhases {
+	ep := &encounterPhases{
+		configs: configs,
+	}
+
+	for _, config := range configs {
+		active := make([]bool, numTargets)
+		for i := range active {
+			active[i] = len(config.ActiveTargetIndices) == 0
+		}
+		for _, targetIndex := range config.ActiveTargetIndices {
+			if targetIndex >= 0 && int(targetIndex) < numTargets {
+				active[targetIndex] = true
+			}
+		}
+		ep.activeTargets = append(ep.activeTargets, active)
+	}
+
+	return ep
+}
+
+func (ep *encounterPhases) reset(sim *Simulation) {
+	ep.nextPhaseAction = nil
+	ep.startPhase(sim, 0)
+}
+
+func (ep *encounterPhases) startPhase(sim *Simulation, phase int) {
+	ep.current = phase
+	ep.currentStart = max(sim.CurrentTime, 0)
+	config := ep.configs[phase]
+
+	if sim.Log != nil {
+		sim.Log("Starting encounter phase %d (%s)", phase+1, config.Name)
+	}
+
+	for i, target := range sim.Encounter.Targets {
+		if ep.activeTargets[phase][i] {
+			sim.Encounter.EnableTarget(sim, target)
+		} else {
+			sim.Encounter.DisableTarget(sim, target)
+		}
+	}
+
+	if config.SwitchPlayerTargets && len(sim.Encounter.ActiveTargetUnits) > 0 {
+		for _, unit := range sim.Raid.AllUnits {
+			if unit.CurrentTarget != sim.Encounter.ActiveTargetUnits[0] {
+				unit.changeTarget(sim, sim.Encounter.ActiveTargetUnits[0])
+			}
+		}
+	}
+
+	ep.nextPhaseAction = nil
+	if phase+1 >= len(ep.configs) {
+		return
+	}
+
+	next := ep.configs[phase+1]
+	if next.StartTime > 0 || next.StartHealthPercent <= 0 {
+		ep.scheduleNextPhase(sim, max(DurationFromSeconds(next.StartTime), sim.CurrentTime))
+	}
+	ep.checkHealthTrigger(sim)
+}
+
+func (ep *encounterPhases) scheduleNextPhase(sim *Simulation, at time.Duration) {
+	if ep.nextPhaseAction != nil {
+		ep.nextPhaseAction.Cancel(sim)
+	}
+
+	nextPhase := ep.current + 1
+	ep.nextPhaseAction = &PendingAction{
+		NextActionAt: at,
+		Priority:     ActionPriorityDOT,
+		OnAction: func(sim *Simulation) {
+			ep.startPhase(sim, nextPhase)
+		},
+	}
+	sim.AddPendingAction(ep.nextPhaseAction)
+}
+
+// Returns the index of the target whose health triggers the next phase, or -1.
+func (ep *encounterPhases) healthTriggerTarget(sim *Simulation) int32 {
+	if ep.current+1 >= len(ep.configs) {
+		return -1
+	}
+	next := ep.configs[ep.current+1]
+	if next.StartHealthPercent <= 0 || next.HealthTargetIndex < 0 || next.HealthTargetIndex >= sim.GetNumTargets() {
+		return -1
+	}
+	return next.HealthTargetIndex
+}
+
+// Damage the health trigger target still has to take before the next phase starts.
+func (ep *encounterPhases) damageUntilHealthTrigger(sim *Simulation, targetIndex int32) float64 {
+	maxHealth := sim.GetTarget(targetIndex).GetStat(stats.Health)
+	threshold := (1 - ep.configs[ep.current+1].StartHealthPercent) * maxHealth
+	return threshold - sim.Encounter.Targets[targetIndex].damageTaken
+}
+
+func (ep *encounterPhases) onDamageTaken(sim *Simulation, target *Unit) {
+	if target.Index == ep.healthTriggerTarget(sim) {
+		ep.checkHealthTrigger(sim)
+	}
+}
+
+func (ep *encounterPhases) checkHealthTrigger(sim *Simulation) {
+	targetIndex := ep.healthTriggerTarget(sim)
+	if targetIndex == -1 || sim.GetTarget(targetIndex).GetStat(stats.Health) <= 0 {
+		return
+	}
+	if ep.nextPhaseAction != nil && ep.nextPhaseAction.NextActionAt <= sim.CurrentTime {
+		return
+	}
+
+	// Phase changes disable targets, so don't change phases in the middle of a spell.
+	if ep.damageUntilHealthTrigger(sim, targetIndex) <= 0 {
+		ep.scheduleNextPhase(sim, max(sim.CurrentTime, 0))
+	}
+}
+
+func (ep *encounterPhases) timeUntilNextPhase(sim *Simulation) time.Duration {
+	remaining := sim.GetRemainingDuration()
+	if ep.current+1 >= len(ep.configs) {
+		return remaining
+	}
+
+	if ep.nextPhaseAction != nil {
+		remaining = min(remaining, max(ep.nextPhaseAction.NextActionAt-sim.CurrentTime, 0))
+	}
+
+	// Estimate when the health trigger will be reached based on the damage taken so far.
+	if targetIndex := ep.healthTriggerTarget(sim); targetIndex != -1 && sim.CurrentTime > 0 {
+		dps := sim.Encounter.Targets[targetIndex].damageTaken / sim.CurrentTime.Seconds()
+		if dps > 0 {
+			remaining = min(remaining, DurationFromSeconds(max(ep.damageUntilHealthTrigger(sim, targetIndex), 0)/dps))
+		}
+	}
+
+	return remaining
+}
+
+// CurrentPhase returns the index of the current encounter phase, or 0 if the
+// encounter doesn't have phases.
+func (encounter *Encounter) CurrentPhase() int {
+	if encounter.phases == nil {
+		return 0
+	}
+	return encounter.phases.current
+}
+
+// TimeUntilNextPhase returns the estimated time until the next encounter phase
+// starts, or the remaining duration if there are no more phases.
+func (sim *Simulation) TimeUntilNextPhase() time.Duration {
+	if sim.Encounter.phases == nil {
+		return sim.GetRemainingDuration()
+	}
+	return sim.Encounter.phases.timeUntilNextPhase(sim)
+}
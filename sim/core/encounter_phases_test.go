@@ -0,0 +1,147 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+func setupPhasedSim() *Simulation {
+	target := func(name string) *proto.Target {
+		return &proto.Target{Name: name, Level: 63, Stats: stats.Stats{stats.Health: 1000}.ToFloatArray()}
+	}
+
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 100,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: []*proto.Player{
+						{
+							Name:      "Caster",
+							Class:     proto.Class_ClassShaman,
+							Consumes:  &proto.Consumes{},
+							Buffs:     &proto.IndividualBuffs{},
+							Spec:      &proto.Player_ElementalShaman{},
+							Equipment: &proto.EquipmentSpec{},
+						},
+					},
+					Buffs: &proto.PartyBuffs{},
+				},
+			},
+		},
+		Encounter: &proto.Encounter{
+			Targets:  []*proto.Target{target("boss"), target("add 1"), target("add 2")},
+			Duration: 180,
+			Phases: []*proto.EncounterPhase{
+				{Name: "Boss", ActiveTargetIndices: []int32{0}},
+				{Name: "Adds", StartTime: 30, ActiveTargetIndices: []int32{1, 2}, SwitchPlayerTargets: true},
+				{Name: "Boss again", StartHealthPercent: 0.5, HealthTargetIndex: 1, ActiveTargetIndices: []int32{0}},
+			},
+		},
+	})
+	sim.Reset()
+
+	return sim
+}
+
+func expectActiveTargets(t *testing.T, sim *Simulation, expected ...int32) {
+	t.Helper()
+	var actual []int32
+	for _, target := range sim.Encounter.ActiveTargets {
+		actual = append(actual, target.Index)
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected active targets %v, got %v", expected, actual)
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			t.Fatalf("Expected active targets %v, got %v", expected, actual)
+		}
+	}
+}
+
+func TestEncounterPhases(t *testing.T) {
+	sim := setupPhasedSim()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+
+	expectActiveTargets(t, sim, 0)
+	if fa.CurrentTarget != sim.GetTargetUnit(0) {
+		t.Fatalf("Expected the player to target the boss")
+	}
+	if sim.Encounter.AOECapMultiplier() != 1 {
+		t.Fatalf("Expected no AoE cap with 1 active target")
+	}
+	fa.Spell.DefenseType = DefenseTypeMagic
+	if fa.Spell.CanCast(sim, sim.GetTargetUnit(1)) {
+		t.Fatalf("Expected inactive targets to be untargetable")
+	}
+	if remaining := sim.TimeUntilNextPhase(); remaining != time.Second*30 {
+		t.Fatalf("Expected the next phase in 30s, got %s", remaining)
+	}
+
+	// The second phase starts on a timer and switches targets.
+	for sim.Encounter.CurrentPhase() == 0 {
+		sim.Step()
+	}
+	if sim.CurrentTime != time.Second*30 {
+		t.Fatalf("Expected the second phase to start at 30s, got %s", sim.CurrentTime)
+	}
+	expectActiveTargets(t, sim, 1, 2)
+	if fa.CurrentTarget != sim.GetTargetUnit(1) {
+		t.Fatalf("Expected the player to switch to the first add")
+	}
+	if next := sim.Environment.NextTargetUnit(sim.GetTargetUnit(2)); next != sim.GetTargetUnit(1) {
+		t.Fatalf("Expected the next target to skip the inactive boss, got %s", next.Label)
+	}
+
+	// The third phase starts once the first add is at half health.
+	fa.Spell.CalcAndDealDamage(sim, sim.GetTargetUnit(1), 200, fa.Spell.OutcomeAlwaysHit)
+	sim.Step()
+	if sim.Encounter.CurrentPhase() != 1 {
+		t.Fatalf("Expected the phase not to change before the health trigger")
+	}
+	fa.Spell.CalcAndDealDamage(sim, sim.GetTargetUnit(1), 200, fa.Spell.OutcomeAlwaysHit)
+	for sim.Encounter.CurrentPhase() == 1 {
+		sim.Step()
+	}
+	expectActiveTargets(t, sim, 0)
+	if fa.CurrentTarget != sim.GetTargetUnit(0) {
+		t.Fatalf("Expected the player to switch away from the despawned add")
+	}
+
+	// Phases start over on the next iteration.
+	sim.Cleanup()
+	sim.Reset()
+	expectActiveTargets(t, sim, 0)
+	if sim.Encounter.CurrentPhase() != 0 {
+		t.Fatalf("Expected the first phase after a reset")
+	}
+}
+
+func TestDisableTargetKeepsPermanentAuras(t *testing.T) {
+	sim := setupPhasedSim()
+	boss := sim.Encounter.Targets[0]
+
+	permanent := boss.RegisterAura(Aura{Label: "Permanent Debuff", Duration: NeverExpires})
+	temporary := boss.RegisterAura(Aura{Label: "Temporary Debuff", Duration: time.Second * 10})
+	permanent.Activate(sim)
+	temporary.Activate(sim)
+
+	sim.Encounter.DisableTarget(sim, boss)
+	if temporary.IsActive() {
+		t.Fatalf("Expected temporary auras to expire when the target is disabled")
+	}
+	if !permanent.IsActive() {
+		t.Fatalf("Expected permanent auras to stay active when the target is disabled")
+	}
+
+	sim.Encounter.EnableTarget(sim, boss)
+	if !permanent.IsActive() {
+		t.Fatalf("Expected permanent auras to be active when the target is enabled again")
+	}
+}
@@ -158,7 +158,7 @@ func (eb *energyBar) addEnergyInternal(sim *Simulation, amount float64, metrics
 	metrics.AddEvent(amount, newEnergy-eb.currentEnergy)
 
 	if sim.Log != nil {
-		eb.unit.Log(sim, "Gained %0.3f energy from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, eb.currentEnergy, newEnergy)
+		sim.logResourceChange(eb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeEnergy, amount, eb.currentEnergy, newEnergy)
 	}
 
 	crossedThreshold := eb.cumulativeEnergyDecisionThresholds == nil || eb.cumulativeEnergyDecisionThresholds[int(eb.currentEnergy)] != eb.cumulativeEnergyDecisionThresholds[int(newEnergy)]
@@ -180,7 +180,7 @@ func (eb *energyBar) SpendEnergy(sim *Simulation, amount float64, metrics *Resou
 	metrics.AddEvent(-amount, -amount)
 
 	if sim.Log != nil {
-		eb.unit.Log(sim, "Spent %0.3f energy from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, eb.currentEnergy, newEnergy)
+		sim.logResourceChange(eb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeEnergy, -amount, eb.currentEnergy, newEnergy)
 	}
 
 	eb.currentEnergy = newEnergy
@@ -207,7 +207,7 @@ func (eb *energyBar) AddComboPoints(sim *Simulation, pointsToAdd int32, metrics
 	metrics.AddEvent(float64(pointsToAdd), float64(newComboPoints-eb.comboPoints))
 
 	if sim.Log != nil {
-		eb.unit.Log(sim, "Gained %d combo points from %s (%d --> %d)", pointsToAdd, metrics.ActionID, eb.comboPoints, newComboPoints)
+		sim.logResourceChange(eb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeComboPoints, float64(pointsToAdd), float64(eb.comboPoints), float64(newComboPoints))
 	}
 
 	eb.comboPoints = newComboPoints
@@ -221,7 +221,7 @@ func (eb *energyBar) SpendComboPoints(sim *Simulation, spell *Spell) {
 	comboPoints := eb.comboPoints
 
 	if sim.Log != nil {
-		eb.unit.Log(sim, "Spent %d combo points from %s (%d --> %d).", comboPoints, spell.ActionID, comboPoints, 0)
+		sim.logResourceChange(eb.unit, spell.ActionID, proto.ResourceType_ResourceTypeComboPoints, -float64(comboPoints), float64(comboPoints), 0)
 	}
 	spell.ComboPointMetrics().AddEvent(float64(-comboPoints), float64(-comboPoints))
 	eb.comboPoints = 0
@@ -121,6 +121,10 @@ func (env *Environment) initialize(raidProto *proto.Raid, encounterProto *proto.
 		}
 	}
 
+	if env.Encounter.raidDamage != nil {
+		env.Encounter.raidDamage.initialize(env)
+	}
+
 	for _, party := range env.Raid.Parties {
 		for _, playerOrPet := range party.PlayersAndPets {
 			playerOrPet.GetCharacter().initialize(playerOrPet)
@@ -244,7 +248,10 @@ func (env *Environment) setupAttackTables() {
 					}
 				}
 			} else {
-				attacker.AttackTables[idx][proto.CastType_CastTypeMainHand] = NewAttackTable(attacker, defender, nil)
+				// Dual wielding enemies swing their off hand with the same table.
+				attackTable := NewAttackTable(attacker, defender, nil)
+				attacker.AttackTables[idx][proto.CastType_CastTypeMainHand] = attackTable
+				attacker.AttackTables[idx][proto.CastType_CastTypeOffHand] = attackTable
 			}
 		}
 	}
@@ -265,6 +272,9 @@ func (env *Environment) reset(sim *Simulation) {
 	}
 
 	env.Raid.reset(sim)
+
+	// Phases are applied after the raid reset, which resets player targets.
+	env.Encounter.reset(sim)
 }
 
 // The maximum possible duration for any iteration.
@@ -276,6 +286,11 @@ func (env *Environment) GetNumTargets() int32 {
 	return int32(len(env.Encounter.Targets))
 }
 
+// ActiveTargetCount returns the number of targets which are currently active.
+func (env *Environment) ActiveTargetCount() int32 {
+	return int32(len(env.Encounter.ActiveTargets))
+}
+
 func (env *Environment) GetTarget(index int32) *Target {
 	return env.Encounter.Targets[index]
 }
@@ -6,11 +6,6 @@ import (
 
 // Note that this is only used when the hardcast and GCD actions happen at different times.
 func (unit *Unit) newHardcastAction(sim *Simulation) {
-	if unit.hardcastAction != nil && !unit.hardcastAction.consumed {
-		unit.hardcastAction.Cancel(sim)
-		unit.hardcastAction = nil
-	}
-
 	if unit.hardcastAction == nil {
 		pa := &PendingAction{
 			NextActionAt: unit.Hardcast.Expires,
@@ -24,6 +19,11 @@ func (unit *Unit) newHardcastAction(sim *Simulation) {
 			},
 		}
 		unit.hardcastAction = pa
+	} else if !unit.hardcastAction.consumed {
+		// Cancelling removes the action from the queue, so it can be reused.
+		unit.hardcastAction.Cancel(sim)
+		unit.hardcastAction.cancelled = false
+		unit.hardcastAction.NextActionAt = unit.Hardcast.Expires
 	} else {
 		unit.hardcastAction.cancelled = false
 		unit.hardcastAction.NextActionAt = unit.Hardcast.Expires
@@ -47,18 +47,12 @@ func (unit *Unit) SetGCDTimer(sim *Simulation, gcdReadyAt time.Duration) {
 
 	unit.GCD.Set(gcdReadyAt)
 
-	if unit.gcdAction.consumed {
-		unit.gcdAction.cancelled = false
-		unit.gcdAction.NextActionAt = gcdReadyAt
-	} else {
+	if !unit.gcdAction.consumed {
+		// Cancelling removes the action from the queue, so it can be reused.
 		unit.gcdAction.Cancel(sim)
-		oldAction := unit.gcdAction.OnAction
-		unit.gcdAction = &PendingAction{
-			NextActionAt: gcdReadyAt,
-			Priority:     ActionPriorityGCD,
-			OnAction:     oldAction,
-		}
 	}
+	unit.gcdAction.cancelled = false
+	unit.gcdAction.NextActionAt = gcdReadyAt
 	sim.AddPendingAction(unit.gcdAction)
 }
 
@@ -0,0 +1,614 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	goproto "google.golang.org/protobuf/proto"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+const (
+	defaultCandidatesPerSlot = 4
+	defaultBeamWidth         = 3
+	defaultMaxRounds         = 8
+)
+
+// canEquipItem returns true if a player of the given class and level can wear item in slot.
+// Mirrors canEquipItem in the UI, using the class proficiencies from the database.
+func canEquipItem(item Item, class proto.Class, level int32, slot proto.ItemSlot) bool {
+	proficiency, ok := ClassProficienciesByClass[class]
+	if !ok {
+		return false
+	}
+	if len(item.ClassAllowlist) > 0 && !slices.Contains(item.ClassAllowlist, class) {
+		return false
+	}
+
+	// Some items are missing their required level, so fall back to 10 levels below their ilvl.
+	if item.RequiresLevel > level || (item.RequiresLevel == 0 && level < 60 && item.Ilvl-10 > level) {
+		return false
+	}
+
+	switch item.Type {
+	case proto.ItemType_ItemTypeFinger, proto.ItemType_ItemTypeTrinket:
+		return true
+	case proto.ItemType_ItemTypeWeapon:
+		weaponIdx := slices.IndexFunc(proficiency.Weapons, func(weapon *proto.WeaponProficiency) bool {
+			return weapon.WeaponType == item.WeaponType
+		})
+		if weaponIdx == -1 {
+			return false
+		}
+		if item.HandType == proto.HandType_HandTypeTwoHand && (!proficiency.Weapons[weaponIdx].TwoHand || slot == proto.ItemSlot_ItemSlotOffHand) {
+			return false
+		}
+		if slot == proto.ItemSlot_ItemSlotMainHand && item.HandType == proto.HandType_HandTypeOffHand {
+			return false
+		}
+		if slot == proto.ItemSlot_ItemSlotOffHand {
+			if item.HandType == proto.HandType_HandTypeMainHand {
+				return false
+			}
+			if !proficiency.CanDualWield && item.WeaponType != proto.WeaponType_WeaponTypeShield && item.WeaponType != proto.WeaponType_WeaponTypeOffHand {
+				return false
+			}
+		}
+		return true
+	case proto.ItemType_ItemTypeRanged:
+		return slices.Contains(proficiency.RangedWeaponTypes, item.RangedWeaponType)
+	}
+
+	return item.ArmorType <= proficiency.MaxArmorType
+}
+
+// itemFilterMatches returns true if item passes all the restrictions of filter.
+func itemFilterMatches(filter *proto.ItemFilter, item Item) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.MaxPhase > 0 && item.Phase > filter.MaxPhase {
+		return false
+	}
+	if item.Ilvl < filter.MinIlvl || (filter.MaxIlvl > 0 && item.Ilvl > filter.MaxIlvl) {
+		return false
+	}
+	if item.Quality < filter.MinQuality {
+		return false
+	}
+	if len(filter.Sources) > 0 && !slices.ContainsFunc(item.Sources, func(source proto.ItemSourceType) bool {
+		return slices.Contains(filter.Sources, source)
+	}) {
+		return false
+	}
+	if len(filter.ZoneIds) > 0 && !slices.ContainsFunc(item.SourceZoneIDs, func(zoneID int32) bool {
+		return slices.Contains(filter.ZoneIds, zoneID)
+	}) {
+		return false
+	}
+	return !slices.Contains(filter.ExcludedItemIds, item.ID)
+}
+
+// equipmentKey returns a key which is the same for equal gear, with rings and trinkets in either order.
+func equipmentKey(equipment *proto.EquipmentSpec) string {
+	all := &equipmentSubstitution{}
+	for slot, is := range equipment.Items {
+		if is != nil && is.Id != 0 {
+			all.Items = append(all.Items, &itemWithSlot{Item: is, Slot: proto.ItemSlot(slot)})
+		}
+	}
+	return all.CanonicalHash()
+}
+
+func GearOptimizer(ctx context.Context, request *proto.GearOptimizerRequest, progress chan *proto.ProgressMetrics) *proto.GearOptimizerResult {
+	optimizer := &gearOptimizer{
+		runner:  &bulkSimRunner{SingleRaidSimRunner: runSim, skipFailedSims: true},
+		request: request,
+	}
+
+	result, err := optimizer.Run(ctx, progress)
+	if err != nil {
+		result = &proto.GearOptimizerResult{
+			ErrorResult: err.Error(),
+		}
+	}
+
+	if progress != nil {
+		progress <- &proto.ProgressMetrics{
+			FinalGearOptimizerResult: result,
+		}
+		close(progress)
+	}
+
+	return result
+}
+
+// gearOptimizer searches the item database for the best gear of a single player.
+//
+// Stat weights pick a few candidate items per slot, then a beam search over the
+// gear sims every set which differs from one of the best sets so far by a
+// single item, or by the pieces of an item set.
+type gearOptimizer struct {
+	runner  *bulkSimRunner
+	request *proto.GearOptimizerRequest
+
+	baseRequest *proto.RaidSimRequest
+	baseItems   []*proto.ItemSpec
+
+	weights    UnitStats
+	candidates [][]Item
+	setMoves   [][]*itemWithSlot
+
+	// Keys of all gear that was already simmed.
+	seen    map[string]bool
+	simsRun int32
+}
+
+func (o *gearOptimizer) Run(ctx context.Context, progress chan *proto.ProgressMetrics) (result *proto.GearOptimizerResult, resultErr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			result = &proto.GearOptimizerResult{
+				ErrorResult: fmt.Sprintf("%v\nStack Trace:\n%s", err, string(debug.Stack())),
+			}
+		}
+	}()
+
+	var playerCount int
+	var player *proto.Player
+	for _, p := range o.request.GetBaseSettings().GetRaid().GetParties() {
+		for _, pl := range p.GetPlayers() {
+			if pl.Name != "" {
+				player = pl
+				playerCount++
+			}
+		}
+	}
+	if playerCount != 1 || player == nil {
+		return nil, fmt.Errorf("gear optimizer: expected exactly 1 player, found %d", playerCount)
+	}
+	if player.GetDatabase() != nil {
+		addToDatabase(player.GetDatabase())
+	}
+	o.request.BaseSettings.Raid.Parties = []*proto.Party{o.request.BaseSettings.Raid.Parties[0]}
+	player.Database = nil
+
+	if player.Equipment == nil {
+		player.Equipment = &proto.EquipmentSpec{}
+	}
+	for len(player.Equipment.Items) < len(proto.ItemSlot_name) {
+		player.Equipment.Items = append(player.Equipment.Items, &proto.ItemSpec{})
+	}
+	for i, is := range player.Equipment.Items {
+		if is == nil {
+			player.Equipment.Items[i] = &proto.ItemSpec{}
+		}
+	}
+	o.baseItems = player.Equipment.Items
+
+	// As in bulk sims, all gear is simmed with the same seed so results can be compared iteration by iteration.
+	simOptions := o.request.BaseSettings.SimOptions
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+	simOptions.SaveAllValues = true
+	o.baseRequest = o.request.BaseSettings
+
+	settings := o.request.GetSettings()
+	iterations := int64(settings.GetIterationsPerSim())
+	if iterations <= 0 {
+		iterations = defaultIterationsPerCombo
+	}
+	beamWidth := int(settings.GetBeamWidth())
+	if beamWidth <= 0 {
+		beamWidth = defaultBeamWidth
+	}
+	maxRounds := int(settings.GetMaxRounds())
+	if maxRounds <= 0 {
+		maxRounds = defaultMaxRounds
+	}
+
+	var items []Item
+	for _, item := range ItemsByID {
+		if itemFilterMatches(o.request.ItemFilter, item) {
+			items = append(items, item)
+		}
+	}
+	// Sort so the search doesn't depend on map order.
+	slices.SortFunc(items, func(a, b Item) int {
+		return int(a.ID - b.ID)
+	})
+
+	if o.request.StatWeights != nil {
+		o.weights = NewUnitStats()
+		copy(o.weights.Stats[:], o.request.StatWeights.Stats)
+		copy(o.weights.PseudoStats, o.request.StatWeights.PseudoStats)
+	} else {
+		o.weights = o.calcStatWeights(ctx, player, items, int32(iterations), progress)
+	}
+
+	o.findCandidates(items, player.Class, player.Level, int(settings.GetCandidatesPerSlot()))
+
+	// The forwarder is joined before returning, so it never sends on progress after the caller closes it.
+	simProgress := make(chan *proto.ProgressMetrics, 10)
+	done := make(chan struct{})
+	var forwarder sync.WaitGroup
+	forwarder.Add(1)
+	go func() {
+		defer forwarder.Done()
+		o.forwardProgress(simProgress, progress, done)
+	}()
+	defer func() {
+		close(done)
+		forwarder.Wait()
+	}()
+
+	o.seen = map[string]bool{equipmentKey(player.Equipment): true}
+	baseResults, baseResult, err := o.sim(ctx, []singleBulkSim{{
+		req: o.baseRequest,
+		cl:  &raidSimRequestChangeLog{},
+		eq:  &equipmentSubstitution{},
+	}}, iterations, simProgress)
+	if err != nil {
+		return nil, err
+	}
+	if baseResult == nil {
+		return &proto.GearOptimizerResult{Cancelled: true}, nil
+	}
+
+	beam := baseResults
+	cancelled := false
+	for round := 0; round < maxRounds; round++ {
+		neighbors := o.neighbors(beam)
+		if len(neighbors) == 0 {
+			break
+		}
+
+		results, _, err := o.sim(ctx, neighbors, iterations, simProgress)
+		if err != nil {
+			return nil, err
+		}
+		cancelled = ctx.Err() != nil
+
+		bestScore := beam[0].Score()
+		beam = append(results, beam...)
+		sort.SliceStable(beam, func(i, j int) bool {
+			return beam[i].Score() > beam[j].Score()
+		})
+		if len(beam) > beamWidth {
+			beam = beam[:beamWidth]
+		}
+
+		if cancelled || beam[0].Score() <= bestScore {
+			break
+		}
+	}
+
+	return o.newResult(beam[0], baseResult, cancelled), nil
+}
+
+// calcStatWeights runs stat weights for the equipped gear, for the stats found on any of items.
+func (o *gearOptimizer) calcStatWeights(ctx context.Context, player *proto.Player, items []Item, iterations int32, progress chan *proto.ProgressMetrics) UnitStats {
+	var itemStats stats.Stats
+	for _, item := range items {
+		for stat, value := range item.Stats {
+			if value != 0 {
+				itemStats[stat] = 1
+			}
+		}
+	}
+	var statsToWeigh []proto.Stat
+	for stat, value := range itemStats {
+		if value != 0 {
+			statsToWeigh = append(statsToWeigh, proto.Stat(stat))
+		}
+	}
+	if len(statsToWeigh) == 0 {
+		return NewUnitStats()
+	}
+
+	raid := o.baseRequest.Raid
+	simOptions := goproto.Clone(o.baseRequest.SimOptions).(*proto.SimOptions)
+	simOptions.Iterations = iterations
+	swr := &proto.StatWeightsRequest{
+		Player:          goproto.Clone(player).(*proto.Player),
+		RaidBuffs:       raid.Buffs,
+		PartyBuffs:      raid.Parties[0].Buffs,
+		Debuffs:         raid.Debuffs,
+		Encounter:       o.baseRequest.Encounter,
+		SimOptions:      simOptions,
+		Tanks:           raid.Tanks,
+		StatsToWeigh:    statsToWeigh,
+		EpReferenceStat: statsToWeigh[0],
+		PseudoStatsToWeigh: []proto.PseudoStat{
+			proto.PseudoStat_PseudoStatMainHandDps,
+			proto.PseudoStat_PseudoStatOffHandDps,
+			proto.PseudoStat_PseudoStatRangedDps,
+		},
+	}
+	result := CalcStatWeight(ctx, swr, stats.Stat(statsToWeigh[0]), progress)
+
+	// Use the weights of the metric gear is scored by.
+	switch scoreMetric(o.baseRequest) {
+	case proto.ConvergenceOptions_Hps:
+		return result.Hps.Weights
+	case proto.ConvergenceOptions_Tps:
+		return result.Tps.Weights
+	}
+	return result.Dps.Weights
+}
+
+// itemScore estimates how much item adds in slot, using the stat weights.
+func (o *gearOptimizer) itemScore(item Item, slot proto.ItemSlot) float64 {
+	var score float64
+	for stat, value := range item.Stats {
+		score += value * o.weights.Stats[stat]
+	}
+
+	if item.SwingSpeed > 0 {
+		weaponDps := (item.WeaponDamageMin + item.WeaponDamageMax) / 2 / item.SwingSpeed
+		switch slot {
+		case proto.ItemSlot_ItemSlotMainHand:
+			score += weaponDps * o.weights.PseudoStats[proto.PseudoStat_PseudoStatMainHandDps]
+		case proto.ItemSlot_ItemSlotOffHand:
+			score += weaponDps * o.weights.PseudoStats[proto.PseudoStat_PseudoStatOffHandDps]
+		case proto.ItemSlot_ItemSlotRanged:
+			score += weaponDps * o.weights.PseudoStats[proto.PseudoStat_PseudoStatRangedDps]
+		}
+	}
+	return score
+}
+
+// findCandidates picks the best items per slot by stat weights, and the item
+// sets with enough pieces among items for a set bonus.
+func (o *gearOptimizer) findCandidates(items []Item, class proto.Class, level int32, candidatesPerSlot int) {
+	if candidatesPerSlot <= 0 {
+		candidatesPerSlot = defaultCandidatesPerSlot
+	}
+
+	type scoredItem struct {
+		item  Item
+		score float64
+	}
+	bySlot := make([][]scoredItem, len(proto.ItemSlot_name))
+	for _, item := range items {
+		for _, slot := range eligibleSlotsForItem(item) {
+			if canEquipItem(item, class, level, slot) {
+				bySlot[slot] = append(bySlot[slot], scoredItem{item: item, score: o.itemScore(item, slot)})
+			}
+		}
+	}
+
+	o.candidates = make([][]Item, len(bySlot))
+	for slot, scoredItems := range bySlot {
+		sort.SliceStable(scoredItems, func(i, j int) bool {
+			return scoredItems[i].score > scoredItems[j].score
+		})
+		for i := 0; i < min(candidatesPerSlot, len(scoredItems)); i++ {
+			o.candidates[slot] = append(o.candidates[slot], scoredItems[i].item)
+		}
+	}
+
+	// Set bonuses aren't part of the stat weights, so item sets are tried as a whole.
+	type setMove struct {
+		pieces []*itemWithSlot
+		score  float64
+	}
+	var setMoves []setMove
+	for _, set := range sets {
+		bestPieces := map[proto.ItemSlot]scoredItem{}
+		for slot, scoredItems := range bySlot {
+			for _, si := range scoredItems {
+				if si.item.SetName == "" || (si.item.SetName != set.Name && si.item.SetName != set.AlternativeName) {
+					continue
+				}
+				// Rings and trinkets of a set only go in the first slot.
+				if eligibleSlotsForItem(si.item)[0] != proto.ItemSlot(slot) {
+					continue
+				}
+				if best, ok := bestPieces[proto.ItemSlot(slot)]; !ok || si.score > best.score {
+					bestPieces[proto.ItemSlot(slot)] = si
+				}
+			}
+		}
+
+		minPieces := int32(len(proto.ItemSlot_name))
+		for numPieces := range set.Bonuses {
+			minPieces = min(minPieces, numPieces)
+		}
+		if int32(len(bestPieces)) < minPieces {
+			continue
+		}
+
+		move := setMove{}
+		for slot := 0; slot < len(proto.ItemSlot_name); slot++ {
+			if si, ok := bestPieces[proto.ItemSlot(slot)]; ok {
+				move.pieces = append(move.pieces, &itemWithSlot{Item: &proto.ItemSpec{Id: si.item.ID}, Slot: proto.ItemSlot(slot)})
+				move.score += si.score
+			}
+		}
+		setMoves = append(setMoves, move)
+	}
+	sort.SliceStable(setMoves, func(i, j int) bool {
+		return setMoves[i].score > setMoves[j].score
+	})
+
+	o.setMoves = nil
+	for i := 0; i < min(candidatesPerSlot, len(setMoves)); i++ {
+		o.setMoves = append(o.setMoves, setMoves[i].pieces)
+	}
+}
+
+// itemSpec returns the spec for wearing item in slot, keeping the enchant and rune of the equipped item when possible.
+func (o *gearOptimizer) itemSpec(itemID int32, slot proto.ItemSlot) *proto.ItemSpec {
+	baseSpec := o.baseItems[slot]
+	spec := &proto.ItemSpec{
+		Id:   itemID,
+		Rune: baseSpec.Rune,
+	}
+
+	// Weapon enchants only depend on whether it's a two-hander, shield or held in off-hand item.
+	item := ItemsByID[itemID]
+	if baseItem, ok := ItemsByID[baseSpec.Id]; ok && item.Type == baseItem.Type &&
+		(item.HandType == proto.HandType_HandTypeTwoHand) == (baseItem.HandType == proto.HandType_HandTypeTwoHand) &&
+		(item.WeaponType == proto.WeaponType_WeaponTypeShield) == (baseItem.WeaponType == proto.WeaponType_WeaponTypeShield) &&
+		(item.WeaponType == proto.WeaponType_WeaponTypeOffHand) == (baseItem.WeaponType == proto.WeaponType_WeaponTypeOffHand) {
+		spec.Enchant = baseSpec.Enchant
+	}
+	return spec
+}
+
+// neighbors returns all gear which differs from the gear in beam by one candidate
+// item or set, and wasn't simmed yet.
+func (o *gearOptimizer) neighbors(beam []*itemSubstitutionSimResult) []singleBulkSim {
+	var neighbors []singleBulkSim
+
+	tryMove := func(from *itemSubstitutionSimResult, pieces []*itemWithSlot) {
+		equipment := from.Request.Raid.Parties[0].Players[0].Equipment
+		items := map[proto.ItemSlot]*proto.ItemSpec{}
+		for _, is := range from.Substitution.Items {
+			items[is.Slot] = is.Item
+		}
+
+		changed := false
+		for _, piece := range pieces {
+			if equipment.Items[piece.Slot].Id == piece.Item.Id {
+				continue
+			}
+			changed = true
+			items[piece.Slot] = o.itemSpec(piece.Item.Id, piece.Slot)
+
+			item := ItemsByID[piece.Item.Id]
+			if piece.Slot == proto.ItemSlot_ItemSlotMainHand && item.HandType == proto.HandType_HandTypeTwoHand {
+				items[proto.ItemSlot_ItemSlotOffHand] = &proto.ItemSpec{}
+			}
+			if piece.Slot == proto.ItemSlot_ItemSlotOffHand {
+				if mainHand, ok := ItemsByID[equipment.Items[proto.ItemSlot_ItemSlotMainHand].Id]; ok && mainHand.HandType == proto.HandType_HandTypeTwoHand {
+					if _, replaced := items[proto.ItemSlot_ItemSlotMainHand]; !replaced {
+						return
+					}
+				}
+			}
+		}
+		if !changed {
+			return
+		}
+
+		// Unique items can't be worn in a second slot, e.g. the same unique trinket twice.
+		for _, piece := range pieces {
+			if !ItemsByID[piece.Item.Id].Unique {
+				continue
+			}
+			for slot, is := range equipment.Items {
+				if replacement, ok := items[proto.ItemSlot(slot)]; ok {
+					is = replacement
+				}
+				if proto.ItemSlot(slot) != piece.Slot && is.GetId() == piece.Item.Id {
+					return
+				}
+			}
+		}
+
+		sub := &equipmentSubstitution{}
+		for slot := 0; slot < len(proto.ItemSlot_name); slot++ {
+			if is, ok := items[proto.ItemSlot(slot)]; ok && !goproto.Equal(is, o.baseItems[slot]) {
+				sub.Items = append(sub.Items, &itemWithSlot{Item: is, Slot: proto.ItemSlot(slot)})
+			}
+		}
+
+		req, changeLog := createNewRequestWithSubstitution(o.baseRequest, sub, false)
+		newEquipment := req.Raid.Parties[0].Players[0].Equipment
+		if !isValidEquipment(newEquipment) {
+			return
+		}
+		key := equipmentKey(newEquipment)
+		if o.seen[key] {
+			return
+		}
+		o.seen[key] = true
+		neighbors = append(neighbors, singleBulkSim{req: req, cl: changeLog, eq: sub})
+	}
+
+	for _, from := range beam {
+		for slot, items := range o.candidates {
+			for _, item := range items {
+				tryMove(from, []*itemWithSlot{{Item: &proto.ItemSpec{Id: item.ID}, Slot: proto.ItemSlot(slot)}})
+			}
+		}
+		for _, pieces := range o.setMoves {
+			tryMove(from, pieces)
+		}
+	}
+	return neighbors
+}
+
+// sim runs all the gear in combos, and returns the results ranked by score.
+func (o *gearOptimizer) sim(ctx context.Context, combos []singleBulkSim, iterations int64, progress chan *proto.ProgressMetrics) ([]*itemSubstitutionSimResult, *itemSubstitutionSimResult, error) {
+	results, baseResult, err := o.runner.getRankedResults(ctx, combos, iterations, progress)
+	atomic.AddInt32(&o.simsRun, int32(len(results)))
+	return results, baseResult, err
+}
+
+// forwardProgress reports the progress of each batch of sims as progress of the whole search.
+func (o *gearOptimizer) forwardProgress(simProgress chan *proto.ProgressMetrics, progress chan *proto.ProgressMetrics, done chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case p := <-simProgress:
+			if progress == nil {
+				continue
+			}
+			simsRun := atomic.LoadInt32(&o.simsRun)
+			select {
+			case progress <- &proto.ProgressMetrics{
+				CompletedSims:       simsRun + p.CompletedSims,
+				TotalSims:           simsRun + p.TotalSims,
+				CompletedIterations: p.CompletedIterations,
+				TotalIterations:     p.TotalIterations,
+			}:
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+func (o *gearOptimizer) newResult(best *itemSubstitutionSimResult, baseResult *itemSubstitutionSimResult, cancelled bool) *proto.GearOptimizerResult {
+	equipment := best.Request.Raid.Parties[0].Players[0].Equipment
+	result := &proto.GearOptimizerResult{
+		Equipment: equipment,
+		SimsRun:   atomic.LoadInt32(&o.simsRun),
+		Cancelled: cancelled,
+	}
+
+	for slot, is := range equipment.Items {
+		if !goproto.Equal(is, o.baseItems[slot]) {
+			result.ItemsChanged = append(result.ItemsChanged, &proto.ItemSpecWithSlot{Item: is, Slot: proto.ItemSlot(slot)})
+		}
+	}
+
+	var score, baseScore aggregator
+	for _, value := range best.scoreValues() {
+		score.add(value)
+	}
+	for _, value := range baseResult.scoreValues() {
+		baseScore.add(value)
+	}
+	if score.n > 0 {
+		result.Score = score.confidenceInterval()
+	}
+	if baseScore.n > 0 {
+		result.EquippedGearScore = baseScore.confidenceInterval()
+	}
+	result.DifferenceToEquippedGear, _ = best.compareScores(baseResult)
+
+	return result
+}
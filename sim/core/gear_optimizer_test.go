@@ -0,0 +1,173 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+const (
+	itemTestDagger    = 990001
+	itemTestStaff     = 990002
+	itemTestShield    = 990003
+	itemTestUniqueAxe = 990004
+)
+
+var optimizerItemDatabase = &proto.SimDatabase{
+	Items: []*proto.SimItem{
+		{Id: itemTestDagger, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, Ilvl: 50, Phase: 2},
+		{Id: itemTestStaff, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, Ilvl: 60, Phase: 3},
+		{Id: itemTestShield, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Ilvl: 50, Phase: 2,
+			Sources: []proto.ItemSourceType{proto.ItemSourceType_ItemSourceTypeDrop}, SourceZoneIds: []int32{1977}},
+		{Id: itemTestUniqueAxe, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, Ilvl: 55, Phase: 2, Unique: true},
+	},
+	ClassProficiencies: []*proto.ClassProficiency{
+		{
+			Class:        proto.Class_ClassMage,
+			MaxArmorType: proto.ArmorType_ArmorTypeCloth,
+			Weapons:      []*proto.WeaponProficiency{{WeaponType: proto.WeaponType_WeaponTypeDagger}, {WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true}},
+		},
+		{
+			Class:        proto.Class_ClassPriest,
+			MaxArmorType: proto.ArmorType_ArmorTypeCloth,
+			Weapons:      []*proto.WeaponProficiency{{WeaponType: proto.WeaponType_WeaponTypeDagger}, {WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true}},
+		},
+		{
+			Class:        proto.Class_ClassRogue,
+			MaxArmorType: proto.ArmorType_ArmorTypeLeather,
+			Weapons:      []*proto.WeaponProficiency{{WeaponType: proto.WeaponType_WeaponTypeDagger}},
+			CanDualWield: true,
+		},
+		{
+			Class:        proto.Class_ClassShaman,
+			MaxArmorType: proto.ArmorType_ArmorTypeMail,
+			Weapons:      []*proto.WeaponProficiency{{WeaponType: proto.WeaponType_WeaponTypeShield}, {WeaponType: proto.WeaponType_WeaponTypeAxe, TwoHand: true}},
+			CanDualWield: true,
+		},
+		{
+			Class:        proto.Class_ClassWarrior,
+			MaxArmorType: proto.ArmorType_ArmorTypePlate,
+			Weapons:      []*proto.WeaponProficiency{{WeaponType: proto.WeaponType_WeaponTypeShield}, {WeaponType: proto.WeaponType_WeaponTypeAxe, TwoHand: true}},
+			CanDualWield: true,
+		},
+	},
+}
+
+func TestCanEquipItem(t *testing.T) {
+	addToDatabase(optimizerItemDatabase)
+
+	for _, tc := range []struct {
+		comment string
+		item    int32
+		class   proto.Class
+		slot    proto.ItemSlot
+		want    bool
+	}{
+		{"Rogues can dual wield daggers", itemTestDagger, proto.Class_ClassRogue, proto.ItemSlot_ItemSlotOffHand, true},
+		{"Mages can't dual wield", itemTestDagger, proto.Class_ClassMage, proto.ItemSlot_ItemSlotOffHand, false},
+		{"Mages can use staves", itemTestStaff, proto.Class_ClassMage, proto.ItemSlot_ItemSlotMainHand, true},
+		{"Rogues can't use staves", itemTestStaff, proto.Class_ClassRogue, proto.ItemSlot_ItemSlotMainHand, false},
+		{"Shields only go in the off hand", itemTestShield, proto.Class_ClassWarrior, proto.ItemSlot_ItemSlotMainHand, false},
+		{"Shamans can use shields", itemTestShield, proto.Class_ClassShaman, proto.ItemSlot_ItemSlotOffHand, true},
+		{"Priests can't use shields", itemTestShield, proto.Class_ClassPriest, proto.ItemSlot_ItemSlotOffHand, false},
+	} {
+		if got := canEquipItem(ItemsByID[tc.item], tc.class, 60, tc.slot); got != tc.want {
+			t.Errorf("%s: canEquipItem() = %v, want %v", tc.comment, got, tc.want)
+		}
+	}
+
+	// Items without a required level are limited by their ilvl below level 60.
+	if canEquipItem(ItemsByID[itemTestStaff], proto.Class_ClassMage, 40, proto.ItemSlot_ItemSlotMainHand) {
+		t.Errorf("Expected an ilvl 60 staff to be unusable at level 40")
+	}
+}
+
+func TestItemFilterMatches(t *testing.T) {
+	addToDatabase(optimizerItemDatabase)
+
+	for _, tc := range []struct {
+		comment string
+		filter  *proto.ItemFilter
+		want    []int32
+	}{
+		{"Empty filter", &proto.ItemFilter{}, []int32{itemTestDagger, itemTestStaff, itemTestShield, itemTestUniqueAxe}},
+		{"Phase", &proto.ItemFilter{MaxPhase: 2}, []int32{itemTestDagger, itemTestShield, itemTestUniqueAxe}},
+		{"Ilvl", &proto.ItemFilter{MinIlvl: 55, MaxIlvl: 59}, []int32{itemTestUniqueAxe}},
+		{"Source", &proto.ItemFilter{Sources: []proto.ItemSourceType{proto.ItemSourceType_ItemSourceTypeDrop}}, []int32{itemTestShield}},
+		{"Zone", &proto.ItemFilter{ZoneIds: []int32{1977}}, []int32{itemTestShield}},
+		{"Excluded", &proto.ItemFilter{ExcludedItemIds: []int32{itemTestDagger, itemTestStaff}}, []int32{itemTestShield, itemTestUniqueAxe}},
+	} {
+		var got []int32
+		for _, id := range []int32{itemTestDagger, itemTestStaff, itemTestShield, itemTestUniqueAxe} {
+			if itemFilterMatches(tc.filter, ItemsByID[id]) {
+				got = append(got, id)
+			}
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: got items %v, want %v", tc.comment, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%s: got items %v, want %v", tc.comment, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestGearOptimizerNeighbors(t *testing.T) {
+	addToDatabase(optimizerItemDatabase)
+
+	baseItems := make([]*proto.ItemSpec, len(proto.ItemSlot_name))
+	for i := range baseItems {
+		baseItems[i] = &proto.ItemSpec{}
+	}
+	baseItems[proto.ItemSlot_ItemSlotMainHand] = &proto.ItemSpec{Id: itemTestUniqueAxe, Enchant: 1900}
+	baseItems[proto.ItemSlot_ItemSlotOffHand] = &proto.ItemSpec{Id: itemTestDagger}
+
+	baseRequest := &proto.RaidSimRequest{
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{{Players: []*proto.Player{{Equipment: &proto.EquipmentSpec{Items: baseItems}}}}},
+		},
+	}
+	optimizer := &gearOptimizer{
+		baseRequest: baseRequest,
+		baseItems:   baseItems,
+		candidates:  make([][]Item, len(proto.ItemSlot_name)),
+		seen:        map[string]bool{equipmentKey(baseRequest.Raid.Parties[0].Players[0].Equipment): true},
+	}
+	optimizer.candidates[proto.ItemSlot_ItemSlotMainHand] = []Item{ItemsByID[itemTestStaff], ItemsByID[itemTestDagger]}
+	optimizer.candidates[proto.ItemSlot_ItemSlotOffHand] = []Item{ItemsByID[itemTestUniqueAxe], ItemsByID[itemTestShield]}
+
+	base := &itemSubstitutionSimResult{Request: baseRequest, Substitution: &equipmentSubstitution{}}
+	neighbors := optimizer.neighbors([]*itemSubstitutionSimResult{base})
+
+	// The staff replaces both weapons, and the unique axe can't be equipped twice.
+	var got []string
+	for _, neighbor := range neighbors {
+		got = append(got, equipmentKey(neighbor.req.Raid.Parties[0].Players[0].Equipment))
+	}
+	want := []string{"14=990002", "14=990001:15=990001", "14=990004:15=990003"}
+	if len(got) != len(want) {
+		t.Fatalf("Got neighbors %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("Got neighbors %v, want %v", got, want)
+		}
+	}
+
+	// Enchants only carry over to the same kind of weapon.
+	if enchant := neighbors[0].req.Raid.Parties[0].Players[0].Equipment.Items[proto.ItemSlot_ItemSlotMainHand].Enchant; enchant != 0 {
+		t.Errorf("Expected no enchant on the staff, got %d", enchant)
+	}
+	if enchant := neighbors[1].req.Raid.Parties[0].Players[0].Equipment.Items[proto.ItemSlot_ItemSlotMainHand].Enchant; enchant != 1900 {
+		t.Errorf("Expected the axe's enchant on the dagger, got %d", enchant)
+	}
+
+	// Gear that was already simmed isn't returned again.
+	if neighbors := optimizer.neighbors([]*itemSubstitutionSimResult{base}); len(neighbors) != 0 {
+		t.Errorf("Expected no new neighbors, got %d", len(neighbors))
+	}
+}
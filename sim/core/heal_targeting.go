@@ -0,0 +1,149 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Health percent used for picking heal targets. Units without a health bar are
+// never considered injured.
+func healTargetHealthPercent(unit *Unit) float64 {
+	if !unit.HasHealthBar() || unit.MaxHealth() <= 0 {
+		return 1
+	}
+	return unit.CurrentHealthPercent()
+}
+
+func isInjured(unit *Unit) bool {
+	return healTargetHealthPercent(unit) < 1
+}
+
+// Returns the unit with the lowest health percent, preferring earlier units on ties.
+func lowestHealthUnit(units []*Unit) *Unit {
+	var lowest *Unit
+	lowestPercent := 0.0
+	for _, unit := range units {
+		if percent := healTargetHealthPercent(unit); lowest == nil || percent < lowestPercent {
+			lowest = unit
+			lowestPercent = percent
+		}
+	}
+	return lowest
+}
+
+// LowestHealthPlayer returns the player with the lowest health percent.
+func (raid *Raid) LowestHealthPlayer() *Unit {
+	return lowestHealthUnit(raid.AllPlayerUnits)
+}
+
+// LowestHealthTank returns the tank with the lowest health percent while any
+// tank is injured, and the lowest health player otherwise. Tanks are the raid
+// members currently targeted by an active target of the encounter.
+func (env *Environment) LowestHealthTank() *Unit {
+	var lowest *Unit
+	lowestPercent := 1.0
+	for _, target := range env.Encounter.ActiveTargetUnits {
+		tank := target.CurrentTarget
+		if tank == nil || tank.Type == EnemyUnit {
+			continue
+		}
+		if percent := healTargetHealthPercent(tank); percent < lowestPercent {
+			lowest = tank
+			lowestPercent = percent
+		}
+	}
+
+	if lowest == nil {
+		return env.Raid.LowestHealthPlayer()
+	}
+	return lowest
+}
+
+// SmartHealPartyTarget returns the player with the lowest health percent in the
+// party which is missing the most health, for party heals like Prayer of Healing
+// and Circle of Healing.
+func (raid *Raid) SmartHealPartyTarget() *Unit {
+	var mostInjured *Party
+	mostMissingHealth := 0.0
+	for _, party := range raid.Parties {
+		missingHealth := 0.0
+		for _, player := range party.Players {
+			unit := &player.GetCharacter().Unit
+			if unit.HasHealthBar() {
+				missingHealth += unit.MaxHealth() - unit.CurrentHealth()
+			}
+		}
+		if missingHealth > mostMissingHealth {
+			mostInjured = party
+			mostMissingHealth = missingHealth
+		}
+	}
+
+	if mostInjured == nil {
+		return raid.LowestHealthPlayer()
+	}
+
+	var lowest *Unit
+	lowestPercent := 0.0
+	for _, player := range mostInjured.Players {
+		unit := &player.GetCharacter().Unit
+		if percent := healTargetHealthPercent(unit); lowest == nil || percent < lowestPercent {
+			lowest = unit
+			lowestPercent = percent
+		}
+	}
+	return lowest
+}
+
+// SmartHealTargets returns the targets of a smart heal, such as the jumps of
+// Chain Heal or the players healed by Circle of Healing: the primary target,
+// followed by up to maxTargets-1 other injured candidates, lowest health percent
+// first. The returned slice is reused by the next call.
+func (raid *Raid) SmartHealTargets(primary *Unit, candidates []*Unit, maxTargets int) []*Unit {
+	targets := raid.smartHealTargets[:0]
+	if primary != nil {
+		targets = append(targets, primary)
+	}
+	for _, unit := range candidates {
+		if unit != primary && isInjured(unit) {
+			targets = append(targets, unit)
+		}
+	}
+
+	others := targets
+	if primary != nil {
+		others = targets[1:]
+	}
+	slices.SortStableFunc(others, func(u1, u2 *Unit) int {
+		p1, p2 := healTargetHealthPercent(u1), healTargetHealthPercent(u2)
+		if p1 < p2 {
+			return -1
+		} else if p1 > p2 {
+			return 1
+		}
+		return 0
+	})
+
+	raid.smartHealTargets = targets
+	return targets[:min(len(targets), maxTargets)]
+}
+
+// Returns the current unit for a heal target reference type, see UnitReference.
+func (env *Environment) getHealTarget(healTargetType proto.UnitReference_Type) *Unit {
+	switch healTargetType {
+	case proto.UnitReference_LowestHealthPlayer:
+		return env.Raid.LowestHealthPlayer()
+	case proto.UnitReference_LowestHealthTank:
+		return env.LowestHealthTank()
+	case proto.UnitReference_SmartHeal:
+		return env.Raid.SmartHealPartyTarget()
+	}
+	return nil
+}
+
+func isHealTargetType(refType proto.UnitReference_Type) bool {
+	return refType == proto.UnitReference_LowestHealthPlayer ||
+		refType == proto.UnitReference_LowestHealthTank ||
+		refType == proto.UnitReference_SmartHeal
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func TestHealTargeting(t *testing.T) {
+	sim := setupHealingSim(nil)
+	tank := sim.Raid.AllPlayerUnits[0]
+	dummies := sim.Raid.AllPlayerUnits[1:]
+
+	// Party 1 has the tank and dummies 1-4, party 2 has dummies 5-8.
+	dummies[1].RemoveHealth(sim, 6000)
+	dummies[4].RemoveHealth(sim, 4000)
+	dummies[5].RemoveHealth(sim, 3000)
+	dummies[6].RemoveHealth(sim, 1000)
+
+	lowestHealthPlayer := NewUnitReference(&proto.UnitReference{Type: proto.UnitReference_LowestHealthPlayer}, tank)
+	lowestHealthTank := NewUnitReference(&proto.UnitReference{Type: proto.UnitReference_LowestHealthTank}, tank)
+	smartHeal := NewUnitReference(&proto.UnitReference{Type: proto.UnitReference_SmartHeal}, tank)
+
+	if unit := lowestHealthPlayer.Get(); unit != dummies[1] {
+		t.Fatalf("Expected the lowest health player to be %s, got %s", dummies[1].Label, unit.Label)
+	}
+	if unit := lowestHealthTank.Get(); unit != dummies[1] {
+		t.Fatalf("Expected the lowest health player while the tank is healthy, got %s", unit.Label)
+	}
+	if unit := smartHeal.Get(); unit != dummies[4] {
+		t.Fatalf("Expected the smart heal to pick %s from the most injured party, got %s", dummies[4].Label, unit.Label)
+	}
+
+	tank.RemoveHealth(sim, 1)
+	if unit := lowestHealthTank.Get(); unit != tank {
+		t.Fatalf("Expected the injured tank to be prioritized, got %s", unit.Label)
+	}
+
+	targets := sim.Raid.SmartHealTargets(dummies[6], sim.Raid.AllPlayerUnits, 4)
+	expected := []*Unit{dummies[6], dummies[1], dummies[4], dummies[5]}
+	if len(targets) != len(expected) {
+		t.Fatalf("Expected %d smart heal targets, got %d", len(expected), len(targets))
+	}
+	for i, unit := range expected {
+		if targets[i] != unit {
+			t.Fatalf("Expected smart heal target %d to be %s, got %s", i, unit.Label, targets[i].Label)
+		}
+	}
+	if targets := sim.Raid.SmartHealTargets(nil, dummies[6:], 3); len(targets) != 1 {
+		t.Fatalf("Expected uninjured players to be skipped by smart heals, got %d targets", len(targets))
+	}
+}
+
+func TestOverhealing(t *testing.T) {
+	sim := setupHealingSim(nil)
+	healer := sim.Raid.AllPlayerUnits[0]
+	dummy := sim.Raid.AllPlayerUnits[1]
+
+	heal := healer.RegisterSpell(SpellConfig{
+		ActionID:    ActionID{SpellID: 2050},
+		SpellSchool: SpellSchoolHoly,
+		ProcMask:    ProcMaskSpellHealing,
+		Flags:       SpellFlagHelpful,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		ApplyEffects: func(sim *Simulation, target *Unit, spell *Spell) {
+			spell.CalcAndDealHealing(sim, target, 1500, spell.OutcomeAlwaysHit)
+		},
+	})
+
+	dummy.RemoveHealth(sim, 1000)
+	heal.Cast(sim, dummy)
+
+	metrics := heal.SpellMetrics[dummy.UnitIndex]
+	if metrics.TotalHealing != 1500 || metrics.TotalOverhealing != 500 {
+		t.Fatalf("Expected 1500 healing with 500 overhealing, got %0.0f and %0.0f", metrics.TotalHealing, metrics.TotalOverhealing)
+	}
+	if dummy.CurrentHealth() != dummy.MaxHealth() {
+		t.Fatalf("Expected the dummy to be healed to full")
+	}
+}
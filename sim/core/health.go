@@ -55,7 +55,7 @@ func (hb *healthBar) GainHealth(sim *Simulation, amount float64, metrics *Resour
 	metrics.AddEvent(amount, newHealth-oldHealth)
 
 	if sim.Log != nil {
-		hb.unit.Log(sim, "Gained %0.3f health from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, oldHealth, newHealth)
+		sim.logResourceChange(hb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeHealth, amount, oldHealth, newHealth)
 	}
 
 	hb.currentHealth = newHealth
@@ -81,7 +81,7 @@ func (hb *healthBar) RemoveHealth(sim *Simulation, amount float64) {
 	}
 
 	if sim.Log != nil {
-		hb.unit.Log(sim, "Spent %0.3f health from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, oldHealth, newHealth)
+		sim.logResourceChange(hb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeHealth, -amount, oldHealth, newHealth)
 	}
 
 	hb.currentHealth = newHealth
@@ -96,16 +96,16 @@ func (character *Character) trackChanceOfDeath(healingModel *proto.HealingModel)
 			character.Unit.Metrics.isTanking = true
 		}
 	}
-	if !character.Unit.Metrics.isTanking {
-		return
-	}
-
-	if healingModel == nil {
+	if character.Unit.Metrics.isTanking {
+		if healingModel == nil {
+			return
+		}
+		character.Unit.Metrics.tmiBin = healingModel.BurstWindow
+	} else if !character.Env.Encounter.HasRaidDamage() {
+		// Only tanks take damage, unless the encounter damages the whole raid.
 		return
 	}
 
-	character.Unit.Metrics.tmiBin = healingModel.BurstWindow
-
 	character.RegisterAura(Aura{
 		Label:    ChanceOfDeathAuraLabel,
 		Duration: NeverExpires,
@@ -138,7 +138,7 @@ func (character *Character) trackChanceOfDeath(healingModel *proto.HealingModel)
 		},
 	})
 
-	if healingModel.Hps != 0 {
+	if healingModel != nil && healingModel.Hps != 0 {
 		character.applyHealingModel(healingModel)
 	}
 }
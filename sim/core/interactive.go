@@ -0,0 +1,364 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// An InteractiveSession is a single iteration in interactive mode, where the
+// caller chooses the actions of the first player, e.g. for a rotation trainer.
+type InteractiveSession struct {
+	id     string
+	sim    *Simulation
+	player *Character
+	logs   strings.Builder
+	done   bool
+
+	// Computed once the fight is over, since it cleans up the iteration.
+	result *proto.RaidSimResult
+
+	// When the session was last started or stepped, for expiring idle sessions.
+	lastUsed time.Time
+
+	// Held while the session is stepped, since callers may share sessions
+	// between goroutines.
+	mut sync.Mutex
+}
+
+// Sessions are kept between calls, until their fight is over, they're closed or
+// they've been idle for interactiveSessionTTL.
+const interactiveSessionTTL = time.Minute * 10
+
+var (
+	interactiveSessionsMut sync.Mutex
+	interactiveSessions    = map[string]*InteractiveSession{}
+)
+
+// Session IDs are random so that clients can't step each other's sessions.
+func newInteractiveSessionID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(id)
+}
+
+func NewInteractiveSession(request *proto.RaidSimRequest) *InteractiveSession {
+	request = googleProto.Clone(request).(*proto.RaidSimRequest)
+	if request.SimOptions == nil {
+		request.SimOptions = &proto.SimOptions{}
+	}
+	request.SimOptions.Interactive = true
+	request.SimOptions.Iterations = 1
+	request.SimOptions.Replay = nil
+
+	sim := NewSim(request)
+	if len(sim.Raid.Parties) == 0 || len(sim.Raid.Parties[0].Players) == 0 {
+		panic("Interactive sessions need a player")
+	}
+
+	session := &InteractiveSession{
+		sim:    sim,
+		player: sim.Raid.Parties[0].Players[0].GetCharacter(),
+	}
+	if sim.Options.Debug || sim.Options.DebugFirstIteration {
+		sim.logTo(&session.logs)
+	}
+	sim.reset()
+	sim.PrePull()
+	session.advance()
+	return session
+}
+
+// Steps the sim until the player's GCD is ready. Decisions requested for other
+// units, e.g. pets, are skipped.
+func (session *InteractiveSession) advance() {
+	sim := session.sim
+	for !session.done {
+		if sim.NeedsInput {
+			if session.player.GCD.IsReady(sim) && session.player.Hardcast.Expires <= sim.CurrentTime {
+				return
+			}
+			sim.NeedsInput = false
+		}
+		session.done = sim.Step()
+	}
+}
+
+// Cast casts the player's spell with the given ID on its current target, then
+// steps to the next decision point.
+func (session *InteractiveSession) Cast(actionID ActionID) error {
+	spell := session.player.GetSpell(actionID)
+	if spell == nil || !spell.Flags.Matches(SpellFlagAPL) {
+		return fmt.Errorf("no castable spell with ID %s", actionID)
+	}
+
+	target := session.player.CurrentTarget
+	if !spell.CanCast(session.sim, target) {
+		return fmt.Errorf("%s can't be cast right now", actionID)
+	}
+
+	// Casts go through Input, so that snapshots can replay them.
+	session.sim.Input(func(sim *Simulation) {
+		if spell.Cast(sim, target) && spell.CurCast.GCD > 0 {
+			sim.NeedsInput = false
+		}
+	})
+	session.advance()
+	return nil
+}
+
+// Wait pauses the player for the given duration, then steps to the next decision
+// point.
+func (session *InteractiveSession) Wait(duration time.Duration) {
+	session.sim.Input(func(sim *Simulation) {
+		session.player.WaitUntil(sim, sim.CurrentTime+duration)
+		sim.NeedsInput = false
+	})
+	session.advance()
+}
+
+// Restart abandons the current fight and starts a new one, whose RNG is seeded
+// with seed.
+func (session *InteractiveSession) Restart(seed int64) {
+	session.sim.restartIteration(seed)
+	session.done = false
+	session.result = nil
+	session.advance()
+}
+
+func (session *InteractiveSession) Done() bool {
+	return session.done
+}
+
+func (session *InteractiveSession) Sim() *Simulation {
+	return session.sim
+}
+
+func (session *InteractiveSession) Player() *Character {
+	return session.player
+}
+
+// DamageDone returns the damage dealt by the player and its pets so far in this
+// fight.
+func (session *InteractiveSession) DamageDone() float64 {
+	damage := session.player.damageDone()
+	for _, pet := range session.player.Pets {
+		damage += pet.damageDone()
+	}
+	return damage
+}
+
+// Damage dealt by the unit so far in this iteration. Unit metrics only add up
+// spell metrics once the iteration is done.
+func (unit *Unit) damageDone() float64 {
+	damage := 0.0
+	for _, spell := range unit.Spellbook {
+		if spell.Flags.Matches(SpellFlagNoMetrics) {
+			continue
+		}
+		for _, spellMetrics := range spell.splitSpellMetrics {
+			for _, targetMetrics := range spellMetrics {
+				damage += targetMetrics.TotalDamage
+			}
+		}
+	}
+	return damage
+}
+
+func (session *InteractiveSession) State() *proto.InteractiveState {
+	sim := session.sim
+	state := &proto.InteractiveState{
+		SessionId:     session.id,
+		CurrentTime:   sim.CurrentTime.Seconds(),
+		RemainingTime: sim.GetRemainingDuration().Seconds(),
+		Done:          session.done,
+		Player:        session.unitState(&session.player.Unit),
+	}
+
+	for _, spell := range session.player.Spellbook {
+		if !spell.Flags.Matches(SpellFlagAPL) {
+			continue
+		}
+		state.Player.Spells = append(state.Player.Spells, &proto.InteractiveSpellState{
+			Id:                spell.ActionID.ToProto(),
+			CooldownRemaining: spell.TimeToReady(sim).Seconds(),
+			Castable:          !session.done && spell.CanCast(sim, session.player.CurrentTarget),
+		})
+	}
+
+	for _, target := range sim.Encounter.TargetUnits {
+		state.Targets = append(state.Targets, session.unitState(target))
+	}
+
+	if session.done {
+		state.FinalResult = session.finalResult()
+	}
+	return state
+}
+
+func (session *InteractiveSession) unitState(unit *Unit) *proto.InteractiveUnitState {
+	sim := session.sim
+	state := &proto.InteractiveUnitState{
+		Name:          unit.Label,
+		GcdRemaining:  max(0, unit.GCD.TimeToReady(sim)).Seconds(),
+		CastRemaining: max(0, unit.Hardcast.Expires-sim.CurrentTime).Seconds(),
+		DamageDone:    unit.damageDone(),
+	}
+
+	if unit.HasHealthBar() {
+		state.Health = unit.CurrentHealth()
+	}
+	if unit.HasManaBar() {
+		state.Mana = unit.CurrentMana()
+	}
+	if unit.HasRageBar() {
+		state.Rage = unit.CurrentRage()
+	}
+	if unit.HasEnergyBar() {
+		state.Energy = unit.CurrentEnergy()
+		state.ComboPoints = unit.ComboPoints()
+	}
+
+	for _, aura := range unit.GetAuras() {
+		if !aura.IsActive() {
+			continue
+		}
+		remaining := -1.0
+		if aura.expires != NeverExpires {
+			remaining = aura.RemainingDuration(sim).Seconds()
+		}
+		state.Auras = append(state.Auras, &proto.InteractiveAuraState{
+			Id:        aura.ActionID.ToProto(),
+			Label:     aura.Label,
+			Stacks:    aura.GetStacks(),
+			Remaining: remaining,
+		})
+	}
+	return state
+}
+
+// Finishes the iteration and returns its results, like a sim with a single
+// iteration would.
+func (session *InteractiveSession) finalResult() *proto.RaidSimResult {
+	if session.result != nil {
+		return session.result
+	}
+
+	sim := session.sim
+	// Cleanup moves CurrentTime to the end of the fight, which health-based
+	// fights may not have reached.
+	elapsed := sim.CurrentTime
+	sim.Cleanup()
+
+	session.result = &proto.RaidSimResult{
+		RaidMetrics:      sim.Raid.GetMetrics(),
+		EncounterMetrics: sim.Encounter.GetMetricsProto(),
+
+		Logs:                   session.logs.String(),
+		Events:                 sim.events,
+		FirstIterationDuration: elapsed.Seconds(),
+		AvgIterationDuration:   elapsed.Seconds(),
+
+		CompletedIterations: 1,
+	}
+	return session.result
+}
+
+// Drops sessions that haven't been stepped for interactiveSessionTTL. Must be
+// called with interactiveSessionsMut held.
+func expireInteractiveSessions(now time.Time) {
+	for id, session := range interactiveSessions {
+		if now.Sub(session.lastUsed) > interactiveSessionTTL {
+			delete(interactiveSessions, id)
+		}
+	}
+}
+
+// Recovers from panics in the session API, returning them as an error result.
+func recoverInteractiveState(state **proto.InteractiveState) {
+	if err := recover(); err != nil {
+		errStr := fmt.Sprint(err) + "\nStack Trace:\n" + string(debug.Stack())
+		*state = &proto.InteractiveState{
+			ErrorResult: errStr,
+		}
+	}
+}
+
+func StartInteractiveSession(request *proto.InteractiveStartRequest) (state *proto.InteractiveState) {
+	defer recoverInteractiveState(&state)
+
+	session := NewInteractiveSession(request.Request)
+	if session.done {
+		return session.State()
+	}
+
+	now := time.Now()
+	interactiveSessionsMut.Lock()
+	expireInteractiveSessions(now)
+	session.id = newInteractiveSessionID()
+	session.lastUsed = now
+	interactiveSessions[session.id] = session
+	interactiveSessionsMut.Unlock()
+
+	return session.State()
+}
+
+func StepInteractiveSession(request *proto.InteractiveStepRequest) (state *proto.InteractiveState) {
+	defer recoverInteractiveState(&state)
+
+	now := time.Now()
+	interactiveSessionsMut.Lock()
+	expireInteractiveSessions(now)
+	session, ok := interactiveSessions[request.SessionId]
+	if ok && request.Close {
+		delete(interactiveSessions, request.SessionId)
+	} else if ok {
+		session.lastUsed = now
+	}
+	interactiveSessionsMut.Unlock()
+
+	if !ok {
+		return &proto.InteractiveState{
+			SessionId:   request.SessionId,
+			ErrorResult: "Unknown interactive session: " + request.SessionId,
+		}
+	}
+	if request.Close {
+		return &proto.InteractiveState{SessionId: request.SessionId}
+	}
+
+	session.mut.Lock()
+	defer session.mut.Unlock()
+	if session.done {
+		return &proto.InteractiveState{
+			SessionId:   request.SessionId,
+			ErrorResult: "Interactive session is already over: " + request.SessionId,
+		}
+	}
+
+	if request.Action != nil {
+		if err := session.Cast(ProtoToActionID(request.Action)); err != nil {
+			state := session.State()
+			state.ErrorResult = err.Error()
+			return state
+		}
+	} else if request.Wait > 0 {
+		session.Wait(DurationFromSeconds(request.Wait))
+	}
+
+	if session.done {
+		interactiveSessionsMut.Lock()
+		delete(interactiveSessions, request.SessionId)
+		interactiveSessionsMut.Unlock()
+	}
+	return session.State()
+}
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpireInteractiveSessions(t *testing.T) {
+	now := time.Now()
+
+	interactiveSessionsMut.Lock()
+	defer interactiveSessionsMut.Unlock()
+	interactiveSessions["idle"] = &InteractiveSession{id: "idle", lastUsed: now.Add(-interactiveSessionTTL - time.Second)}
+	interactiveSessions["active"] = &InteractiveSession{id: "active", lastUsed: now.Add(-time.Minute)}
+	defer delete(interactiveSessions, "active")
+
+	expireInteractiveSessions(now)
+
+	if _, ok := interactiveSessions["idle"]; ok {
+		t.Fatalf("Expected idle session to expire")
+	}
+	if _, ok := interactiveSessions["active"]; !ok {
+		t.Fatalf("Expected active session to be kept")
+	}
+}
+
+func TestInteractiveSessionIDs(t *testing.T) {
+	first, second := newInteractiveSessionID(), newInteractiveSessionID()
+	if len(first) != 32 || first == second {
+		t.Fatalf("Expected distinct random session IDs, got %s and %s", first, second)
+	}
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Records the results of every unit in the iteration which just finished, see
+// SimOptions.save_iteration_results. Must be called after the metrics are done
+// with the iteration, but before they are reset for the next one.
+func (sim *Simulation) recordIterationResults() {
+	for _, unit := range sim.AllUnits {
+		sim.iterationResults = append(sim.iterationResults, unit.iterationResult(sim))
+	}
+}
+
+func (unit *Unit) iterationResult(sim *Simulation) *proto.IterationUnitResult {
+	durationSeconds := sim.Duration.Seconds()
+	metrics := &unit.Metrics
+
+	result := &proto.IterationUnitResult{
+		Iteration:       sim.currentIteration,
+		Seed:            sim.rand.GetSeed(),
+		DurationSeconds: durationSeconds,
+
+		Unit: unit.unitReference(),
+		Name: unit.Label,
+
+		Dps:        metrics.dps.Total / durationSeconds,
+		Hps:        metrics.hps.Total / durationSeconds,
+		Tps:        metrics.threat.Total / durationSeconds,
+		Dtps:       metrics.dtps.Total / durationSeconds,
+		OomSeconds: metrics.OOMTime.Seconds(),
+		Died:       metrics.Died,
+	}
+
+	for _, spell := range unit.Spellbook {
+		if spell.Flags.Matches(SpellFlagNoMetrics) {
+			continue
+		}
+		for i, spellMetrics := range spell.splitSpellMetrics {
+			if empty(spellMetrics) {
+				continue
+			}
+
+			action := &proto.IterationActionResult{
+				Id: spell.ActionID.WithTag(spell.splitTags[i]).ToProto(),
+			}
+			for _, targetMetrics := range spellMetrics {
+				action.Casts += targetMetrics.Casts
+				action.Damage += targetMetrics.TotalDamage
+				action.Healing += targetMetrics.TotalHealing + targetMetrics.TotalShielding
+				action.Threat += targetMetrics.TotalThreat
+			}
+			result.Actions = append(result.Actions, action)
+		}
+	}
+
+	for _, aura := range unit.auraTracker.auras {
+		if aura.ActionID.IsEmptyAction() || (aura.metrics.Uptime == 0 && aura.metrics.Procs == 0) {
+			continue
+		}
+		result.Auras = append(result.Auras, &proto.IterationAuraResult{
+			Id:            aura.ActionID.ToProto(),
+			UptimeSeconds: aura.metrics.Uptime.Seconds(),
+			Procs:         aura.metrics.Procs,
+		})
+	}
+
+	return result
+}
@@ -181,6 +181,9 @@ type majorCooldownManager struct {
 	// the course of the sim.
 	majorCooldowns []*MajorCooldown
 	minReady       time.Duration
+
+	// Backing storage for majorCooldowns, reused by every iteration.
+	mcdStorage []MajorCooldown
 }
 
 func newMajorCooldownManager(cooldowns *proto.Cooldowns) majorCooldownManager {
@@ -228,10 +231,12 @@ func (mcdm *majorCooldownManager) finalize() {
 }
 
 func (mcdm *majorCooldownManager) reset(_ *Simulation) {
+	if len(mcdm.mcdStorage) != len(mcdm.majorCooldowns) {
+		mcdm.mcdStorage = make([]MajorCooldown, len(mcdm.majorCooldowns))
+	}
 	for i := range mcdm.majorCooldowns {
-		newMCD := &MajorCooldown{}
-		*newMCD = mcdm.initialMajorCooldowns[i]
-		mcdm.majorCooldowns[i] = newMCD
+		mcdm.mcdStorage[i] = mcdm.initialMajorCooldowns[i]
+		mcdm.majorCooldowns[i] = &mcdm.mcdStorage[i]
 	}
 
 	// For initial sorting.
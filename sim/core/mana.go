@@ -85,7 +85,7 @@ func (unit *Unit) AddMana(sim *Simulation, amount float64, metrics *ResourceMetr
 	metrics.AddEvent(amount, newMana-oldMana)
 
 	if sim.Log != nil {
-		unit.Log(sim, "Gained %0.3f mana from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, oldMana, newMana)
+		sim.logResourceChange(unit, metrics.ActionID, proto.ResourceType_ResourceTypeMana, amount, oldMana, newMana)
 	}
 
 	unit.currentMana = newMana
@@ -101,7 +101,7 @@ func (unit *Unit) SpendMana(sim *Simulation, amount float64, metrics *ResourceMe
 	metrics.AddEvent(-amount, -amount)
 
 	if sim.Log != nil {
-		unit.Log(sim, "Spent %0.3f mana from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, unit.CurrentMana(), newMana)
+		sim.logResourceChange(unit, metrics.ActionID, proto.ResourceType_ResourceTypeMana, -amount, unit.CurrentMana(), newMana)
 	}
 
 	unit.currentMana = newMana
@@ -235,6 +235,20 @@ func (unit *Unit) TimeUntilManaRegen(desiredMana float64) time.Duration {
 }
 
 func (sim *Simulation) initManaTickAction() {
+	if sim.manaTickAction == nil {
+		sim.manaTickAction = sim.newManaTickAction()
+		if sim.manaTickAction == nil {
+			return
+		}
+	}
+
+	sim.manaTickAction.NextActionAt = sim.Environment.PrepullStartTime() + manaTickInterval
+	sim.AddPendingAction(sim.manaTickAction)
+}
+
+const manaTickInterval = time.Second * 2
+
+func (sim *Simulation) newManaTickAction() *PendingAction {
 	var unitsWithManaBars []*Unit
 
 	for _, party := range sim.Raid.Parties {
@@ -253,13 +267,11 @@ func (sim *Simulation) initManaTickAction() {
 	}
 
 	if len(unitsWithManaBars) == 0 {
-		return
+		return nil
 	}
 
-	interval := time.Second * 2
 	pa := &PendingAction{
-		NextActionAt: sim.Environment.PrepullStartTime() + interval,
-		Priority:     ActionPriorityRegen,
+		Priority: ActionPriorityRegen,
 	}
 	pa.OnAction = func(sim *Simulation) {
 		for _, unit := range unitsWithManaBars {
@@ -268,10 +280,10 @@ func (sim *Simulation) initManaTickAction() {
 			}
 		}
 
-		pa.NextActionAt = sim.CurrentTime + interval
+		pa.NextActionAt = sim.CurrentTime + manaTickInterval
 		sim.AddPendingAction(pa)
 	}
-	sim.AddPendingAction(pa)
+	return pa
 }
 
 func (mb *manaBar) reset() {
@@ -1,7 +1,9 @@
 package core
 
 import (
+	"cmp"
 	"math"
+	"slices"
 	"time"
 
 	"github.com/wowsims/sod/sim/core/proto"
@@ -36,8 +38,8 @@ func (distMetrics *DistributionMetrics) doneIteration(sim *Simulation) {
 	distMetrics.add(dps)
 
 	if sim.Options.SaveAllValues {
-		if cap(distMetrics.sample) < int(sim.Options.Iterations) {
-			distMetrics.sample = make([]float64, 0, sim.Options.Iterations)
+		if cap(distMetrics.sample) < int(sim.numSavedIterations) {
+			distMetrics.sample = make([]float64, 0, sim.numSavedIterations)
 		}
 		distMetrics.sample = append(distMetrics.sample, dps)
 	}
@@ -55,6 +57,29 @@ func (distMetrics *DistributionMetrics) doneIteration(sim *Simulation) {
 	distMetrics.hist[dpsRounded]++
 }
 
+// Merges in the aggregate values of other, which must cover later iterations.
+func (distMetrics *DistributionMetrics) merge(other *DistributionMetrics) {
+	if other.n == 0 {
+		return
+	}
+
+	distMetrics.aggregator = *distMetrics.aggregator.merge(&other.aggregator)
+	distMetrics.sample = append(distMetrics.sample, other.sample...)
+
+	if other.max > distMetrics.max {
+		distMetrics.max = other.max
+		distMetrics.maxSeed = other.maxSeed
+	}
+	if other.min <= distMetrics.min || distMetrics.min < 0 {
+		distMetrics.min = other.min
+		distMetrics.minSeed = other.minSeed
+	}
+
+	for dps, count := range other.hist {
+		distMetrics.hist[dps] += count
+	}
+}
+
 func (distMetrics *DistributionMetrics) ToProto() *proto.DistributionMetrics {
 	mean, stdev := distMetrics.meanAndStdDev()
 
@@ -84,6 +109,7 @@ type UnitMetrics struct {
 	dtps   DistributionMetrics
 	tmi    DistributionMetrics
 	hps    DistributionMetrics
+	ehps   DistributionMetrics
 	tto    DistributionMetrics
 
 	tmiList   []tmiListItem
@@ -125,6 +151,27 @@ type tmiListItem struct {
 	WeightedDamage float64
 }
 
+func (actionMetrics *ActionMetrics) merge(other *ActionMetrics) {
+	for i := range actionMetrics.Targets {
+		tam := &actionMetrics.Targets[i]
+		otherTam := &other.Targets[i]
+		tam.Casts += otherTam.Casts
+		tam.Hits += otherTam.Hits
+		tam.Crits += otherTam.Crits
+		tam.Misses += otherTam.Misses
+		tam.Dodges += otherTam.Dodges
+		tam.Parries += otherTam.Parries
+		tam.Blocks += otherTam.Blocks
+		tam.Glances += otherTam.Glances
+		tam.Damage += otherTam.Damage
+		tam.Threat += otherTam.Threat
+		tam.Healing += otherTam.Healing
+		tam.Shielding += otherTam.Shielding
+		tam.Overhealing += otherTam.Overhealing
+		tam.CastTime += otherTam.CastTime
+	}
+}
+
 func (actionMetrics *ActionMetrics) ToProto(actionID ActionID) *proto.ActionMetrics {
 	targetMetrics := make([]*proto.TargetedActionMetrics, len(actionMetrics.Targets))
 	for i, tam := range actionMetrics.Targets {
@@ -152,11 +199,12 @@ type SpellMetrics struct {
 
 	// Partial or full resists aren't tracked, at the moment, cp. applyResistances()
 
-	TotalDamage    float64 // Damage done by all casts of this spell.
-	TotalThreat    float64 // Threat generated by all casts of this spell.
-	TotalHealing   float64 // Healing done by all casts of this spell.
-	TotalShielding float64 // Shielding done by all casts of this spell.
-	TotalCastTime  time.Duration
+	TotalDamage      float64 // Damage done by all casts of this spell.
+	TotalThreat      float64 // Threat generated by all casts of this spell.
+	TotalHealing     float64 // Healing done by all casts of this spell.
+	TotalShielding   float64 // Shielding done by all casts of this spell.
+	TotalOverhealing float64 // Part of TotalHealing which exceeded the missing health of the target.
+	TotalCastTime    time.Duration
 }
 
 type TargetedActionMetrics struct {
@@ -169,30 +217,32 @@ type TargetedActionMetrics struct {
 	Blocks  int32
 	Glances int32
 
-	Damage    float64
-	Threat    float64
-	Healing   float64
-	Shielding float64
-	CastTime  time.Duration
+	Damage      float64
+	Threat      float64
+	Healing     float64
+	Shielding   float64
+	Overhealing float64
+	CastTime    time.Duration
 }
 
 func (tam *TargetedActionMetrics) ToProto(unitIndex int32) *proto.TargetedActionMetrics {
 	return &proto.TargetedActionMetrics{
 		UnitIndex: unitIndex,
 
-		Casts:      tam.Casts,
-		Hits:       tam.Hits,
-		Crits:      tam.Crits,
-		Misses:     tam.Misses,
-		Dodges:     tam.Dodges,
-		Parries:    tam.Parries,
-		Blocks:     tam.Blocks,
-		Glances:    tam.Glances,
-		Damage:     tam.Damage,
-		Threat:     tam.Threat,
-		Healing:    tam.Healing,
-		Shielding:  tam.Shielding,
-		CastTimeMs: float64(tam.CastTime.Milliseconds()),
+		Casts:       tam.Casts,
+		Hits:        tam.Hits,
+		Crits:       tam.Crits,
+		Misses:      tam.Misses,
+		Dodges:      tam.Dodges,
+		Parries:     tam.Parries,
+		Blocks:      tam.Blocks,
+		Glances:     tam.Glances,
+		Damage:      tam.Damage,
+		Threat:      tam.Threat,
+		Healing:     tam.Healing,
+		Shielding:   tam.Shielding,
+		Overhealing: tam.Overhealing,
+		CastTimeMs:  float64(tam.CastTime.Milliseconds()),
 	}
 }
 
@@ -204,6 +254,7 @@ func NewUnitMetrics() UnitMetrics {
 		dtps:    NewDistributionMetrics(),
 		tmi:     NewDistributionMetrics(),
 		hps:     NewDistributionMetrics(),
+		ehps:    NewDistributionMetrics(),
 		tto:     NewDistributionMetrics(),
 		actions: make(map[ActionID]*ActionMetrics),
 	}
@@ -232,6 +283,12 @@ func (resourceMetrics *ResourceMetrics) ToProto() *proto.ResourceMetrics {
 	}
 }
 
+func (resourceMetrics *ResourceMetrics) merge(other *ResourceMetrics) {
+	resourceMetrics.Events += other.Events
+	resourceMetrics.Gain += other.Gain
+	resourceMetrics.ActualGain += other.ActualGain
+}
+
 func (resourceMetrics *ResourceMetrics) reset() {
 	resourceMetrics.EventsFromPreviousIterations = resourceMetrics.Events
 	resourceMetrics.ActualGainFromPreviousIterations = resourceMetrics.ActualGain
@@ -328,6 +385,7 @@ func (unitMetrics *UnitMetrics) addSpellMetrics(spell *Spell, actionID ActionID,
 		tam.Threat += spellTargetMetrics.TotalThreat
 		tam.Healing += spellTargetMetrics.TotalHealing
 		tam.Shielding += spellTargetMetrics.TotalShielding
+		tam.Overhealing += spellTargetMetrics.TotalOverhealing
 		tam.CastTime += spellTargetMetrics.TotalCastTime
 
 		target := spell.Unit.Env.AllUnits[i]
@@ -338,6 +396,7 @@ func (unitMetrics *UnitMetrics) addSpellMetrics(spell *Spell, actionID ActionID,
 			unitMetrics.threat.Total += spellTargetMetrics.TotalThreat
 		} else {
 			unitMetrics.hps.Total += spellTargetMetrics.TotalHealing + spellTargetMetrics.TotalShielding
+			unitMetrics.ehps.Total += spellTargetMetrics.TotalHealing - spellTargetMetrics.TotalOverhealing + spellTargetMetrics.TotalShielding
 		}
 	}
 }
@@ -375,6 +434,7 @@ func (unitMetrics *UnitMetrics) reset() {
 	unitMetrics.tmi.reset()
 	unitMetrics.tmiList = nil
 	unitMetrics.hps.reset()
+	unitMetrics.ehps.reset()
 	unitMetrics.tto.reset()
 	unitMetrics.CharacterIterationMetrics = CharacterIterationMetrics{}
 
@@ -419,6 +479,7 @@ func (unitMetrics *UnitMetrics) doneIteration(unit *Unit, sim *Simulation) {
 	unitMetrics.dtps.doneIteration(sim)
 	unitMetrics.tmi.doneIteration(sim)
 	unitMetrics.hps.doneIteration(sim)
+	unitMetrics.ehps.doneIteration(sim)
 	unitMetrics.tto.doneIteration(sim)
 
 	unitMetrics.oomTimeSum += unitMetrics.OOMTime.Seconds()
@@ -427,6 +488,56 @@ func (unitMetrics *UnitMetrics) doneIteration(unit *Unit, sim *Simulation) {
 	}
 }
 
+// Merges in the aggregate metrics of the same unit from another Simulation, which
+// must cover later iterations.
+func (unitMetrics *UnitMetrics) merge(other *UnitMetrics) {
+	unitMetrics.dps.merge(&other.dps)
+	unitMetrics.dpasp.merge(&other.dpasp)
+	unitMetrics.threat.merge(&other.threat)
+	unitMetrics.dtps.merge(&other.dtps)
+	unitMetrics.tmi.merge(&other.tmi)
+	unitMetrics.hps.merge(&other.hps)
+	unitMetrics.ehps.merge(&other.ehps)
+	unitMetrics.tto.merge(&other.tto)
+
+	unitMetrics.numItersDead += other.numItersDead
+	unitMetrics.oomTimeSum += other.oomTimeSum
+
+	for actionID, otherAction := range other.actions {
+		if action, ok := unitMetrics.actions[actionID]; ok {
+			action.merge(otherAction)
+		} else {
+			unitMetrics.actions[actionID] = otherAction
+		}
+	}
+
+	// Some resource metrics are created lazily, so their order can differ between
+	// Simulations. Match up the nth metrics with a given key on each side instead.
+	seen := make(map[ResourceKey]int, len(other.resources))
+	for _, otherResource := range other.resources {
+		key := ResourceKey{ActionID: otherResource.ActionID, Type: otherResource.Type}
+		nth := seen[key]
+		seen[key]++
+
+		var resource *ResourceMetrics
+		for _, r := range unitMetrics.resources {
+			if r.ActionID == key.ActionID && r.Type == key.Type {
+				if nth == 0 {
+					resource = r
+					break
+				}
+				nth--
+			}
+		}
+
+		if resource != nil {
+			resource.merge(otherResource)
+		} else {
+			unitMetrics.resources = append(unitMetrics.resources, otherResource)
+		}
+	}
+}
+
 func (unitMetrics *UnitMetrics) calculateTMI(unit *Unit, sim *Simulation) float64 {
 	if unit.Metrics.tmiList == nil || unitMetrics.tmiBin == 0 {
 		return 0
@@ -508,14 +619,31 @@ func (unitMetrics *UnitMetrics) ToProto() *proto.UnitMetrics {
 		Dtps:          unitMetrics.dtps.ToProto(),
 		Tmi:           unitMetrics.tmi.ToProto(),
 		Hps:           unitMetrics.hps.ToProto(),
+		Ehps:          unitMetrics.ehps.ToProto(),
 		Tto:           unitMetrics.tto.ToProto(),
 		SecondsOomAvg: unitMetrics.oomTimeSum / n,
 		ChanceOfDeath: float64(unitMetrics.numItersDead) / n,
 	}
 
+	// Sort the actions so results don't depend on map iteration order.
+	actionIDs := make([]ActionID, 0, len(unitMetrics.actions))
+	for actionID := range unitMetrics.actions {
+		actionIDs = append(actionIDs, actionID)
+	}
+	slices.SortFunc(actionIDs, func(a, b ActionID) int {
+		if a.SpellID != b.SpellID {
+			return cmp.Compare(a.SpellID, b.SpellID)
+		} else if a.ItemID != b.ItemID {
+			return cmp.Compare(a.ItemID, b.ItemID)
+		} else if a.OtherID != b.OtherID {
+			return cmp.Compare(a.OtherID, b.OtherID)
+		}
+		return cmp.Compare(a.Tag, b.Tag)
+	})
+
 	protoMetrics.Actions = make([]*proto.ActionMetrics, 0, len(unitMetrics.actions))
-	for actionID, action := range unitMetrics.actions {
-		protoMetrics.Actions = append(protoMetrics.Actions, action.ToProto(actionID))
+	for _, actionID := range actionIDs {
+		protoMetrics.Actions = append(protoMetrics.Actions, unitMetrics.actions[actionID].ToProto(actionID))
 	}
 
 	protoMetrics.Resources = make([]*proto.ResourceMetrics, 0, len(unitMetrics.resources))
@@ -551,6 +679,11 @@ func (auraMetrics *AuraMetrics) doneIteration() {
 	auraMetrics.procsSum += auraMetrics.Procs
 }
 
+func (auraMetrics *AuraMetrics) merge(other *AuraMetrics) {
+	auraMetrics.aggregator = *auraMetrics.aggregator.merge(&other.aggregator)
+	auraMetrics.procsSum += other.procsSum
+}
+
 func (auraMetrics *AuraMetrics) ToProto() *proto.AuraMetrics {
 	mean, stdev := auraMetrics.meanAndStdDev()
 
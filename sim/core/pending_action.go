@@ -35,6 +35,12 @@ type PendingAction struct {
 
 	cancelled bool
 	consumed  bool
+
+	// 1-based position in the Simulation's queue, or 0 if not queued.
+	queueIndex int32
+
+	// Set for actions created by NewPeriodicAction.
+	periodic *periodicAction
 }
 
 func (pa *PendingAction) Cancel(sim *Simulation) {
@@ -48,4 +54,5 @@ func (pa *PendingAction) Cancel(sim *Simulation) {
 	}
 
 	pa.cancelled = true
+	sim.pendingActions.remove(pa)
 }
@@ -0,0 +1,170 @@
+package core
+
+import (
+	"slices"
+	"time"
+)
+
+// Binary min-heap of pending actions. Actions are ordered by NextActionAt, then
+// by descending Priority, then in the order they were added.
+type pendingActionQueue struct {
+	entries []pendingActionEntry
+
+	// Incremented for every added action, used as the final tie-breaker.
+	seq uint64
+
+	// Reused by forEachLatestFirst.
+	sorted []pendingActionEntry
+}
+
+// The ordering keys are copied on insertion, so changes to an action which is
+// already queued don't break the heap.
+type pendingActionEntry struct {
+	pa           *PendingAction
+	nextActionAt time.Duration
+	priority     ActionPriority
+	seq          uint64
+}
+
+func (e *pendingActionEntry) before(other *pendingActionEntry) bool {
+	if e.nextActionAt != other.nextActionAt {
+		return e.nextActionAt < other.nextActionAt
+	}
+	if e.priority != other.priority {
+		return e.priority > other.priority
+	}
+	return e.seq < other.seq
+}
+
+func (q *pendingActionQueue) reset() {
+	for i := range q.entries {
+		q.entries[i].pa.queueIndex = 0
+	}
+	clear(q.entries)
+	q.entries = q.entries[:0]
+	q.seq = 0
+}
+
+func (q *pendingActionQueue) len() int {
+	return len(q.entries)
+}
+
+// Adds pa to the queue. If pa is already queued it's moved instead, as if it
+// had been removed and added again.
+func (q *pendingActionQueue) push(pa *PendingAction) {
+	entry := pendingActionEntry{
+		pa:           pa,
+		nextActionAt: pa.NextActionAt,
+		priority:     pa.Priority,
+		seq:          q.seq,
+	}
+	q.seq++
+
+	if i := int(pa.queueIndex) - 1; i >= 0 {
+		q.entries[i] = entry
+		q.fix(i)
+		return
+	}
+
+	q.entries = append(q.entries, entry)
+	q.up(len(q.entries) - 1)
+}
+
+// Returns the next action without removing it, or nil if the queue is empty.
+func (q *pendingActionQueue) peek() *PendingAction {
+	if len(q.entries) == 0 {
+		return nil
+	}
+	return q.entries[0].pa
+}
+
+func (q *pendingActionQueue) pop() *PendingAction {
+	pa := q.entries[0].pa
+	q.removeAt(0)
+	return pa
+}
+
+// Removes pa from the queue, if it's queued.
+func (q *pendingActionQueue) remove(pa *PendingAction) {
+	if i := int(pa.queueIndex) - 1; i >= 0 {
+		q.removeAt(i)
+	}
+}
+
+func (q *pendingActionQueue) removeAt(i int) {
+	q.entries[i].pa.queueIndex = 0
+
+	last := len(q.entries) - 1
+	if i != last {
+		q.entries[i] = q.entries[last]
+	}
+	q.entries[last] = pendingActionEntry{}
+	q.entries = q.entries[:last]
+
+	if i != last {
+		q.fix(i)
+	}
+}
+
+func (q *pendingActionQueue) fix(i int) {
+	if i > 0 && q.entries[i].before(&q.entries[(i-1)/2]) {
+		q.up(i)
+	} else {
+		q.down(i)
+	}
+}
+
+func (q *pendingActionQueue) up(i int) {
+	entry := q.entries[i]
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !entry.before(&q.entries[parent]) {
+			break
+		}
+		q.set(i, q.entries[parent])
+		i = parent
+	}
+	q.set(i, entry)
+}
+
+func (q *pendingActionQueue) down(i int) {
+	n := len(q.entries)
+	entry := q.entries[i]
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && q.entries[right].before(&q.entries[child]) {
+			child = right
+		}
+		if !q.entries[child].before(&entry) {
+			break
+		}
+		q.set(i, q.entries[child])
+		i = child
+	}
+	q.set(i, entry)
+}
+
+func (q *pendingActionQueue) set(i int, entry pendingActionEntry) {
+	q.entries[i] = entry
+	entry.pa.queueIndex = int32(i + 1)
+}
+
+// Calls f for each queued action, latest first. Actions added by f aren't
+// visited.
+func (q *pendingActionQueue) forEachLatestFirst(f func(pa *PendingAction)) {
+	q.sorted = append(q.sorted[:0], q.entries...)
+	slices.SortFunc(q.sorted, func(a, b pendingActionEntry) int {
+		if b.before(&a) {
+			return -1
+		}
+		return 1
+	})
+
+	for i := range q.sorted {
+		f(q.sorted[i].pa)
+	}
+	clear(q.sorted)
+}
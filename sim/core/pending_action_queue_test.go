@@ -0,0 +1,67 @@
+package core
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// Reference implementation: the sorted slice the queue replaced, with the next
+// action at the back.
+func addToSortedPendingActions(actions []*PendingAction, pa *PendingAction) []*PendingAction {
+	for index, v := range actions {
+		if v.NextActionAt < pa.NextActionAt || (v.NextActionAt == pa.NextActionAt && v.Priority >= pa.Priority) {
+			actions = append(actions, pa)
+			copy(actions[index+1:], actions[index:])
+			actions[index] = pa
+			return actions
+		}
+	}
+	return append(actions, pa)
+}
+
+func TestPendingActionQueueOrder(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	var queue pendingActionQueue
+	var sorted []*PendingAction
+
+	newAction := func() *PendingAction {
+		return &PendingAction{
+			NextActionAt: time.Duration(r.Intn(20)) * time.Second,
+			Priority:     ActionPriority(r.Intn(5) - 1),
+		}
+	}
+
+	for round := 0; round < 200; round++ {
+		for i := r.Intn(10); i > 0; i-- {
+			pa := newAction()
+			queue.push(pa)
+			sorted = addToSortedPendingActions(sorted, pa)
+		}
+
+		var latestFirst []*PendingAction
+		queue.forEachLatestFirst(func(pa *PendingAction) {
+			latestFirst = append(latestFirst, pa)
+		})
+		if len(latestFirst) != len(sorted) {
+			t.Fatalf("Expected %d queued actions, got %d", len(sorted), len(latestFirst))
+		}
+		for i := range sorted {
+			if latestFirst[i] != sorted[i] {
+				t.Fatalf("Queued actions differ at index %d", i)
+			}
+		}
+
+		for i := r.Intn(10); i > 0 && queue.len() > 0; i-- {
+			expected := sorted[len(sorted)-1]
+			sorted = sorted[:len(sorted)-1]
+			if pa := queue.peek(); pa != expected {
+				t.Fatalf("Peeked action at %s/%d, expected %s/%d", pa.NextActionAt, pa.Priority, expected.NextActionAt, expected.Priority)
+			}
+			if pa := queue.pop(); pa != expected {
+				t.Fatalf("Popped action at %s/%d, expected %s/%d", pa.NextActionAt, pa.Priority, expected.NextActionAt, expected.Priority)
+			}
+		}
+	}
+}
@@ -34,6 +34,15 @@ func StartDelayedAction(sim *Simulation, options DelayedActionOptions) *PendingA
 	return pa
 }
 
+// Like StartDelayedAction, for callbacks which never need to be cancelled. The
+// action is recycled after it runs, so scheduling it doesn't allocate.
+func ScheduleDelayedCallback(sim *Simulation, doAt time.Duration, callback func(*Simulation)) {
+	pa := sim.schedulePooledAction(doAt, func(sim *Simulation, pa *pooledAction) {
+		pa.callback(sim)
+	})
+	pa.callback = callback
+}
+
 type PeriodicActionOptions struct {
 	// How often the action should be performed.
 	Period time.Duration
@@ -53,46 +62,76 @@ type PeriodicActionOptions struct {
 }
 
 func NewPeriodicAction(sim *Simulation, options PeriodicActionOptions) *PendingAction {
+	return ReusePeriodicAction(sim, nil, options)
+}
+
+// Like NewPeriodicAction, but reuses pa if it was created by NewPeriodicAction
+// and can't run anymore, i.e. it isn't queued or running. pa may be nil.
+//
+// Owners which repeatedly start periodic actions can use this to avoid
+// allocating a new action each time.
+func ReusePeriodicAction(sim *Simulation, pa *PendingAction, options PeriodicActionOptions) *PendingAction {
 	if options.OnAction == nil {
 		panic("NewPeriodicAction: OnAction must not be nil")
 	}
 
-	pa := &PendingAction{
+	var periodic *periodicAction
+	if pa != nil && pa.periodic != nil && pa.queueIndex == 0 && !pa.periodic.running {
+		periodic = pa.periodic
+	} else {
+		periodic = &periodicAction{}
+		periodic.PendingAction.periodic = periodic
+		periodic.PendingAction.OnAction = periodic.onAction
+	}
+
+	periodic.PendingAction = PendingAction{
 		NextActionAt: sim.CurrentTime + options.Period,
 		Priority:     options.Priority,
+		OnAction:     periodic.OnAction,
 		CleanUp:      options.CleanUp,
+		periodic:     periodic,
 	}
-
-	tickIndex := 0
-
-	pa.OnAction = func(sim *Simulation) {
-		options.OnAction(sim)
-		tickIndex++
-
-		if options.NumTicks == 0 || tickIndex < options.NumTicks {
-			// Refresh action.
-			pa.NextActionAt = sim.CurrentTime + options.Period
-			sim.AddPendingAction(pa)
-		} else {
-			pa.Cancel(sim)
-		}
-	}
+	periodic.options = options
+	periodic.tickIndex = 0
 
 	if options.TickImmediately {
 		// t = 0 might be during reset, so put it in the actions queue instead of
 		// invoking the callback directly.
 		if sim.CurrentTime == 0 {
-			pa.NextActionAt = 0
+			periodic.NextActionAt = 0
 		} else {
 			options.OnAction(sim)
-			tickIndex++
+			periodic.tickIndex++
 			if options.NumTicks == 1 {
-				pa.Cancel(sim)
+				periodic.Cancel(sim)
 			}
 		}
 	}
 
-	return pa
+	return &periodic.PendingAction
+}
+
+type periodicAction struct {
+	PendingAction
+
+	options   PeriodicActionOptions
+	tickIndex int
+	running   bool
+}
+
+func (pa *periodicAction) onAction(sim *Simulation) {
+	pa.running = true
+	pa.options.OnAction(sim)
+	pa.running = false
+	pa.tickIndex++
+
+	if pa.options.NumTicks == 0 || pa.tickIndex < pa.options.NumTicks {
+		// Refresh action.
+		pa.NextActionAt = sim.CurrentTime + pa.options.Period
+		sim.AddPendingAction(&pa.PendingAction)
+	} else {
+		pa.Cancel(sim)
+	}
 }
 
 // Convenience for immediately creating and starting a periodic action.
@@ -48,7 +48,8 @@ type Pet struct {
 
 	// Some pets expire after a certain duration. This is the pending action that disables
 	// the pet on expiration.
-	timeoutAction *PendingAction
+	timeoutAction         *PendingAction
+	reusableTimeoutAction *PendingAction
 }
 
 func NewPet(name string, owner *Character, baseStats stats.Stats, statInheritance PetStatInheritance, enabledOnStart bool, isGuardian bool) Pet {
@@ -168,7 +169,7 @@ func (pet *Pet) Enable(sim *Simulation, petAgent PetAgent) {
 	if sim.Log != nil {
 		pet.Log(sim, "Pet stats: %s", pet.GetStats().FlatString())
 		pet.Log(sim, "Pet inherited stats: %s", pet.ApplyStatDependencies(pet.inheritedStats).FlatString())
-		pet.Log(sim, "Pet summoned")
+		sim.logUnitEvent(proto.CombatEvent_TypePetSummoned, &pet.Unit, nil)
 	}
 
 	sim.addTracker(&pet.auraTracker)
@@ -182,12 +183,18 @@ func (pet *Pet) Enable(sim *Simulation, petAgent PetAgent) {
 func (pet *Pet) EnableWithTimeout(sim *Simulation, petAgent PetAgent, petDuration time.Duration) {
 	pet.Enable(sim, petAgent)
 
-	pet.timeoutAction = &PendingAction{
-		NextActionAt: sim.CurrentTime + petDuration,
-		OnAction: func(sim *Simulation) {
-			pet.Disable(sim)
-		},
+	// Reuse the previous timeout unless it's still pending.
+	if pet.reusableTimeoutAction == nil || pet.reusableTimeoutAction.queueIndex != 0 {
+		pet.reusableTimeoutAction = &PendingAction{
+			OnAction: func(sim *Simulation) {
+				pet.Disable(sim)
+			},
+		}
 	}
+
+	pet.timeoutAction = pet.reusableTimeoutAction
+	pet.timeoutAction.NextActionAt = sim.CurrentTime + petDuration
+	pet.timeoutAction.cancelled = false
 	sim.AddPendingAction(pet.timeoutAction)
 }
 
@@ -257,7 +264,7 @@ func (pet *Pet) Disable(sim *Simulation) {
 	sim.removeTracker(&pet.auraTracker)
 
 	if sim.Log != nil {
-		pet.Log(sim, "Pet dismissed")
+		sim.logUnitEvent(proto.CombatEvent_TypePetDismissed, &pet.Unit, nil)
 		pet.Log(sim, pet.GetStats().FlatString())
 	}
 }
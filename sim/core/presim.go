@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"time"
 
 	"github.com/wowsims/sod/sim/core/proto"
@@ -31,35 +32,12 @@ type PresimOptions struct {
 	OnPresimResult func(presimResult *proto.UnitMetrics, iterations int32, duration time.Duration) bool
 }
 
-func (sim *Simulation) runPresims(request *proto.RaidSimRequest) *proto.RaidSimResult {
-	const numPresimIterations = 100
+const numPresimIterations = 100
 
+// Runs presim rounds until every Agent is done, or ctx is cancelled.
+func (sim *Simulation) runPresims(ctx context.Context, request *proto.RaidSimRequest) *proto.RaidSimResult {
 	// Run presims if requested.
-	raidPresimOptions := make([]*PresimOptions, 25)
-	remainingAgents := 0
-	for _, party := range sim.Raid.Parties {
-		for _, player := range party.Players {
-			presimmer, ok := player.(Presimmer)
-			if !ok {
-				continue
-			}
-
-			partyConfig := request.Raid.Parties[player.GetCharacter().Party.Index]
-			if player.GetCharacter().PartyIndex >= len(partyConfig.Players) {
-				// This happens for target dummies.
-				continue
-			}
-			playerConfig := partyConfig.Players[player.GetCharacter().PartyIndex]
-
-			presimOptions := presimmer.GetPresimOptions(playerConfig)
-			if presimOptions == nil {
-				continue
-			}
-
-			raidPresimOptions[player.GetCharacter().Index] = presimOptions
-			remainingAgents++
-		}
-	}
+	raidPresimOptions, remainingAgents := sim.getPresimOptions(request)
 
 	// Base presim request.
 	// Define this outside the loop so that, as Agents iteratively update their
@@ -69,6 +47,9 @@ func (sim *Simulation) runPresims(request *proto.RaidSimRequest) *proto.RaidSimR
 	presimRequest.SimOptions.RandomSeed = 1
 	presimRequest.SimOptions.Debug = false
 	presimRequest.SimOptions.DebugFirstIteration = false
+	presimRequest.SimOptions.Replay = nil
+	presimRequest.SimOptions.SaveIterationResults = false
+	presimRequest.SimOptions.Timeseries = nil
 	presimRequest.SimOptions.Iterations = numPresimIterations
 	duration := DurationFromSeconds(presimRequest.Encounter.Duration)
 
@@ -94,29 +75,80 @@ func (sim *Simulation) runPresims(request *proto.RaidSimRequest) *proto.RaidSimR
 		}
 
 		// Run the presim.
-		presimResult := runSim(presimRequest, nil, true)
+		presimResult := runSim(ctx, presimRequest, nil, true)
 		lastResult = presimResult
 
-		if presimResult.ErrorResult != "" {
+		if presimResult.ErrorResult != "" || presimResult.Cancelled {
 			break
 		}
 
-		// Provide each Agent with their own results.
-		for partyIdx, party := range sim.Raid.Parties {
-			partyMetrics := presimResult.RaidMetrics.Parties[partyIdx]
-			for _, player := range party.Players {
-				playerMetrics := partyMetrics.Players[player.GetCharacter().PartyIndex]
-				presimOptions := raidPresimOptions[player.GetCharacter().Index]
-				if presimOptions != nil {
-					done := presimOptions.OnPresimResult(playerMetrics, numPresimIterations, duration)
-					if done {
-						raidPresimOptions[player.GetCharacter().Index] = nil
-						remainingAgents--
-					}
+		sim.presimResults = append(sim.presimResults, presimResult)
+		remainingAgents -= sim.applyPresimResult(raidPresimOptions, presimResult, numPresimIterations, duration)
+		doOne = false
+	}
+	return lastResult
+}
+
+// Returns the presim options of each Agent indexed by raid index, and the number of Agents which want presims.
+func (sim *Simulation) getPresimOptions(request *proto.RaidSimRequest) ([]*PresimOptions, int) {
+	raidPresimOptions := make([]*PresimOptions, 25)
+	numAgents := 0
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			presimmer, ok := player.(Presimmer)
+			if !ok {
+				continue
+			}
+
+			partyConfig := request.Raid.Parties[player.GetCharacter().Party.Index]
+			if player.GetCharacter().PartyIndex >= len(partyConfig.Players) {
+				// This happens for target dummies.
+				continue
+			}
+			playerConfig := partyConfig.Players[player.GetCharacter().PartyIndex]
+
+			presimOptions := presimmer.GetPresimOptions(playerConfig)
+			if presimOptions == nil {
+				continue
+			}
+
+			raidPresimOptions[player.GetCharacter().Index] = presimOptions
+			numAgents++
+		}
+	}
+	return raidPresimOptions, numAgents
+}
+
+// Provides each Agent with their own results, and returns the number of Agents
+// which are now done running presims.
+func (sim *Simulation) applyPresimResult(raidPresimOptions []*PresimOptions, presimResult *proto.RaidSimResult, iterations int32, duration time.Duration) int {
+	numDone := 0
+	for partyIdx, party := range sim.Raid.Parties {
+		partyMetrics := presimResult.RaidMetrics.Parties[partyIdx]
+		for _, player := range party.Players {
+			playerMetrics := partyMetrics.Players[player.GetCharacter().PartyIndex]
+			presimOptions := raidPresimOptions[player.GetCharacter().Index]
+			if presimOptions != nil {
+				done := presimOptions.OnPresimResult(playerMetrics, iterations, duration)
+				if done {
+					raidPresimOptions[player.GetCharacter().Index] = nil
+					numDone++
 				}
 			}
 		}
-		doOne = false
 	}
-	return lastResult
+	return numDone
+}
+
+// replayPresims applies the presim results of another Simulation built from the
+// same request, leaving the Agents in the same state as if the presims had been run.
+func (sim *Simulation) replayPresims(request *proto.RaidSimRequest, presimResults []*proto.RaidSimResult) {
+	if len(presimResults) == 0 {
+		return
+	}
+
+	raidPresimOptions, _ := sim.getPresimOptions(request)
+	for _, presimResult := range presimResults {
+		sim.applyPresimResult(raidPresimOptions, presimResult, numPresimIterations, DurationFromSeconds(request.Encounter.Duration))
+	}
 }
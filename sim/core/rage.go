@@ -151,20 +151,18 @@ func (rb *rageBar) AddRage(sim *Simulation, amount float64, metrics *ResourceMet
 	metrics.AddEvent(amount, newRage-rb.currentRage)
 
 	if sim.Log != nil {
-		rb.unit.Log(sim, "Gained %0.3f rage from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, rb.currentRage, newRage)
+		sim.logResourceChange(rb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeRage, amount, rb.currentRage, newRage)
 	}
 
 	rb.currentRage = newRage
 	if !sim.Options.Interactive {
 		rb.unit.Rotation.DoNextAction(sim)
 	}
-	StartDelayedAction(sim, DelayedActionOptions{
-		DoAt: sim.CurrentTime + time.Millisecond*1,
-		OnAction: func(sim *Simulation) {
-			rb.unit.OnRageChange(sim, metrics)
-		},
+	pa := sim.schedulePooledAction(sim.CurrentTime+time.Millisecond*1, func(sim *Simulation, pa *pooledAction) {
+		pa.unit.OnRageChange(sim, pa.metrics)
 	})
-
+	pa.unit = rb.unit
+	pa.metrics = metrics
 }
 
 func (rb *rageBar) SpendRage(sim *Simulation, amount float64, metrics *ResourceMetrics) {
@@ -176,7 +174,7 @@ func (rb *rageBar) SpendRage(sim *Simulation, amount float64, metrics *ResourceM
 	metrics.AddEvent(-amount, -amount)
 
 	if sim.Log != nil {
-		rb.unit.Log(sim, "Spent %0.3f rage from %s (%0.3f --> %0.3f).", amount, metrics.ActionID, rb.currentRage, newRage)
+		sim.logResourceChange(rb.unit, metrics.ActionID, proto.ResourceType_ResourceTypeRage, -amount, rb.currentRage, newRage)
 	}
 
 	rb.currentRage = newRage
@@ -91,6 +91,11 @@ func (party *Party) doneIteration(sim *Simulation) {
 	party.hpsMetrics.doneIteration(sim)
 }
 
+func (party *Party) mergeMetrics(other *Party) {
+	party.dpsMetrics.merge(&other.dpsMetrics)
+	party.hpsMetrics.merge(&other.hpsMetrics)
+}
+
 func (party *Party) GetMetrics() *proto.PartyMetrics {
 	metrics := &proto.PartyMetrics{
 		Dps: party.dpsMetrics.ToProto(),
@@ -127,6 +132,8 @@ type Raid struct {
 	replenishmentUnits         []*Unit   // All units who can receive replenishment.
 	curReplenishmentUnits      [][]*Unit // Units that currently have replenishment active, separated by source.
 	leftoverReplenishmentUnits []*Unit   // Units without replenishment currently active.
+
+	smartHealTargets []*Unit // Reused by SmartHealTargets().
 }
 
 func (raid *Raid) GetActiveUnits() []*Unit {
@@ -280,7 +287,11 @@ func (raid *Raid) applyCharacterEffects(raidConfig *proto.Raid) *proto.RaidStats
 		// Apply all buffs to the players in this party.
 		for playerIdx, player := range party.Players {
 			if playerIdx >= len(partyConfig.Players) {
-				// This happens for target dummies.
+				// This happens for target dummies, which only need health for
+				// healers to heal.
+				char := player.GetCharacter()
+				char.EnableHealthBar()
+				char.trackChanceOfDeath(nil)
 				continue
 			}
 			playerConfig := partyConfig.Players[playerIdx]
@@ -379,6 +390,16 @@ func (raid *Raid) doneIteration(sim *Simulation) {
 	raid.hpsMetrics.doneIteration(sim)
 }
 
+// Merges in the raid and party metrics from a Raid in another Simulation, built
+// from the same request. Unit metrics are merged separately.
+func (raid *Raid) mergeMetrics(other *Raid) {
+	raid.dpsMetrics.merge(&other.dpsMetrics)
+	raid.hpsMetrics.merge(&other.hpsMetrics)
+	for i, party := range raid.Parties {
+		party.mergeMetrics(other.Parties[i])
+	}
+}
+
 func (raid *Raid) GetMetrics() *proto.RaidMetrics {
 	metrics := &proto.RaidMetrics{
 		Dps: raid.dpsMetrics.ToProto(),
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// raidDamageModel deals the incoming raid damage of an encounter, see
+// proto.RaidDamageModel. The damage is dealt by the first target, so that it
+// shows up in its metrics and triggers the usual damage taken effects.
+type raidDamageModel struct {
+	config *proto.RaidDamageModel
+
+	spell *Spell
+
+	// Players which can be hit, and a scratch buffer for picking the ones hit
+	// by each pulse.
+	candidates []*Unit
+	hit        []*Unit
+
+	damagePerHit float64
+
+	minCadence  float64
+	maxCadence  float64
+	lastPulseAt time.Duration
+	pulseAction *PendingAction
+}
+
+func newRaidDamageModel(config *proto.RaidDamageModel) *raidDamageModel {
+	if config == nil || config.DamagePerSecond <= 0 {
+		return nil
+	}
+
+	cadence := config.CadenceSeconds
+	if cadence <= 0 {
+		cadence = 2
+	}
+
+	return &raidDamageModel{
+		config:     config,
+		minCadence: max(cadence-config.CadenceVariation, 0.1),
+		maxCadence: cadence + config.CadenceVariation,
+	}
+}
+
+// HasRaidDamage returns whether the encounter damages the whole raid, in which
+// case the health of every raid member is tracked.
+func (encounter *Encounter) HasRaidDamage() bool {
+	return encounter.raidDamage != nil
+}
+
+func (rdm *raidDamageModel) initialize(env *Environment) {
+	for _, player := range env.Raid.AllPlayerUnits {
+		if rdm.config.IncludeTanks || !isTanked(env, player) {
+			rdm.candidates = append(rdm.candidates, player)
+		}
+	}
+	rdm.hit = make([]*Unit, len(rdm.candidates))
+
+	school := SpellSchoolFromProto(rdm.config.School)
+	spellConfig := SpellConfig{
+		ActionID:    ActionID{OtherID: proto.OtherAction_OtherActionRaidDamage},
+		SpellSchool: school,
+		DefenseType: DefenseTypeMagic,
+		ProcMask:    ProcMaskSpellDamage,
+		Flags:       SpellFlagIgnoreAttackerModifiers,
+
+		DamageMultiplier: 1,
+
+		ApplyEffects: func(sim *Simulation, target *Unit, spell *Spell) {
+			spell.CalcAndDealDamage(sim, target, rdm.damagePerHit, spell.OutcomeAlwaysHit)
+		},
+	}
+	if school == SpellSchoolPhysical {
+		spellConfig.DefenseType = DefenseTypeMelee
+		spellConfig.ProcMask = ProcMaskMeleeMHSpecial
+	}
+	rdm.spell = env.Encounter.Targets[0].RegisterSpell(spellConfig)
+
+	rdm.pulseAction = &PendingAction{
+		OnAction: rdm.pulse,
+	}
+}
+
+// Whether any target of the encounter is tanked by this unit.
+func isTanked(env *Environment, unit *Unit) bool {
+	for _, target := range env.Encounter.TargetUnits {
+		if target.CurrentTarget == unit {
+			return true
+		}
+	}
+	return false
+}
+
+func (rdm *raidDamageModel) reset(sim *Simulation) {
+	rdm.lastPulseAt = 0
+	rdm.schedulePulse(sim)
+}
+
+func (rdm *raidDamageModel) schedulePulse(sim *Simulation) {
+	cadence := rdm.minCadence
+	if rdm.maxCadence > rdm.minCadence {
+		cadence += sim.RandomFloat("Raid Damage Cadence") * (rdm.maxCadence - rdm.minCadence)
+	}
+	rdm.pulseAction.NextActionAt = max(sim.CurrentTime, 0) + DurationFromSeconds(cadence)
+	sim.AddPendingAction(rdm.pulseAction)
+}
+
+func (rdm *raidDamageModel) pulse(sim *Simulation) {
+	numHit := len(rdm.candidates)
+	if rdm.config.TargetsPerPulse > 0 {
+		numHit = min(numHit, int(rdm.config.TargetsPerPulse))
+	}
+
+	if numHit > 0 {
+		// Partial Fisher-Yates shuffle, the first numHit players are hit.
+		copy(rdm.hit, rdm.candidates)
+		for i := 0; i < numHit; i++ {
+			j := i + int(sim.RandomFloat("Raid Damage Target")*float64(len(rdm.hit)-i))
+			j = min(j, len(rdm.hit)-1)
+			rdm.hit[i], rdm.hit[j] = rdm.hit[j], rdm.hit[i]
+		}
+
+		// Scale each pulse by the time since the previous one, so the average
+		// damage per second matches the config regardless of cadence rolls.
+		elapsed := (sim.CurrentTime - rdm.lastPulseAt).Seconds()
+		rdm.damagePerHit = rdm.config.DamagePerSecond * elapsed / float64(numHit)
+		for _, target := range rdm.hit[:numHit] {
+			rdm.spell.Cast(sim, target)
+		}
+	}
+
+	rdm.lastPulseAt = sim.CurrentTime
+	rdm.schedulePulse(sim)
+}
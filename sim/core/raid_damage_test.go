@@ -0,0 +1,106 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+func setupHealingSim(raidDamage *proto.RaidDamageModel) *Simulation {
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 100,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: []*proto.Player{
+						{
+							Name:      "Tank",
+							Class:     proto.Class_ClassShaman,
+							Consumes:  &proto.Consumes{},
+							Buffs:     &proto.IndividualBuffs{},
+							Spec:      &proto.Player_ElementalShaman{},
+							Equipment: &proto.EquipmentSpec{},
+						},
+					},
+					Buffs: &proto.PartyBuffs{},
+				},
+				{
+					Buffs: &proto.PartyBuffs{},
+				},
+			},
+			Tanks:         []*proto.UnitReference{{Type: proto.UnitReference_Player, Index: 0}},
+			TargetDummies: 8,
+		},
+		Encounter: &proto.Encounter{
+			Targets: []*proto.Target{
+				{
+					Name:  "Boss",
+					Level: 60,
+					Stats: stats.Stats{stats.Health: 1_000_000}.ToFloatArray(),
+				},
+			},
+			Duration:   180,
+			RaidDamage: raidDamage,
+		},
+	})
+	sim.Reset()
+
+	return sim
+}
+
+func TestRaidDamageModel(t *testing.T) {
+	sim := setupHealingSim(&proto.RaidDamageModel{
+		DamagePerSecond:  400,
+		CadenceSeconds:   2,
+		CadenceVariation: 1,
+		TargetsPerPulse:  3,
+		School:           proto.SpellSchool_SpellSchoolPhysical,
+	})
+	tank := sim.Raid.AllPlayerUnits[0]
+	dummies := sim.Raid.AllPlayerUnits[1:]
+	rdm := sim.Encounter.raidDamage
+
+	if len(dummies) != 8 {
+		t.Fatalf("Expected 8 target dummies, got %d", len(dummies))
+	}
+	if len(rdm.candidates) != len(dummies) {
+		t.Fatalf("Expected only the target dummies to be hit, got %d candidates", len(rdm.candidates))
+	}
+
+	for sim.CurrentTime < time.Second*20 {
+		sim.Step()
+	}
+
+	missingHealth := 0.0
+	for _, dummy := range dummies {
+		missingHealth += dummy.MaxHealth() - dummy.CurrentHealth()
+	}
+	expected := 400 * rdm.lastPulseAt.Seconds()
+	if math.Abs(missingHealth-expected) > 0.01 {
+		t.Fatalf("Expected the raid to take %0.2f damage until the last pulse, got %0.2f", expected, missingHealth)
+	}
+
+	casts := rdm.spell.SpellMetrics[tank.UnitIndex].Casts
+	if casts != 0 || tank.CurrentHealth() != tank.MaxHealth() {
+		t.Fatalf("Expected the tank not to be hit by raid damage")
+	}
+	totalCasts := int32(0)
+	for _, dummy := range dummies {
+		totalCasts += rdm.spell.SpellMetrics[dummy.UnitIndex].Casts
+	}
+	if totalCasts%3 != 0 || totalCasts == 0 {
+		t.Fatalf("Expected each pulse to hit 3 players, got %d hits", totalCasts)
+	}
+}
+
+func TestNoRaidDamageModel(t *testing.T) {
+	sim := setupHealingSim(nil)
+	if sim.Encounter.HasRaidDamage() {
+		t.Fatalf("Expected no raid damage without a model")
+	}
+}
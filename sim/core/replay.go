@@ -0,0 +1,99 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// runReplay runs a single iteration of the sim with debug logging enabled. The
+// iteration is in the same state as in a full run of the same request, so it can
+// be used to inspect e.g. the best or worst iteration of that run.
+func (sim *Simulation) runReplay(replay *proto.ReplayOptions) *proto.RaidSimResult {
+	seed, err := sim.replaySeed(replay)
+	if err != nil {
+		result := &proto.RaidSimResult{ErrorResult: err.Error()}
+		if sim.ProgressReport != nil {
+			sim.ProgressReport(&proto.ProgressMetrics{FinalRaidResult: result})
+		}
+		return result
+	}
+
+	// Health fights which didn't get their duration from the presims estimate it
+	// during the first iteration, which all later iterations depend on.
+	if seed != sim.rseed && sim.Encounter.DurationIsEstimate {
+		sim.estimateDuration()
+	}
+
+	logsBuffer := &strings.Builder{}
+	sim.logTo(logsBuffer)
+
+	if id, ok := replay.IterationId.(*proto.ReplayOptions_Iteration); ok {
+		sim.currentIteration = id.Iteration
+	}
+	sim.seedRands(seed)
+	sim.runOnce()
+	duration := sim.iterationDuration()
+
+	result := &proto.RaidSimResult{
+		RaidMetrics:      sim.Raid.GetMetrics(),
+		EncounterMetrics: sim.Encounter.GetMetricsProto(),
+
+		Logs:                   logsBuffer.String(),
+		Events:                 sim.events,
+		IterationResults:       sim.iterationResults,
+		Timeseries:             sim.timeseries.ToProto(sim.Environment),
+		FirstIterationDuration: duration.Seconds(),
+		AvgIterationDuration:   duration.Seconds(),
+
+		CompletedIterations: 1,
+	}
+
+	if sim.ProgressReport != nil {
+		sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: 1, CompletedIterations: 1, Dps: result.RaidMetrics.Dps.Avg, FinalRaidResult: result})
+	}
+
+	return result
+}
+
+// Seed of the iteration to replay. Iterations after the first are seeded with
+// their index, see runIterations.
+func (sim *Simulation) replaySeed(replay *proto.ReplayOptions) (int64, error) {
+	// Without a fixed seed, every run uses a new one, so there's no earlier run to reproduce.
+	if sim.Options.RandomSeed == 0 {
+		return 0, errors.New("replaying an iteration requires a fixed random seed")
+	}
+
+	switch id := replay.IterationId.(type) {
+	case *proto.ReplayOptions_Seed:
+		return id.Seed, nil
+	case *proto.ReplayOptions_Iteration:
+		if id.Iteration < 0 || id.Iteration >= max(sim.Options.Iterations, 1) {
+			return 0, fmt.Errorf("replayed iteration %d is out of range, the sim has %d iterations", id.Iteration, max(sim.Options.Iterations, 1))
+		}
+		if id.Iteration > 0 {
+			return sim.Options.RandomSeed + int64(id.Iteration), nil
+		}
+	}
+	return sim.rseed, nil
+}
+
+// Runs the first iteration on a copy of this Simulation, and uses its duration
+// like the following iterations of a full run would.
+func (sim *Simulation) estimateDuration() {
+	if sim.request == nil {
+		panic("Cannot replay iterations of health fights without a request")
+	}
+
+	first := NewSim(googleProto.Clone(sim.request).(*proto.RaidSimRequest))
+	first.replayPresims(first.request, sim.presimResults)
+	first.rseed = sim.rseed
+	first.seedRands(sim.rseed)
+	first.runOnce()
+
+	sim.BaseDuration = first.CurrentTime
+	sim.Encounter.DurationIsEstimate = false
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func TestReplayReproducesIterations(t *testing.T) {
+	request := newMeleeTargetDummyRequest(50, 101)
+	result := RunSim(context.Background(), request, nil)
+	dps := result.EncounterMetrics.Targets[0].Dps
+
+	replay := func(replayOptions *proto.ReplayOptions) *proto.RaidSimResult {
+		request := newMeleeTargetDummyRequest(50, 101)
+		request.SimOptions.Replay = replayOptions
+		result := RunSim(context.Background(), request, nil)
+		if result.ErrorResult != "" {
+			t.Fatalf("Replay failed: %s", result.ErrorResult)
+		}
+		if result.CompletedIterations != 1 || result.Logs == "" {
+			t.Fatalf("Expected a single iteration with logs")
+		}
+		return result
+	}
+
+	for seed, expectedDps := range map[int64]float64{dps.MaxSeed: dps.Max, dps.MinSeed: dps.Min} {
+		replayed := replay(&proto.ReplayOptions{IterationId: &proto.ReplayOptions_Seed{Seed: seed}})
+		if replayedDps := replayed.EncounterMetrics.Targets[0].Dps.Avg; replayedDps != expectedDps {
+			t.Fatalf("Expected seed %d to reproduce %0.3f DPS, got %0.3f", seed, expectedDps, replayedDps)
+		}
+	}
+
+	first := replay(&proto.ReplayOptions{IterationId: &proto.ReplayOptions_Iteration{Iteration: 0}})
+	firstOnly := RunSim(context.Background(), newMeleeTargetDummyRequest(1, 101), nil)
+	if first.EncounterMetrics.Targets[0].Dps.Avg != firstOnly.EncounterMetrics.Targets[0].Dps.Avg {
+		t.Fatalf("Expected iteration 0 to match the first iteration of a full run")
+	}
+}
+
+func TestReplayRejectsInvalidIterations(t *testing.T) {
+	for _, tc := range []struct {
+		comment string
+		seed    int64
+		replay  *proto.ReplayOptions
+	}{
+		{"Iteration out of range", 101, &proto.ReplayOptions{IterationId: &proto.ReplayOptions_Iteration{Iteration: 50}}},
+		{"Negative iteration", 101, &proto.ReplayOptions{IterationId: &proto.ReplayOptions_Iteration{Iteration: -1}}},
+		{"No fixed random seed", 0, &proto.ReplayOptions{IterationId: &proto.ReplayOptions_Iteration{Iteration: 1}}},
+	} {
+		request := newMeleeTargetDummyRequest(50, tc.seed)
+		request.SimOptions.Replay = tc.replay
+		if result := RunSim(context.Background(), request, nil); result.ErrorResult == "" {
+			t.Errorf("%s: expected an error result", tc.comment)
+		}
+	}
+}
@@ -16,6 +16,9 @@ type Shield struct {
 
 	// Embed Aura so we can use IsActive/Refresh/etc directly.
 	*Aura
+
+	// Amount of damage the active shield can still absorb.
+	remaining float64
 }
 
 func (shield *Shield) Apply(sim *Simulation, shieldAmount float64) {
@@ -29,10 +32,11 @@ func (shield *Shield) Apply(sim *Simulation, shieldAmount float64) {
 
 	shield.Aura.Deactivate(sim)
 	shield.Aura.Activate(sim)
+	shield.remaining = shieldAmount
 
-	threat := 0.0 // TODO
+	// Shields generate threat like heals do, for the full amount when applied.
+	threat := shield.Spell.ThreatFromDamage(OutcomeHit, shieldAmount)
 	shield.Spell.SpellMetrics[target.UnitIndex].TotalThreat += threat
-	shield.Spell.SpellMetrics[target.UnitIndex].TotalShielding += shieldAmount
 	shield.Spell.SpellMetrics[target.UnitIndex].Hits++
 
 	if sim.Log != nil {
@@ -40,13 +44,53 @@ func (shield *Shield) Apply(sim *Simulation, shieldAmount float64) {
 	}
 }
 
+// Returns the amount of damage the shield can still absorb.
+func (shield *Shield) AbsorbRemaining() float64 {
+	if !shield.Aura.IsActive() {
+		return 0
+	}
+	return shield.remaining
+}
+
+// Absorbs as much of the incoming damage as possible, removing the shield once
+// it is depleted. Only absorbed damage counts towards shielding metrics.
+func (shield *Shield) absorb(sim *Simulation, result *SpellResult) {
+	if !shield.Aura.IsActive() || result.Damage <= 0 {
+		return
+	}
+
+	absorbed := min(shield.remaining, result.Damage)
+	result.Damage -= absorbed
+	shield.remaining -= absorbed
+	shield.Spell.SpellMetrics[result.Target.UnitIndex].TotalShielding += absorbed
+
+	if sim.Log != nil {
+		shield.Spell.Unit.Log(sim, "%s %s absorbed %0.3f damage, %0.3f remaining.", result.Target.LogLabel(), shield.Spell.ActionID, absorbed, shield.remaining)
+	}
+
+	if shield.remaining <= 0 {
+		shield.Aura.Deactivate(sim)
+	}
+}
+
 func newShield(config Shield) *Shield {
 	shield := &Shield{}
 	*shield = config
 
+	shield.Aura.Unit.shields = append(shield.Aura.Unit.shields, shield)
+
 	return shield
 }
 
+// Lets active shields on the target absorb damage that is being dealt. This
+// happens in the Deal phase, so results which are calculated but never dealt
+// do not consume any absorption.
+func (unit *Unit) absorbDamage(sim *Simulation, result *SpellResult) {
+	for _, shield := range unit.shields {
+		shield.absorb(sim, result)
+	}
+}
+
 type ShieldArray []*Shield
 
 func (shields ShieldArray) Get(target *Unit) *Shield {
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestShieldAbsorb(t *testing.T) {
+	sim := SetupFakeSim()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+	caster := &fa.Unit
+	shield := fa.Shield.Shield(caster)
+
+	shield.Apply(sim, 1000)
+	if !shield.IsActive() || shield.AbsorbRemaining() != 1000 {
+		t.Fatalf("Expected an active shield absorbing 1000, got %0.3f", shield.AbsorbRemaining())
+	}
+	if threat := fa.Shield.SpellMetrics[caster.UnitIndex].TotalThreat; threat != 500 {
+		t.Fatalf("Expected 500 threat from applying the shield, got %0.3f", threat)
+	}
+
+	// Results which are only calculated must not consume the shield.
+	result := fa.Spell.NewResult(caster)
+	result.Damage = 600
+	if shield.AbsorbRemaining() != 1000 {
+		t.Fatalf("Expected an undealt result to leave the shield untouched, got %0.3f remaining", shield.AbsorbRemaining())
+	}
+
+	fa.Spell.DealDamage(sim, result)
+	if result.Damage != 0 || shield.AbsorbRemaining() != 400 {
+		t.Fatalf("Expected the shield to absorb all 600 damage, got %0.3f damage and %0.3f remaining", result.Damage, shield.AbsorbRemaining())
+	}
+
+	result = fa.Spell.NewResult(caster)
+	result.Damage = 600
+	fa.Spell.DealDamage(sim, result)
+	if result.Damage != 200 {
+		t.Fatalf("Expected 200 damage to go through the depleted shield, got %0.3f", result.Damage)
+	}
+	if shield.IsActive() {
+		t.Fatalf("Expected the depleted shield to be removed")
+	}
+	if shielding := fa.Shield.SpellMetrics[caster.UnitIndex].TotalShielding; shielding != 1000 {
+		t.Fatalf("Expected 1000 shielding, got %0.3f", shielding)
+	}
+}
+
+func TestCalcPeriodicHealingWithoutHot(t *testing.T) {
+	sim := SetupFakeSim()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+
+	// The shield spell has no HoT, so this falls back to the spell's own coefficient.
+	result := fa.Shield.CalcPeriodicHealing(sim, &fa.Unit, 100, fa.Shield.OutcomeAlwaysHit)
+	if result.Damage != 100 {
+		t.Fatalf("Expected 100 healing, got %0.3f", result.Damage)
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math"
@@ -10,9 +11,11 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/wowsims/sod/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
 )
 
 type Task interface {
@@ -27,20 +30,53 @@ type Simulation struct {
 	rand  Rand
 	rseed int64
 
+	// Seed the current iteration started from, and the event loop steps and
+	// inputs since, see Snapshot.
+	iterationSeed int64
+	steps         int64
+	inputs        []simInput
+
+	// Request this Simulation was built from, used to construct additional
+	// shard Simulations. Nil for Simulations built directly from an Environment.
+	request *proto.RaidSimRequest
+
+	// Results of each presim round, so they can be replayed on shard Simulations.
+	presimResults []*proto.RaidSimResult
+
+	// Number of iterations run on this Simulation or merged into it, used to size
+	// the buffers for SimOptions.SaveAllValues. Shards only run their own iterations.
+	numSavedIterations int32
+
 	// Used for testing only, see RandomFloat().
 	isTest    bool
 	testRands map[string]Rand
 
 	// Current Simulation State
-	pendingActions []*PendingAction
-	CurrentTime    time.Duration // duration that has elapsed in the sim since starting
-	Duration       time.Duration // Duration of current iteration
-	NeedsInput     bool          // Sim is in interactive mode and needs input
+	pendingActions pendingActionQueue
+	actionPool     actionPool
+
+	// Actions which are added again for every iteration.
+	prepullPendingActions []*PendingAction
+	manaTickAction        *PendingAction
+	CurrentTime           time.Duration // duration that has elapsed in the sim since starting
+	Duration              time.Duration // Duration of current iteration
+	NeedsInput            bool          // Sim is in interactive mode and needs input
 
 	ProgressReport func(*proto.ProgressMetrics)
 
 	Log func(string, ...interface{})
 
+	// Typed versions of the log messages, see combat_events.go.
+	events []*proto.CombatEvent
+
+	// Index of the current iteration, and the results of all finished iterations
+	// if SimOptions.SaveIterationResults is set.
+	currentIteration int32
+	iterationResults []*proto.IterationUnitResult
+
+	// Nil unless SimOptions.Timeseries is set.
+	timeseries *timeseriesMetrics
+
 	executePhase int32 // 20, 25, or 35 for the respective execute range, 100 otherwise
 
 	executePhaseCallbacks []func(*Simulation, int32) // 2nd parameter is 35 for 35%, 25 for 25% and 20 for 20%
@@ -104,11 +140,13 @@ func (sim *Simulation) RemoveTask(task Task) {
 	}
 }
 
-func RunSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) *proto.RaidSimResult {
-	return runSim(rsr, progress, false)
+// RunSim runs the requested sim. If ctx is cancelled, the sim stops early and
+// returns the metrics of the iterations completed so far, flagged as cancelled.
+func RunSim(ctx context.Context, rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) *proto.RaidSimResult {
+	return runSim(ctx, rsr, progress, false)
 }
 
-func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool) (result *proto.RaidSimResult) {
+func runSim(ctx context.Context, rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool) (result *proto.RaidSimResult) {
 	if !rsr.SimOptions.IsTest {
 		defer func() {
 			if err := recover(); err != nil {
@@ -146,7 +184,7 @@ func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, ski
 			}
 			runtime.Gosched() // allow time for message to make it back out.
 		}
-		presimResult := sim.runPresims(rsr)
+		presimResult := sim.runPresims(ctx, rsr)
 		if presimResult != nil && presimResult.ErrorResult != "" {
 			if progress != nil {
 				progress <- &proto.ProgressMetrics{
@@ -167,7 +205,7 @@ func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, ski
 			runtime.Gosched() // allow time for message to make it back out.
 		}
 		// Use pre-sim as estimate for length of fight (when using health fight)
-		if sim.Encounter.EndFightAtHealth > 0 && presimResult != nil {
+		if sim.Encounter.EndFightAtHealth > 0 && presimResult != nil && !presimResult.Cancelled {
 			sim.BaseDuration = time.Duration(presimResult.AvgIterationDuration) * time.Second
 			sim.Duration = time.Duration(presimResult.AvgIterationDuration) * time.Second
 			sim.Encounter.DurationIsEstimate = false // we now have a pretty good value for duration
@@ -175,14 +213,19 @@ func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, ski
 	}
 
 	// using a variable here allows us to mutate it in the deferred recover, sending out error info
-	result = sim.run()
+	result = sim.run(ctx)
 
 	return result
 }
 
 func NewSim(rsr *proto.RaidSimRequest) *Simulation {
+	// Environment construction may modify the request, so keep a pristine copy around.
+	request := googleProto.Clone(rsr).(*proto.RaidSimRequest)
+
 	env, _, _ := NewEnvironment(rsr.Raid, rsr.Encounter, false)
-	return newSimWithEnv(env, rsr.SimOptions)
+	sim := newSimWithEnv(env, rsr.SimOptions)
+	sim.request = request
+	return sim
 }
 
 func newSimWithEnv(env *Environment, simOptions *proto.SimOptions) *Simulation {
@@ -198,8 +241,14 @@ func newSimWithEnv(env *Environment, simOptions *proto.SimOptions) *Simulation {
 		rand:  NewSplitMix(uint64(rseed)),
 		rseed: rseed,
 
+		iterationSeed: rseed,
+
+		numSavedIterations: simOptions.Iterations,
+
 		isTest:    simOptions.IsTest,
 		testRands: make(map[string]Rand),
+
+		timeseries: newTimeseriesMetrics(env, simOptions.Timeseries),
 	}
 }
 
@@ -228,7 +277,11 @@ func (sim *Simulation) labelRand(label string) Rand {
 }
 
 func (sim *Simulation) reseedRands(i int64) {
-	rseed := sim.Options.RandomSeed + i
+	sim.seedRands(sim.Options.RandomSeed + i)
+}
+
+func (sim *Simulation) seedRands(rseed int64) {
+	sim.iterationSeed = rseed
 	sim.rand.Seed(rseed)
 
 	if sim.isTest {
@@ -276,14 +329,19 @@ func (sim *Simulation) Reseed(seed int64) {
 
 // Run runs the simulation for the configured number of iterations, and
 // collects all the metrics together.
-func (sim *Simulation) run() *proto.RaidSimResult {
+//
+// The first iteration is always run, so that there are metrics to return even
+// if ctx is already cancelled.
+func (sim *Simulation) run(ctx context.Context) *proto.RaidSimResult {
+	if sim.Options.Replay != nil {
+		return sim.runReplay(sim.Options.Replay)
+	}
+
 	t0 := time.Now()
 
 	logsBuffer := &strings.Builder{}
 	if sim.Options.Debug || sim.Options.DebugFirstIteration {
-		sim.Log = func(message string, vals ...interface{}) {
-			logsBuffer.WriteString(fmt.Sprintf("[%0.2f] "+message+"\n", append([]interface{}{sim.CurrentTime.Seconds()}, vals...)...))
-		}
+		sim.logTo(logsBuffer)
 	}
 
 	// Uncomment this to print logs directly to console.
@@ -292,58 +350,108 @@ func (sim *Simulation) run() *proto.RaidSimResult {
 	// 	fmt.Printf(fmt.Sprintf("[%0.1f] "+message+"\n", append([]interface{}{sim.CurrentTime.Seconds()}, vals...)...))
 	// }
 
+	// Fail early on invalid convergence options.
+	trackedMetric := sim.convergenceMetric()
+
 	sim.runOnce()
-	firstIterationDuration := sim.Duration
-	if sim.Encounter.EndFightAtHealth != 0 {
-		firstIterationDuration = sim.CurrentTime
-	}
+	firstIterationDuration := sim.iterationDuration()
 	totalDuration := firstIterationDuration
 
 	if !sim.Options.Debug {
 		sim.Log = nil
 	}
 
-	var st time.Time
-	for i := int32(1); i < sim.Options.Iterations; i++ {
-		// fmt.Printf("Iteration: %d\n", i)
-		if sim.ProgressReport != nil && time.Since(st) > time.Millisecond*100 {
-			metrics := sim.Raid.GetMetrics()
-			sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: sim.Options.Iterations, CompletedIterations: i, Dps: metrics.Dps.Avg, Hps: metrics.Hps.Avg})
-			runtime.Gosched() // ensure that reporting threads are given time to report, mostly only important in wasm (only 1 thread)
-			st = time.Now()
-		}
-
-		// Before each iteration, reset state to seed+iterations
-		sim.reseedRands(int64(i))
-
-		sim.runOnce()
-		iterDuration := sim.Duration
-		if sim.Encounter.EndFightAtHealth != 0 {
-			iterDuration = sim.CurrentTime
-		}
-		totalDuration += iterDuration
+	completedIterations := int32(1)
+	var duration time.Duration
+	var numIterations int32
+	var converged bool
+	if numShards := sim.numShards(ctx); numShards > 1 {
+		duration, numIterations, converged = sim.runShards(ctx, numShards, numShardWorkers, &completedIterations)
+	} else {
+		duration, numIterations, converged = sim.runIterationsUntilConverged(ctx, 1, sim.Options.Iterations, &completedIterations)
 	}
+	totalDuration += duration
+	numIterations++ // Include the first iteration.
+
 	result := &proto.RaidSimResult{
 		RaidMetrics:      sim.Raid.GetMetrics(),
 		EncounterMetrics: sim.Encounter.GetMetricsProto(),
 
 		Logs:                   logsBuffer.String(),
+		Events:                 sim.events,
+		IterationResults:       sim.iterationResults,
+		Timeseries:             sim.timeseries.ToProto(sim.Environment),
 		FirstIterationDuration: firstIterationDuration.Seconds(),
-		AvgIterationDuration:   totalDuration.Seconds() / float64(sim.Options.Iterations),
+		AvgIterationDuration:   totalDuration.Seconds() / float64(numIterations),
+
+		Cancelled:           numIterations < sim.Options.Iterations && !converged,
+		CompletedIterations: numIterations,
+		ConfidenceInterval:  trackedMetric.confidenceInterval(),
 	}
 
 	// Final progress report
 	if sim.ProgressReport != nil {
-		sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: sim.Options.Iterations, CompletedIterations: sim.Options.Iterations, Dps: result.RaidMetrics.Dps.Avg, FinalRaidResult: result})
+		sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: sim.Options.Iterations, CompletedIterations: numIterations, Dps: result.RaidMetrics.Dps.Avg, FinalRaidResult: result})
 	}
 
-	if d := sim.Options.Iterations; d > 3000 {
+	if d := numIterations; d > 3000 {
 		log.Printf("running %d iterations took %s", d, time.Since(t0))
 	}
 
 	return result
 }
 
+// Writes the debug logs of all following iterations into logsBuffer.
+func (sim *Simulation) logTo(logsBuffer *strings.Builder) {
+	sim.Log = func(message string, vals ...interface{}) {
+		logsBuffer.WriteString(fmt.Sprintf("[%0.2f] "+message+"\n", append([]interface{}{sim.CurrentTime.Seconds()}, vals...)...))
+	}
+}
+
+// runIterations runs iterations [start, end) and returns their combined duration
+// and how many were run. completedIterations is shared between all Simulations
+// working on the same request, and is used for progress reports.
+//
+// Stops early if ctx is cancelled.
+func (sim *Simulation) runIterations(ctx context.Context, start int32, end int32, completedIterations *int32) (time.Duration, int32) {
+	var totalDuration time.Duration
+	var numIterations int32
+	var st time.Time
+	for i := start; i < end && ctx.Err() == nil; i++ {
+		// fmt.Printf("Iteration: %d\n", i)
+		if sim.ProgressReport != nil && time.Since(st) > time.Millisecond*100 {
+			sim.reportProgress(atomic.LoadInt32(completedIterations))
+			st = time.Now()
+		}
+
+		// Before each iteration, reset state to seed+iterations
+		if i > 0 {
+			sim.reseedRands(int64(i))
+		}
+		sim.currentIteration = i
+
+		sim.runOnce()
+		totalDuration += sim.iterationDuration()
+		numIterations++
+		atomic.AddInt32(completedIterations, 1)
+	}
+	return totalDuration, numIterations
+}
+
+func (sim *Simulation) reportProgress(completedIterations int32) {
+	metrics := sim.Raid.GetMetrics()
+	sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: sim.Options.Iterations, CompletedIterations: completedIterations, Dps: metrics.Dps.Avg, Hps: metrics.Hps.Avg})
+	runtime.Gosched() // ensure that reporting threads are given time to report, mostly only important in wasm (only 1 thread)
+}
+
+// Duration of the iteration which just finished.
+func (sim *Simulation) iterationDuration() time.Duration {
+	if sim.Encounter.EndFightAtHealth != 0 {
+		return sim.CurrentTime
+	}
+	return sim.Duration
+}
+
 // RunOnce is the main event loop. It will run the simulation for number of seconds.
 func (sim *Simulation) runOnce() {
 	sim.reset()
@@ -374,8 +482,8 @@ func (sim *Simulation) reset() {
 		sim.Duration += time.Duration(sim.RandomFloat("sim duration")*float64(variation)) - sim.DurationVariation
 	}
 
-	sim.pendingActions = sim.pendingActions[:0]
-	sim.pendingActions = append(sim.pendingActions, sentinelPendingAction)
+	sim.pendingActions.reset()
+	sim.actionPool.reset()
 
 	sim.executePhase = 0
 	sim.nextExecutePhase()
@@ -390,6 +498,8 @@ func (sim *Simulation) reset() {
 	}
 
 	sim.CurrentTime = 0
+	sim.steps = 0
+	sim.inputs = sim.inputs[:0]
 
 	sim.trackers = sim.trackers[:0]
 	sim.minTrackerTime = NeverExpires
@@ -403,22 +513,37 @@ func (sim *Simulation) reset() {
 	sim.Environment.reset(sim)
 
 	sim.initManaTickAction()
+
+	if sim.timeseries != nil {
+		sim.timeseries.reset(sim)
+	}
 }
 
 func (sim *Simulation) PrePull() {
+	if sim.prepullPendingActions == nil {
+		sim.initPrepullPendingActions()
+	}
+
 	if len(sim.prepullActions) > 0 {
 		sim.CurrentTime = sim.prepullActions[0].DoAt
+	}
+	for _, pa := range sim.prepullPendingActions {
+		pa.cancelled = false
+		sim.AddPendingAction(pa)
+	}
+}
 
-		for i, ppa := range sim.prepullActions {
-			sim.AddPendingAction(&PendingAction{
-				NextActionAt: ppa.DoAt,
-				Priority:     ActionPriorityPrePull + ActionPriority(len(sim.prepullActions)-i),
-				OnAction:     ppa.Action,
-			})
-		}
+// The actions used by PrePull(), which are the same for every iteration.
+func (sim *Simulation) initPrepullPendingActions() {
+	for i, ppa := range sim.prepullActions {
+		sim.prepullPendingActions = append(sim.prepullPendingActions, &PendingAction{
+			NextActionAt: ppa.DoAt,
+			Priority:     ActionPriorityPrePull + ActionPriority(len(sim.prepullActions)-i),
+			OnAction:     ppa.Action,
+		})
 	}
 
-	sim.AddPendingAction(&PendingAction{
+	sim.prepullPendingActions = append(sim.prepullPendingActions, &PendingAction{
 		NextActionAt: 0,
 		Priority:     ActionPriorityPrePull,
 		OnAction: func(sim *Simulation) {
@@ -438,11 +563,11 @@ func (sim *Simulation) Cleanup() {
 	// intuitive.
 	sim.CurrentTime = sim.Duration
 
-	for _, pa := range sim.pendingActions {
+	sim.pendingActions.forEachLatestFirst(func(pa *PendingAction) {
 		if pa.CleanUp != nil {
 			pa.CleanUp(sim)
 		}
-	}
+	})
 
 	sim.Raid.doneIteration(sim)
 	sim.Encounter.doneIteration(sim)
@@ -453,6 +578,13 @@ func (sim *Simulation) Cleanup() {
 	for _, target := range sim.Encounter.TargetUnits {
 		target.Metrics.doneIteration(target, sim)
 	}
+
+	if sim.Options.SaveIterationResults {
+		sim.recordIterationResults()
+	}
+	if sim.timeseries != nil {
+		sim.timeseries.doneIteration(sim)
+	}
 }
 
 func (sim *Simulation) runPendingActions() {
@@ -464,8 +596,12 @@ func (sim *Simulation) runPendingActions() {
 }
 
 func (sim *Simulation) Step() bool {
-	last := len(sim.pendingActions) - 1
-	pa := sim.pendingActions[last]
+	sim.steps++
+
+	pa := sim.pendingActions.peek()
+	if pa == nil {
+		pa = sentinelPendingAction
+	}
 
 	if pa.NextActionAt >= sim.minWeaponAttackTime && sim.minWeaponAttackTime <= sim.minTaskTime {
 		if sim.minWeaponAttackTime > sim.endOfCombatDuration || sim.Encounter.DamageTaken > sim.endOfCombatDamage {
@@ -483,7 +619,9 @@ func (sim *Simulation) Step() bool {
 		return false
 	}
 
-	sim.pendingActions = sim.pendingActions[:last]
+	if pa != sentinelPendingAction {
+		sim.pendingActions.pop()
+	}
 	if pa.cancelled {
 		return false
 	}
@@ -580,24 +718,7 @@ func (sim *Simulation) AddPendingAction(pa *PendingAction) {
 	//	panic(fmt.Sprintf("Cant add action in the past: %s", pa.NextActionAt))
 	//}
 	pa.consumed = false
-	for index, v := range sim.pendingActions[1:] {
-		if v.NextActionAt < pa.NextActionAt || (v.NextActionAt == pa.NextActionAt && v.Priority >= pa.Priority) {
-			//if sim.Log != nil {
-			//	sim.Log("Adding action at index %d for time %s", index - len(sim.pendingActions), pa.NextActionAt)
-			//	for i := index; i < len(sim.pendingActions); i++ {
-			//		sim.Log("Upcoming action at %s", sim.pendingActions[i].NextActionAt)
-			//	}
-			//}
-			sim.pendingActions = append(sim.pendingActions, pa)
-			copy(sim.pendingActions[index+2:], sim.pendingActions[index+1:])
-			sim.pendingActions[index+1] = pa
-			return
-		}
-	}
-	//if sim.Log != nil {
-	//	sim.Log("Adding action at end for time %s", pa.NextActionAt)
-	//}
-	sim.pendingActions = append(sim.pendingActions, pa)
+	sim.pendingActions.push(pa)
 }
 
 func (sim *Simulation) RegisterExecutePhaseCallback(callback func(sim *Simulation, isExecute int32)) {
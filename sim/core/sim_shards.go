@@ -0,0 +1,205 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Number of iterations in each shard of a sim.
+//
+// Shards have a fixed size so that aggregated results only depend on the seed and
+// iteration count, and not on how many workers the shards were spread across.
+// Sims with at most this many iterations are run exactly as before, on one Simulation.
+//
+// Must be a multiple of convergenceCheckInterval.
+const iterationsPerShard = 1000
+
+// Number of goroutines used to run the shards of a single sim.
+var numShardWorkers = runtime.NumCPU()
+
+// The wasm build runs on a single thread, where shards would only add the cost
+// of building their Simulations.
+var shardingEnabled = runtime.GOOS != "js"
+
+type noShardsKey struct{}
+
+// withoutShards returns a context for sims which are already run in parallel
+// with other sims, like those of bulk sims and stat weights. Sharding those as
+// well would multiply the number of live Simulations by the number of workers,
+// so their iterations run serially instead.
+func withoutShards(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noShardsKey{}, true)
+}
+
+type shardResult struct {
+	index         int32
+	sim           *Simulation // Nil if the shard was skipped.
+	duration      time.Duration
+	numIterations int32
+	err           string
+}
+
+// Returns the number of shards to split this sim's iterations into, or 1 if the
+// iterations need to run serially on this Simulation.
+func (sim *Simulation) numShards(ctx context.Context) int32 {
+	// Debug logs, interactive sessions and health fights which are still estimating
+	// their duration all rely on state carried over between iterations. So do test
+	// RNGs, which are created lazily for each label.
+	if sim.request == nil || sim.Options.Debug || sim.Options.Interactive || sim.isTest || sim.Encounter.DurationIsEstimate {
+		return 1
+	}
+	if !shardingEnabled || ctx.Value(noShardsKey{}) != nil {
+		return 1
+	}
+	return (sim.Options.Iterations + iterationsPerShard - 1) / iterationsPerShard
+}
+
+// runShards runs the remaining iterations of the first shard on this Simulation,
+// and every other shard on a fresh Simulation built from the same request, spread
+// across numWorkers goroutines (including the calling one). Shard metrics are
+// merged back into this Simulation in shard order.
+//
+// Returns the combined duration and number of all iterations merged, and whether
+// the sim converged. If ctx is cancelled, the shards which haven't started yet
+// are skipped. Once the sim has converged, all remaining shards are discarded.
+func (sim *Simulation) runShards(ctx context.Context, numShards int32, numWorkers int, completedIterations *int32) (time.Duration, int32, bool) {
+	numWorkers = max(1, min(numWorkers, int(numShards)))
+
+	// Stops the other shards once the sim has converged.
+	shardCtx, stopShards := context.WithCancel(ctx)
+	defer stopShards()
+
+	shardIndices := make(chan int32, numShards)
+	for i := int32(1); i < numShards; i++ {
+		shardIndices <- i
+	}
+	close(shardIndices)
+
+	// Captured up front, as this Simulation keeps changing its own state while running.
+	baseDuration := sim.BaseDuration
+
+	results := make(chan *shardResult, numShards)
+	for i := 1; i < numWorkers; i++ {
+		go func() {
+			for index := range shardIndices {
+				results <- sim.runShard(shardCtx, index, baseDuration, completedIterations)
+			}
+		}()
+	}
+
+	// The first iteration has already been run by the caller.
+	totalDuration, totalIterations, converged := sim.runIterationsUntilConverged(ctx, 1, iterationsPerShard, completedIterations)
+
+	// Merge in the other shards as they finish, helping out with the remaining
+	// ones in the meantime.
+	remainingIndices := shardIndices
+	pending := make(map[int32]*shardResult, numShards)
+	nextIndex := int32(1)
+	var st time.Time
+	for !converged && nextIndex < numShards {
+		select {
+		case result := <-results:
+			pending[result.index] = result
+		case index, ok := <-remainingIndices:
+			if !ok {
+				remainingIndices = nil
+				continue
+			}
+			pending[index] = sim.runShard(shardCtx, index, baseDuration, completedIterations)
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		// Merge in shard order, so results don't depend on which shard finished first.
+		for !converged {
+			result, ok := pending[nextIndex]
+			if !ok {
+				break
+			}
+			if result.err != "" {
+				panic(result.err)
+			}
+			if result.sim != nil {
+				sim.mergeMetrics(result.sim)
+				totalDuration += result.duration
+				totalIterations += result.numIterations
+				converged = sim.hasConverged()
+			}
+			delete(pending, nextIndex)
+			nextIndex++
+		}
+
+		if sim.ProgressReport != nil && time.Since(st) > time.Millisecond*100 {
+			sim.reportProgress(atomic.LoadInt32(completedIterations))
+			st = time.Now()
+		}
+	}
+
+	return totalDuration, totalIterations, converged
+}
+
+// runShard runs a single shard on a fresh Simulation, unless ctx is already
+// cancelled. Panics are captured so they can be surfaced by the goroutine which
+// owns the sim.
+func (sim *Simulation) runShard(ctx context.Context, index int32, baseDuration time.Duration, completedIterations *int32) (result *shardResult) {
+	if ctx.Err() != nil {
+		return &shardResult{index: index}
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			result = &shardResult{
+				index: index,
+				err:   fmt.Sprintf("%v\nStack Trace:\n%s", err, string(debug.Stack())),
+			}
+		}
+	}()
+
+	start := index * iterationsPerShard
+	end := min(start+iterationsPerShard, sim.Options.Iterations)
+	shard := sim.newShardSim(baseDuration)
+	shard.numSavedIterations = end - start
+
+	duration, numIterations := shard.runIterations(ctx, start, end, completedIterations)
+	return &shardResult{
+		index:         index,
+		sim:           shard,
+		duration:      duration,
+		numIterations: numIterations,
+	}
+}
+
+// newShardSim builds a new Simulation from this Simulation's request, in the same
+// state this Simulation was in before running any iterations.
+func (sim *Simulation) newShardSim(baseDuration time.Duration) *Simulation {
+	shard := NewSim(googleProto.Clone(sim.request).(*proto.RaidSimRequest))
+	shard.replayPresims(shard.request, sim.presimResults)
+
+	// Health fights take their duration from the presims.
+	shard.BaseDuration = baseDuration
+	shard.Encounter.DurationIsEstimate = false
+	return shard
+}
+
+// mergeMetrics folds the metrics collected by shard, which must have been built
+// from the same request and run over later iterations, into this Simulation.
+func (sim *Simulation) mergeMetrics(shard *Simulation) {
+	sim.Raid.mergeMetrics(shard.Raid)
+
+	for i, unit := range sim.AllUnits {
+		shardUnit := shard.AllUnits[i]
+		unit.Metrics.merge(&shardUnit.Metrics)
+		unit.auraTracker.mergeMetrics(&shardUnit.auraTracker)
+	}
+
+	sim.iterationResults = append(sim.iterationResults, shard.iterationResults...)
+	if sim.timeseries != nil {
+		sim.timeseries.merge(shard.timeseries)
+	}
+}
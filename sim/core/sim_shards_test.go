@@ -0,0 +1,39 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestShardedResultsIgnoreWorkerCount(t *testing.T) {
+	rsr := newMeleeTargetDummyRequest(iterationsPerShard*3+17, 101)
+
+	defer func(oldWorkers int) { numShardWorkers = oldWorkers }(numShardWorkers)
+
+	var results [][]byte
+	for _, workers := range []int{1, 2, 5} {
+		numShardWorkers = workers
+		result := RunRaidSim(googleProto.Clone(rsr).(*proto.RaidSimRequest))
+		if result.ErrorResult != "" {
+			t.Fatalf("Sim failed with %d workers: %s", workers, result.ErrorResult)
+		}
+		if result.EncounterMetrics.Targets[0].Dps.Avg == 0 {
+			t.Fatalf("Expected the target to deal damage")
+		}
+
+		data, err := googleProto.MarshalOptions{Deterministic: true}.Marshal(result)
+		if err != nil {
+			t.Fatalf("Failed to marshal results: %s", err)
+		}
+		results = append(results, data)
+	}
+
+	for i, result := range results[1:] {
+		if !bytes.Equal(results[0], result) {
+			t.Fatalf("Results with %d workers differ from results with 1 worker", []int{2, 5}[i])
+		}
+	}
+}
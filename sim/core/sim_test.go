@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+// A boss meleeing a target dummy, which is enough to exercise hit tables,
+// duration variation and the dummy's health and damage taken metrics.
+func newMeleeTargetDummyRequest(iterations int32, seed int64) *proto.RaidSimRequest {
+	return &proto.RaidSimRequest{
+		Raid: &proto.Raid{
+			Parties:       []*proto.Party{{}},
+			Tanks:         []*proto.UnitReference{{Type: proto.UnitReference_Player, Index: 0}},
+			TargetDummies: 1,
+		},
+		Encounter: &proto.Encounter{
+			Duration:          60,
+			DurationVariation: 10,
+			Targets: []*proto.Target{
+				{
+					Level:         63,
+					Stats:         stats.Stats{}.ToFloatArray(),
+					MinBaseDamage: 100,
+					SwingSpeed:    2,
+					TankIndex:     0,
+				},
+			},
+		},
+		SimOptions: &proto.SimOptions{
+			Iterations: iterations,
+			RandomSeed: seed,
+		},
+	}
+}
+
+func TestCancelledSimReturnsPartialResults(t *testing.T) {
+	for _, iterations := range []int32{iterationsPerShard / 2, iterationsPerShard * 4} {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result := RunSim(ctx, newMeleeTargetDummyRequest(iterations, 101), nil)
+		if result.ErrorResult != "" {
+			t.Fatalf("Sim failed: %s", result.ErrorResult)
+		}
+		if !result.Cancelled {
+			t.Fatalf("Expected the sim to be flagged as cancelled")
+		}
+		if result.CompletedIterations != 1 {
+			t.Fatalf("Expected only the first iteration to run, got %d", result.CompletedIterations)
+		}
+		if result.EncounterMetrics.Targets[0].Dps.Avg == 0 {
+			t.Fatalf("Expected metrics for the first iteration")
+		}
+	}
+
+	result := RunSim(context.Background(), newMeleeTargetDummyRequest(10, 101), nil)
+	if result.Cancelled || result.CompletedIterations != 10 {
+		t.Fatalf("Expected all 10 iterations to run, got %d (cancelled: %t)", result.CompletedIterations, result.Cancelled)
+	}
+}
+
+func TestDualWieldingTarget(t *testing.T) {
+	request := newMeleeTargetDummyRequest(5, 101)
+	request.Encounter.Targets[0].DualWield = true
+	result := RunSim(context.Background(), request, nil)
+	if result.ErrorResult != "" {
+		t.Fatalf("Sim failed: %s", result.ErrorResult)
+	}
+	if result.EncounterMetrics.Targets[0].Dps.Avg == 0 {
+		t.Fatalf("Expected the dual wielding target to deal damage")
+	}
+}
+
+func TestIterationResults(t *testing.T) {
+	for _, iterations := range []int32{20, iterationsPerShard * 2} {
+		request := newMeleeTargetDummyRequest(iterations, 101)
+		request.SimOptions.SaveIterationResults = true
+		result := RunSim(context.Background(), request, nil)
+
+		numUnits := len(result.RaidMetrics.Parties[0].Players) + len(result.EncounterMetrics.Targets)
+		if len(result.IterationResults) != int(iterations)*numUnits {
+			t.Fatalf("Expected %d iteration results, got %d", int(iterations)*numUnits, len(result.IterationResults))
+		}
+
+		totalDps := 0.0
+		for i, iterationResult := range result.IterationResults {
+			if iterationResult.Iteration != int32(i/numUnits) {
+				t.Fatalf("Expected iteration results in order, got iteration %d at %d", iterationResult.Iteration, i)
+			}
+			if iterationResult.Unit.Type == proto.UnitReference_Target {
+				totalDps += iterationResult.Dps
+			}
+		}
+		if avgDps := result.EncounterMetrics.Targets[0].Dps.Avg; math.Abs(totalDps/float64(iterations)-avgDps) > 1e-6 {
+			t.Fatalf("Expected iteration results to average to %0.3f DPS, got %0.3f", avgDps, totalDps/float64(iterations))
+		}
+	}
+}
+
+func TestTimeseries(t *testing.T) {
+	for _, iterations := range []int32{20, iterationsPerShard * 2} {
+		request := newMeleeTargetDummyRequest(iterations, 101)
+		request.Encounter.DurationVariation = 0
+		request.Encounter.ExecuteProportion_20 = 0.2
+		request.SimOptions.Timeseries = &proto.TimeseriesOptions{BinWidth: 5}
+		result := RunSim(context.Background(), request, nil)
+
+		var targetTimeseries *proto.UnitTimeseries
+		for _, unitTimeseries := range result.Timeseries.Units {
+			if unitTimeseries.Unit.Type == proto.UnitReference_Target {
+				targetTimeseries = unitTimeseries
+			}
+		}
+		if len(targetTimeseries.Dps) != 12 {
+			t.Fatalf("Expected 12 bins for a 60s fight, got %d", len(targetTimeseries.Dps))
+		}
+
+		// Every iteration lasts exactly 60s, so the bins average out to the fight's DPS.
+		totalDps := 0.0
+		for _, dps := range targetTimeseries.Dps {
+			totalDps += dps
+		}
+		if avgDps := result.EncounterMetrics.Targets[0].Dps.Avg; math.Abs(totalDps/12-avgDps) > 1e-6 {
+			t.Fatalf("Expected the DPS timeseries to average to %0.3f, got %0.3f", avgDps, totalDps/12)
+		}
+		if len(targetTimeseries.Actions) == 0 {
+			t.Fatalf("Expected a DPS timeseries for the boss's melee")
+		}
+		if phase := result.Timeseries.ExecutePhase_20; phase[0] != 0 || phase[11] != 1 {
+			t.Fatalf("Expected the execute phase to start during the fight, got %v", phase)
+		}
+	}
+}
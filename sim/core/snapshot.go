@@ -0,0 +1,209 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// A Snapshot is a point in an iteration which the sim can be restored to, e.g.
+// to branch the fight and compare different decisions from there.
+//
+// Copying every unit, aura, cooldown, resource and pending action would need
+// clone support from every class and item implementation. Instead a Snapshot
+// records how the iteration got to that point: the seed it started from, the
+// number of event loop steps taken and the inputs applied between them.
+// Iterations are deterministic given those, so restoring replays the iteration
+// up to the same point, which recreates the entire sim state including the RNG.
+// This makes every restore cost as much as running the iteration up to the
+// snapshot.
+//
+// Changes made outside of Input aren't replayed, so a Snapshot also keeps a
+// fingerprint of the sim state, and restoring panics if the replayed state
+// doesn't match it.
+type Snapshot struct {
+	CurrentTime time.Duration
+
+	seed        int64
+	steps       int64
+	inputs      []simInput
+	fingerprint simFingerprint
+}
+
+// Parts of the sim state which are compared after replaying a snapshot.
+type simFingerprint struct {
+	randState      uint64
+	pendingActions int
+	damageTaken    float64
+	units          []unitFingerprint
+}
+
+type unitFingerprint struct {
+	health      float64
+	mana        float64
+	rage        float64
+	energy      float64
+	damage      float64
+	activeAuras int
+}
+
+func (sim *Simulation) fingerprint() simFingerprint {
+	fingerprint := simFingerprint{
+		pendingActions: sim.pendingActions.len(),
+		damageTaken:    sim.Encounter.DamageTaken,
+		units:          make([]unitFingerprint, len(sim.Environment.AllUnits)),
+	}
+	// Test RNGs are split by label, so only the damage and resources they lead to are compared.
+	if rand, ok := sim.rand.(*SplitMix64); ok {
+		fingerprint.randState = rand.state
+	}
+	for i, unit := range sim.Environment.AllUnits {
+		fingerprint.units[i] = unitFingerprint{
+			health:      unit.currentHealth,
+			mana:        unit.currentMana,
+			rage:        unit.currentRage,
+			energy:      unit.currentEnergy,
+			damage:      unit.Metrics.dps.Total,
+			activeAuras: len(unit.activeAuras),
+		}
+	}
+	return fingerprint
+}
+
+// Returns a description of the first difference to other, or "" if there is none.
+func (fingerprint simFingerprint) diff(other simFingerprint) string {
+	switch {
+	case fingerprint.randState != other.randState:
+		return "RNG state"
+	case fingerprint.pendingActions != other.pendingActions:
+		return fmt.Sprintf("%d pending actions instead of %d", fingerprint.pendingActions, other.pendingActions)
+	case fingerprint.damageTaken != other.damageTaken:
+		return fmt.Sprintf("%0.3f damage taken by the encounter instead of %0.3f", fingerprint.damageTaken, other.damageTaken)
+	}
+	for i, unit := range fingerprint.units {
+		if unit != other.units[i] {
+			return fmt.Sprintf("state of unit %d: %+v instead of %+v", i, unit, other.units[i])
+		}
+	}
+	return ""
+}
+
+// An external decision applied to the sim between two event loop steps.
+type simInput struct {
+	step  int64
+	apply func(sim *Simulation)
+}
+
+// Input applies an external decision, e.g. a spell cast chosen by an
+// interactive client, and records it so that snapshots taken later in the
+// iteration replay it. Anything which changes the sim from outside of the event
+// loop should go through Input, otherwise restored snapshots will diverge.
+func (sim *Simulation) Input(apply func(sim *Simulation)) {
+	sim.inputs = append(sim.inputs, simInput{step: sim.steps, apply: apply})
+	apply(sim)
+}
+
+// ReseedContinuation reseeds the RNG for the rest of the iteration, so that
+// restoring the same snapshot repeatedly explores different continuations.
+// The reseed is recorded as an input.
+func (sim *Simulation) ReseedContinuation(seed int64) {
+	sim.Input(func(sim *Simulation) {
+		iterationSeed := sim.iterationSeed
+		sim.seedRands(seed)
+		sim.iterationSeed = iterationSeed
+	})
+}
+
+// Snapshot returns the current point of the iteration. The iteration must have
+// been started from a freshly seeded RNG, as done by Run and by Reseed() followed
+// by Reset() and PrePull().
+func (sim *Simulation) Snapshot() *Snapshot {
+	return &Snapshot{
+		CurrentTime: sim.CurrentTime,
+
+		seed:        sim.iterationSeed,
+		steps:       sim.steps,
+		inputs:      slices.Clone(sim.inputs),
+		fingerprint: sim.fingerprint(),
+	}
+}
+
+// Restore puts the sim back into the state it was in when snapshot was taken.
+// Metrics of finished iterations are unaffected. Logging is suppressed while
+// the iteration is replayed, so logs only contain what happens afterwards.
+//
+// Panics if the replayed state differs from the snapshot's, which means the
+// iteration was changed outside of Input before the snapshot was taken.
+func (sim *Simulation) Restore(snapshot *Snapshot) {
+	log := sim.Log
+	sim.Log = nil
+	defer func() {
+		sim.Log = log
+	}()
+
+	sim.restartIteration(snapshot.seed)
+
+	inputs := snapshot.inputs
+	for {
+		for len(inputs) > 0 && inputs[0].step == sim.steps {
+			inputs[0].apply(sim)
+			inputs = inputs[1:]
+		}
+		if sim.steps == snapshot.steps {
+			break
+		}
+		if sim.Step() && sim.steps < snapshot.steps {
+			panic("Iteration ended before reaching the snapshot")
+		}
+	}
+
+	if diff := sim.fingerprint().diff(snapshot.fingerprint); diff != "" {
+		panic(fmt.Sprintf("Restored snapshot at %s diverged from the original iteration, with %s. Changes to the sim must be made through Simulation.Input to be replayed.", snapshot.CurrentTime, diff))
+	}
+
+	sim.inputs = append(sim.inputs[:0], snapshot.inputs...)
+}
+
+// restartIteration abandons the current iteration without recording its
+// metrics, and starts a new one from seed.
+func (sim *Simulation) restartIteration(seed int64) {
+	for _, unit := range sim.Environment.AllUnits {
+		unit.auraTracker.endIteration(sim)
+	}
+
+	// Keeps health fights from using the time of the abandoned iteration as
+	// their duration estimate, see reset().
+	sim.CurrentTime = 0
+	sim.NeedsInput = false
+
+	sim.seedRands(seed)
+	sim.reset()
+	sim.PrePull()
+}
+
+// EvaluateBranches compares the outcomes of several decisions made at the
+// point of snapshot. For every branch, the snapshot is restored, the branch is
+// applied as an input and the rest of the iteration is run, once for each of
+// continuations seeds. Every branch uses the same seeds, so differences between
+// them come from the decision rather than from the RNG. Returns the average
+// damage done to the encounter targets by the end of the iteration, for each
+// branch.
+//
+// The sim is left at the end of the last continuation, without calling
+// Cleanup(), so results of finished iterations aren't affected.
+func (sim *Simulation) EvaluateBranches(snapshot *Snapshot, branches []func(sim *Simulation), continuations int) []float64 {
+	damage := make([]float64, len(branches))
+	for i, branch := range branches {
+		for c := 0; c < continuations; c++ {
+			sim.Restore(snapshot)
+			sim.ReseedContinuation(snapshot.seed + int64(c) + 1)
+			if branch != nil {
+				sim.Input(branch)
+			}
+			sim.runPendingActions()
+			damage[i] += sim.Encounter.DamageTaken
+		}
+		damage[i] /= float64(max(continuations, 1))
+	}
+	return damage
+}
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func stepUntil(sim *Simulation, until time.Duration) {
+	for sim.CurrentTime < until && !sim.Step() {
+	}
+}
+
+// The fake sim has no rotation, so inputs schedule the casts.
+func castAt(fa *FakeAgent, doAt time.Duration) func(sim *Simulation) {
+	return func(sim *Simulation) {
+		StartDelayedAction(sim, DelayedActionOptions{
+			DoAt: doAt,
+			OnAction: func(sim *Simulation) {
+				fa.Spell.Cast(sim, fa.CurrentTarget)
+			},
+		})
+	}
+}
+
+func TestSnapshotRestoresIteration(t *testing.T) {
+	sim := SetupFakeSim()
+	sim.PrePull()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+
+	sim.Input(castAt(fa, time.Second*5))
+	stepUntil(sim, time.Second*12)
+
+	snapshot := sim.Snapshot()
+	damage := sim.Encounter.DamageTaken
+	dotRemaining := fa.Dot.RemainingDuration(sim)
+	randState := sim.rand.(*SplitMix64).state
+	if damage == 0 {
+		t.Fatalf("Expected the dot to have ticked before the snapshot")
+	}
+
+	sim.runPendingActions()
+	finalDamage := sim.Encounter.DamageTaken
+
+	sim.Restore(snapshot)
+	if sim.CurrentTime != snapshot.CurrentTime {
+		t.Fatalf("Expected restored time %s, got %s", snapshot.CurrentTime, sim.CurrentTime)
+	}
+	if sim.Encounter.DamageTaken != damage {
+		t.Fatalf("Expected restored damage %0.3f, got %0.3f", damage, sim.Encounter.DamageTaken)
+	}
+	if remaining := fa.Dot.RemainingDuration(sim); remaining != dotRemaining {
+		t.Fatalf("Expected restored dot to have %s remaining, got %s", dotRemaining, remaining)
+	}
+	if state := sim.rand.(*SplitMix64).state; state != randState {
+		t.Fatalf("Expected restored RNG state %d, got %d", randState, state)
+	}
+
+	sim.runPendingActions()
+	if sim.Encounter.DamageTaken != finalDamage {
+		t.Fatalf("Expected restored iteration to finish with %0.3f damage, got %0.3f", finalDamage, sim.Encounter.DamageTaken)
+	}
+}
+
+func TestRestoreDetectsDivergence(t *testing.T) {
+	sim := SetupFakeSim()
+	sim.PrePull()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+
+	// Not recorded as an input, so it isn't replayed.
+	castAt(fa, time.Second*5)(sim)
+	stepUntil(sim, time.Second*12)
+	snapshot := sim.Snapshot()
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Fatalf("Expected restoring to detect that the iteration diverged")
+		}
+	}()
+	sim.Restore(snapshot)
+}
+
+func TestEvaluateBranches(t *testing.T) {
+	sim := SetupFakeSim()
+	sim.PrePull()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+
+	snapshot := sim.Snapshot()
+
+	branches := []func(sim *Simulation){
+		nil,
+		castAt(fa, time.Second*5),
+	}
+	damage := sim.EvaluateBranches(snapshot, branches, 10)
+	if damage[0] != 0 || damage[1] == 0 {
+		t.Fatalf("Expected only the casting branch to deal damage, got %v", damage)
+	}
+
+	if again := sim.EvaluateBranches(snapshot, branches, 10); again[1] != damage[1] {
+		t.Fatalf("Expected branches to be deterministic, got %0.3f and %0.3f", damage[1], again[1])
+	}
+}
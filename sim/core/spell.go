@@ -144,6 +144,11 @@ type Spell struct {
 
 	resultCache SpellResult
 
+	// Results used while resultCache is in use. All of them are reclaimed at the
+	// start of each iteration, including those which were never disposed.
+	results     []*SpellResult
+	freeResults []*SpellResult
+
 	dots   DotArray
 	aoeDot *Dot
 
@@ -410,6 +415,12 @@ func (spell *Spell) reset(_ *Simulation) {
 		}
 	}
 	spell.casts = 0
+
+	spell.resultCache.inUse = false
+	spell.freeResults = append(spell.freeResults[:0], spell.results...)
+	for _, result := range spell.results {
+		result.inUse = false
+	}
 }
 
 func (spell *Spell) SetMetricsSplit(splitIdx int32) {
@@ -470,6 +481,11 @@ func (spell *Spell) CanCast(sim *Simulation, target *Unit) bool {
 		return false
 	}
 
+	// Attacks can't be used on despawned or untargetable enemies.
+	if target != nil && target.Type == EnemyUnit && !target.IsEnabled() && spell.DefenseType != DefenseTypeNone {
+		return false
+	}
+
 	// While moving only instant casts are possible
 	if spell.DefaultCast.CastTime > 0 && spell.Unit.Moving {
 		//if sim.Log != nil {
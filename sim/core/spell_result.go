@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 
+	"github.com/wowsims/sod/sim/core/proto"
 	"github.com/wowsims/sod/sim/core/stats"
 )
 
@@ -18,13 +19,14 @@ type SpellResult struct {
 	ResistanceMultiplier float64 // Partial Resists / Armor multiplier
 	PreOutcomeDamage     float64 // Damage done by this cast before Outcome is applied
 
-	inUse bool
+	inUse  bool
+	pooled bool // Whether this is one of the spell's pooled results.
 }
 
 func (spell *Spell) NewResult(target *Unit) *SpellResult {
 	result := &spell.resultCache
 	if result.inUse {
-		result = &SpellResult{}
+		result = spell.newPooledResult()
 	}
 
 	result.Target = target
@@ -36,9 +38,25 @@ func (spell *Spell) NewResult(target *Unit) *SpellResult {
 	return result
 }
 func (spell *Spell) DisposeResult(result *SpellResult) {
+	if result.pooled && result.inUse {
+		spell.freeResults = append(spell.freeResults, result)
+	}
 	result.inUse = false
 }
 
+func (spell *Spell) newPooledResult() *SpellResult {
+	if n := len(spell.freeResults); n > 0 {
+		result := spell.freeResults[n-1]
+		spell.freeResults = spell.freeResults[:n-1]
+		*result = SpellResult{pooled: true}
+		return result
+	}
+
+	result := &SpellResult{pooled: true}
+	spell.results = append(spell.results, result)
+	return result
+}
+
 func (result *SpellResult) Landed() bool {
 	return result.Outcome.Matches(OutcomeLanded)
 }
@@ -335,6 +353,8 @@ func (spell *Spell) CalcAndDealOutcome(sim *Simulation, target *Unit, outcomeApp
 
 // Applies the fully computed spell result to the sim.
 func (spell *Spell) dealDamageInternal(sim *Simulation, isPeriodic bool, result *SpellResult) {
+	result.Target.absorbDamage(sim, result)
+
 	if sim.CurrentTime >= 0 {
 		spell.SpellMetrics[result.Target.UnitIndex].TotalDamage += result.Damage
 		spell.SpellMetrics[result.Target.UnitIndex].TotalThreat += result.Threat
@@ -344,14 +364,18 @@ func (spell *Spell) dealDamageInternal(sim *Simulation, isPeriodic bool, result
 	// Don't include damage done by EnemyUnits to Players
 	if result.Target.Type == EnemyUnit {
 		sim.Encounter.DamageTaken += result.Damage
+		sim.Encounter.Targets[result.Target.Index].damageTaken += result.Damage
+		if sim.Encounter.phases != nil {
+			sim.Encounter.phases.onDamageTaken(sim, result.Target)
+		}
+	}
+
+	if sim.timeseries != nil && spell.Unit.IsOpponent(result.Target) {
+		sim.timeseries.addDamage(sim, spell, result.Damage)
 	}
 
 	if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
-		if isPeriodic {
-			spell.Unit.Log(sim, "%s %s tick %s. (Threat: %0.3f)", result.Target.LogLabel(), spell.ActionID, result.DamageString(), result.Threat)
-		} else {
-			spell.Unit.Log(sim, "%s %s %s. (Threat: %0.3f)", result.Target.LogLabel(), spell.ActionID, result.DamageString(), result.Threat)
-		}
+		sim.logSpellResult(proto.CombatEvent_TypeDamage, spell, result, isPeriodic)
 	}
 
 	if !spell.Flags.Matches(SpellFlagNoOnDamageDealt) {
@@ -423,6 +447,19 @@ func (spell *Spell) CalcHealing(sim *Simulation, target *Unit, baseHealing float
 	}
 	return spell.calcHealingInternal(sim, target, baseHealing, spell.CasterHealingMultiplier(), outcomeApplier)
 }
+func (spell *Spell) CalcPeriodicHealing(sim *Simulation, target *Unit, baseHealing float64, outcomeApplier OutcomeApplier) *SpellResult {
+	// Use the HoT's coefficient when there is one, otherwise fall back to the spell's own.
+	bonusCoefficient := spell.BonusCoefficient
+	if spell.aoeDot != nil || spell.dots != nil {
+		if hot := spell.DotOrAOEDot(target); hot != nil {
+			bonusCoefficient = hot.BonusCoefficient
+		}
+	}
+	if bonusCoefficient > 0 {
+		baseHealing += bonusCoefficient * spell.HealingPower(target)
+	}
+	return spell.calcHealingInternal(sim, target, baseHealing, spell.CasterHealingMultiplier(), outcomeApplier)
+}
 func (dot *Dot) CalcSnapshotHealing(sim *Simulation, target *Unit, outcomeApplier OutcomeApplier) *SpellResult {
 	return dot.Spell.calcHealingInternal(sim, target, dot.SnapshotBaseDamage, dot.SnapshotAttackerMultiplier, outcomeApplier)
 }
@@ -445,15 +482,13 @@ func (spell *Spell) dealHealingInternal(sim *Simulation, isPeriodic bool, result
 	spell.SpellMetrics[result.Target.UnitIndex].TotalHealing += result.Damage
 	spell.SpellMetrics[result.Target.UnitIndex].TotalThreat += result.Threat
 	if result.Target.HasHealthBar() {
+		missingHealth := result.Target.MaxHealth() - result.Target.CurrentHealth()
+		spell.SpellMetrics[result.Target.UnitIndex].TotalOverhealing += max(result.Damage-missingHealth, 0)
 		result.Target.GainHealth(sim, result.Damage, spell.HealthMetrics(result.Target))
 	}
 
 	if sim.Log != nil {
-		if isPeriodic {
-			spell.Unit.Log(sim, "%s %s tick %s. (Threat: %0.3f)", result.Target.LogLabel(), spell.ActionID, result.HealingString(), result.Threat)
-		} else {
-			spell.Unit.Log(sim, "%s %s %s. (Threat: %0.3f)", result.Target.LogLabel(), spell.ActionID, result.HealingString(), result.Threat)
-		}
+		sim.logSpellResult(proto.CombatEvent_TypeHealing, spell, result, isPeriodic)
 	}
 
 	if isPeriodic {
@@ -479,8 +514,9 @@ func (spell *Spell) CalcAndDealHealing(sim *Simulation, target *Unit, baseHealin
 	return result
 }
 func (spell *Spell) CalcAndDealPeriodicHealing(sim *Simulation, target *Unit, baseHealing float64, outcomeApplier OutcomeApplier) *SpellResult {
-	// This is currently identical to CalcAndDealHealing, but keeping it separate in case they become different in the future.
-	return spell.CalcAndDealHealing(sim, target, baseHealing, outcomeApplier)
+	result := spell.CalcPeriodicHealing(sim, target, baseHealing, outcomeApplier)
+	spell.DealHealing(sim, result)
+	return result
 }
 func (dot *Dot) CalcAndDealPeriodicSnapshotHealing(sim *Simulation, target *Unit, outcomeApplier OutcomeApplier) *SpellResult {
 	result := dot.CalcSnapshotHealing(sim, target, outcomeApplier)
@@ -489,10 +525,27 @@ func (dot *Dot) CalcAndDealPeriodicSnapshotHealing(sim *Simulation, target *Unit
 }
 
 func (spell *Spell) WaitTravelTime(sim *Simulation, callback func(*Simulation)) {
-	StartDelayedAction(sim, DelayedActionOptions{
-		DoAt:     sim.CurrentTime + spell.TravelTime(),
-		OnAction: callback,
+	ScheduleDelayedCallback(sim, sim.CurrentTime+spell.TravelTime(), callback)
+}
+
+// Like WaitTravelTime, but passes result on to callback. Unlike a closure
+// capturing result, callback can be created once instead of for every cast.
+func (spell *Spell) WaitTravelTimeWithResult(sim *Simulation, result *SpellResult, callback func(*Simulation, *Spell, *SpellResult)) {
+	pa := sim.schedulePooledAction(sim.CurrentTime+spell.TravelTime(), func(sim *Simulation, pa *pooledAction) {
+		pa.resultCallback(sim, pa.spell, pa.result)
 	})
+	pa.resultCallback = callback
+	pa.spell = spell
+	pa.result = result
+}
+
+// Shorthand for dealing the damage of result once the spell reaches its target.
+func (spell *Spell) DealDamageAfterTravelTime(sim *Simulation, result *SpellResult) {
+	spell.WaitTravelTimeWithResult(sim, result, dealDamageCallback)
+}
+
+func dealDamageCallback(sim *Simulation, spell *Spell, result *SpellResult) {
+	spell.DealDamage(sim, result)
 }
 
 // Returns the combined attacker modifiers.
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"math"
 	"runtime"
 	"sync"
@@ -78,6 +79,8 @@ type StatWeightsResult struct {
 	Dtps   StatWeightValues
 	Tmi    StatWeightValues
 	PDeath StatWeightValues
+
+	Cancelled bool
 }
 
 func NewStatWeightsResult() *StatWeightsResult {
@@ -99,10 +102,15 @@ func (swr *StatWeightsResult) ToProto() *proto.StatWeightsResult {
 		Dtps:   swr.Dtps.ToProto(),
 		Tmi:    swr.Tmi.ToProto(),
 		PDeath: swr.PDeath.ToProto(),
+
+		Cancelled: swr.Cancelled,
 	}
 }
 
-func CalcStatWeight(swr *proto.StatWeightsRequest, referenceStat stats.Stat, progress chan *proto.ProgressMetrics) *StatWeightsResult {
+// CalcStatWeight runs the sims needed for stat weights. If ctx is cancelled, sims
+// which haven't started yet are skipped, and the weights of the completed
+// iterations are returned, flagged as cancelled.
+func CalcStatWeight(ctx context.Context, swr *proto.StatWeightsRequest, referenceStat stats.Stat, progress chan *proto.ProgressMetrics) *StatWeightsResult {
 	if swr.Player.BonusStats == nil {
 		swr.Player.BonusStats = &proto.UnitStats{}
 	}
@@ -122,6 +130,11 @@ func CalcStatWeight(swr *proto.StatWeightsRequest, referenceStat stats.Stat, pro
 	// Cut in half since we're doing above and below separately.
 	// This number needs to be the same for the baseline sim too, so that RNG lines up perfectly.
 	simOptions.Iterations /= 2
+	// With convergence each sim may stop after a different number of iterations,
+	// in which case weights only use the iterations all of them ran.
+	if simOptions.Convergence != nil {
+		simOptions.Convergence.MinIterations /= 2
+	}
 
 	// Make sure an RNG seed is always set because it gives more consistent results.
 	// When there is no user-supplied seed it needs to be a randomly-selected seed
@@ -143,7 +156,7 @@ func CalcStatWeight(swr *proto.StatWeightsRequest, referenceStat stats.Stat, pro
 		Encounter:  swr.Encounter,
 		SimOptions: simOptions,
 	}
-	baselineResult := RunRaidSim(baseSimRequest)
+	baselineResult := RunSim(ctx, baseSimRequest, nil)
 	if baselineResult.ErrorResult != "" {
 		// TODO: get stack trace out.
 		return &StatWeightsResult{}
@@ -174,12 +187,16 @@ func CalcStatWeight(swr *proto.StatWeightsRequest, referenceStat stats.Stat, pro
 		defer waitGroup.Done()
 		// wait until we have CPU time available.
 		<-tickets
+		if ctx.Err() != nil {
+			tickets <- struct{}{}
+			return
+		}
 
 		simRequest := googleProto.Clone(baseSimRequest).(*proto.RaidSimRequest)
 		stat.AddToStatsProto(simRequest.Raid.Parties[0].Players[0].BonusStats, value)
 
 		reporter := make(chan *proto.ProgressMetrics, 10)
-		go RunSim(simRequest, reporter) // RunRaidSim(simRequest)
+		go RunSim(withoutShards(ctx), simRequest, reporter) // RunRaidSim(simRequest)
 
 		var localIterations int32
 		var errorStr string
@@ -262,11 +279,19 @@ func CalcStatWeight(swr *proto.StatWeightsRequest, referenceStat stats.Stat, pro
 
 	// Compute weight results.
 	result := NewStatWeightsResult()
+	result.Cancelled = baselineResult.Cancelled
 	for i := 0; i < stats.UnitStatsLen; i++ {
 		stat := stats.UnitStatFromIdx(i)
-		if resultsLow[stat] == nil && resultsHigh[stat] == nil {
+		if resultsLow[stat] == nil || resultsHigh[stat] == nil {
+			// Sims are only skipped when cancelled.
+			if statModsLow[stat] != 0 {
+				result.Cancelled = true
+			}
 			continue
 		}
+		if resultsLow[stat].Cancelled || resultsHigh[stat].Cancelled {
+			result.Cancelled = true
+		}
 
 		baselinePlayer := baselineResult.RaidMetrics.Parties[0].Players[0]
 		modPlayerLow := resultsLow[stat].RaidMetrics.Parties[0].Players[0]
@@ -279,15 +304,18 @@ func CalcStatWeight(swr *proto.StatWeightsRequest, referenceStat stats.Stat, pro
 		}
 
 		calcWeightResults := func(baselineMetrics *proto.DistributionMetrics, modLowMetrics *proto.DistributionMetrics, modHighMetrics *proto.DistributionMetrics, weightResults *StatWeightValues) {
+			// Cancelled sims only have values for the iterations they completed.
+			numIterations := min(len(baselineMetrics.AllValues), len(modLowMetrics.AllValues), len(modHighMetrics.AllValues))
+
 			var lo, hi aggregator
 			if resultsLow != nil {
-				for i := 0; i < int(simOptions.Iterations); i++ {
+				for i := 0; i < numIterations; i++ {
 					lo.add(modLowMetrics.AllValues[i] - baselineMetrics.AllValues[i])
 				}
 				lo.scale(1 / statModsLow[stat])
 			}
 			if resultsHigh != nil {
-				for i := 0; i < int(simOptions.Iterations); i++ {
+				for i := 0; i < numIterations; i++ {
 					hi.add(modHighMetrics.AllValues[i] - baselineMetrics.AllValues[i])
 				}
 				hi.scale(1 / statModsHigh[stat])
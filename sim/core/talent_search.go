@@ -0,0 +1,416 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	goproto "google.golang.org/protobuf/proto"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+const (
+	defaultPruningIterations = 100
+	pointsPerTalentRow       = 5
+)
+
+type talentLocation struct {
+	RowIdx int
+	ColIdx int
+}
+
+type talentConfig struct {
+	FieldName      string
+	Location       talentLocation
+	MaxPoints      int
+	PrereqLocation *talentLocation
+}
+
+type talentTreeConfig struct {
+	Name    string
+	Talents []talentConfig
+}
+
+// Returns the talent trees of class, generated from the UI's talent configs into
+// talent_trees.go.
+func talentTreesForClass(class proto.Class) ([]talentTreeConfig, error) {
+	talentTrees, ok := talentTreesByClass[class]
+	if !ok {
+		return nil, fmt.Errorf("no talent trees for class %s", class)
+	}
+	return talentTrees, nil
+}
+
+// talentBuild holds the points in each talent, per tree, in the order of the tree configs.
+type talentBuild [][]int
+
+func parseTalentBuild(talentTrees []talentTreeConfig, talentsString string) talentBuild {
+	treeStrs := strings.Split(talentsString, "-")
+	build := make(talentBuild, len(talentTrees))
+	for treeIdx, tree := range talentTrees {
+		build[treeIdx] = make([]int, len(tree.Talents))
+		if treeIdx >= len(treeStrs) {
+			continue
+		}
+		for talentIdx := 0; talentIdx < min(len(tree.Talents), len(treeStrs[treeIdx])); talentIdx++ {
+			build[treeIdx][talentIdx], _ = strconv.Atoi(string(treeStrs[treeIdx][talentIdx]))
+		}
+	}
+	return build
+}
+
+// String returns the talents string of the build, in the same format as the UI.
+// Trailing empty trees are left out.
+func (build talentBuild) String() string {
+	treeStrs := make([]string, len(build))
+	for treeIdx, points := range build {
+		var sb strings.Builder
+		for _, p := range points {
+			sb.WriteString(strconv.Itoa(p))
+		}
+		treeStrs[treeIdx] = strings.TrimRight(sb.String(), "0")
+	}
+	return strings.TrimRight(strings.Join(treeStrs, "-"), "-")
+}
+
+func (build talentBuild) numPoints() int {
+	var total int
+	for _, points := range build {
+		for _, p := range points {
+			total += p
+		}
+	}
+	return total
+}
+
+func (build talentBuild) clone() talentBuild {
+	newBuild := make(talentBuild, len(build))
+	for treeIdx, points := range build {
+		newBuild[treeIdx] = slices.Clone(points)
+	}
+	return newBuild
+}
+
+// isLegal returns true if build could be picked in game: it doesn't use more than
+// maxPoints, every talent has enough points spent in the rows above it, and every
+// talent with a prerequisite has that prerequisite maxed out.
+func (build talentBuild) isLegal(talentTrees []talentTreeConfig, maxPoints int) bool {
+	if build.numPoints() > maxPoints {
+		return false
+	}
+
+	for treeIdx, tree := range talentTrees {
+		var pointsByRow []int
+		for talentIdx, talent := range tree.Talents {
+			for len(pointsByRow) <= talent.Location.RowIdx {
+				pointsByRow = append(pointsByRow, 0)
+			}
+			pointsByRow[talent.Location.RowIdx] += build[treeIdx][talentIdx]
+		}
+
+		for talentIdx, talent := range tree.Talents {
+			points := build[treeIdx][talentIdx]
+			if points == 0 {
+				continue
+			}
+			if points > talent.MaxPoints {
+				return false
+			}
+
+			var pointsAbove int
+			for row := 0; row < talent.Location.RowIdx; row++ {
+				pointsAbove += pointsByRow[row]
+			}
+			if pointsAbove < talent.Location.RowIdx*pointsPerTalentRow {
+				return false
+			}
+
+			if talent.PrereqLocation != nil {
+				prereqIdx := slices.IndexFunc(tree.Talents, func(t talentConfig) bool {
+					return t.Location == *talent.PrereqLocation
+				})
+				if prereqIdx == -1 || build[treeIdx][prereqIdx] < tree.Talents[prereqIdx].MaxPoints {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// talentNeighbors returns all legal builds which differ from build by moving any
+// number of points from one talent to another, or by spending one unspent point.
+// Moving several points at once lets the search get past builds where moving a
+// single point would break the row or prerequisite requirements, e.g. swapping a
+// maxed talent which others depend on.
+func talentNeighbors(talentTrees []talentTreeConfig, build talentBuild, maxPoints int) []talentBuild {
+	type talentIndex struct{ tree, talent int }
+	var all []talentIndex
+	for treeIdx, tree := range talentTrees {
+		for talentIdx := range tree.Talents {
+			all = append(all, talentIndex{treeIdx, talentIdx})
+		}
+	}
+
+	var neighbors []talentBuild
+	tryBuild := func(newBuild talentBuild) {
+		if newBuild.isLegal(talentTrees, maxPoints) {
+			neighbors = append(neighbors, newBuild)
+		}
+	}
+
+	for _, to := range all {
+		freePoints := talentTrees[to.tree].Talents[to.talent].MaxPoints - build[to.tree][to.talent]
+		if freePoints <= 0 {
+			continue
+		}
+
+		if build.numPoints() < maxPoints {
+			newBuild := build.clone()
+			newBuild[to.tree][to.talent]++
+			tryBuild(newBuild)
+			continue
+		}
+
+		for _, from := range all {
+			if from == to {
+				continue
+			}
+			for numPoints := 1; numPoints <= min(freePoints, build[from.tree][from.talent]); numPoints++ {
+				newBuild := build.clone()
+				newBuild[from.tree][from.talent] -= numPoints
+				newBuild[to.tree][to.talent] += numPoints
+				tryBuild(newBuild)
+			}
+		}
+	}
+	return neighbors
+}
+
+// runesBySlot returns the runes a player of the given class and level can engrave on each slot.
+func runesBySlot(class proto.Class, level int32) [][]int32 {
+	bySlot := make([][]int32, len(proto.ItemSlot_name))
+	for _, r := range RunesByID {
+		if r.RequiresLevel > level || (len(r.ClassAllowlist) > 0 && !slices.Contains(r.ClassAllowlist, class)) {
+			continue
+		}
+		for _, slot := range itemTypeToSlotsMap[r.Type] {
+			bySlot[slot] = append(bySlot[slot], r.ID)
+		}
+	}
+	for _, runes := range bySlot {
+		slices.Sort(runes)
+	}
+	return bySlot
+}
+
+// runeNeighbors returns all substitutions which change the rune of one item in
+// equipment, keeping the rest of sub. The same rune can't be engraved twice.
+func runeNeighbors(equipment *proto.EquipmentSpec, sub *equipmentSubstitution, runes [][]int32) []*equipmentSubstitution {
+	var neighbors []*equipmentSubstitution
+	for slot, slotRunes := range runes {
+		if slot >= len(equipment.Items) || equipment.Items[slot] == nil || equipment.Items[slot].Id == 0 {
+			continue
+		}
+		for _, runeID := range slotRunes {
+			if slices.ContainsFunc(equipment.Items, func(is *proto.ItemSpec) bool {
+				return is != nil && is.Rune == runeID
+			}) {
+				continue
+			}
+
+			newSub := &equipmentSubstitution{}
+			for _, is := range sub.Items {
+				if is.Slot != proto.ItemSlot(slot) {
+					newSub.Items = append(newSub.Items, is)
+				}
+			}
+			is := goproto.Clone(equipment.Items[slot]).(*proto.ItemSpec)
+			is.Rune = runeID
+			newSub.Items = append(newSub.Items, &itemWithSlot{Item: is, Slot: proto.ItemSlot(slot)})
+			neighbors = append(neighbors, newSub)
+		}
+	}
+	return neighbors
+}
+
+// talentRuneSearch runs a best-first search over talent builds and runes, using
+// the bulk sim runner to sim each round.
+type talentRuneSearch struct {
+	settings *proto.TalentRuneSearch
+
+	baseRequest *proto.RaidSimRequest
+	talentTrees []talentTreeConfig
+	maxPoints   int
+	runes       [][]int32
+
+	seen map[string]bool
+}
+
+// Key which is the same for requests with the same talents and runes.
+func talentRuneKey(request *proto.RaidSimRequest) string {
+	player := request.Raid.Parties[0].Players[0]
+	runes := make([]string, len(player.Equipment.Items))
+	for slot, is := range player.Equipment.Items {
+		if is != nil {
+			runes[slot] = strconv.Itoa(int(is.Rune))
+		}
+	}
+	return player.TalentsString + "/" + strings.Join(runes, ",")
+}
+
+// newBuild returns the sim for the base request with talents and the runes of sub.
+func (s *talentRuneSearch) newBuild(talentsString string, sub *equipmentSubstitution) (singleBulkSim, bool) {
+	req, changeLog := createNewRequestWithSubstitution(s.baseRequest, sub, false)
+	req.Raid.Parties[0].Players[0].TalentsString = talentsString
+
+	key := talentRuneKey(req)
+	if s.seen[key] {
+		return singleBulkSim{}, false
+	}
+	s.seen[key] = true
+	return singleBulkSim{req: req, cl: changeLog, eq: sub}, true
+}
+
+func (s *talentRuneSearch) neighbors(beam []*itemSubstitutionSimResult) []singleBulkSim {
+	var neighbors []singleBulkSim
+	for _, from := range beam {
+		player := from.Request.Raid.Parties[0].Players[0]
+		if s.settings.SearchTalents {
+			build := parseTalentBuild(s.talentTrees, player.TalentsString)
+			for _, newBuild := range talentNeighbors(s.talentTrees, build, s.maxPoints) {
+				if sim, ok := s.newBuild(newBuild.String(), from.Substitution); ok {
+					neighbors = append(neighbors, sim)
+				}
+			}
+		}
+		if s.settings.SearchRunes {
+			for _, sub := range runeNeighbors(player.Equipment, from.Substitution, s.runes) {
+				if sim, ok := s.newBuild(player.TalentsString, sub); ok {
+					neighbors = append(neighbors, sim)
+				}
+			}
+		}
+	}
+	return neighbors
+}
+
+// runTalentRuneSearch searches for the best talents and runes for player. Every
+// build simmed with the full number of iterations is ranked in the result.
+func (b *bulkSimRunner) runTalentRuneSearch(ctx context.Context, player *proto.Player, iterations int64, progress chan *proto.ProgressMetrics) (*proto.BulkSimResult, error) {
+	settings := b.Request.BulkSettings.TalentRuneSearch
+	b.skipFailedSims = true
+	s := &talentRuneSearch{
+		settings:    settings,
+		baseRequest: b.Request.BaseSettings,
+		maxPoints:   int(player.Level) - 9,
+		runes:       runesBySlot(player.Class, player.Level),
+		seen:        map[string]bool{},
+	}
+	if settings.SearchTalents {
+		talentTrees, err := talentTreesForClass(player.Class)
+		if err != nil {
+			return nil, err
+		}
+		s.talentTrees = talentTrees
+		build := parseTalentBuild(talentTrees, player.TalentsString)
+		if !build.isLegal(talentTrees, s.maxPoints) {
+			return nil, fmt.Errorf("talents %s are not legal at level %d", player.TalentsString, player.Level)
+		}
+		// Same format as the builds of the search, so they can be told apart.
+		player.TalentsString = build.String()
+	}
+
+	pruningIterations := int64(settings.PruningIterations)
+	if pruningIterations <= 0 {
+		pruningIterations = defaultPruningIterations
+	}
+	beamWidth := int(settings.BeamWidth)
+	if beamWidth <= 0 {
+		beamWidth = defaultBeamWidth
+	}
+	maxRounds := int(settings.MaxRounds)
+	if maxRounds <= 0 {
+		maxRounds = defaultMaxRounds
+	}
+
+	base, _ := s.newBuild(player.TalentsString, &equipmentSubstitution{})
+	allResults, _, err := b.getRankedResults(ctx, []singleBulkSim{base}, iterations, progress)
+	if err != nil {
+		return nil, err
+	}
+	if len(allResults) == 0 {
+		return &proto.BulkSimResult{Cancelled: true}, nil
+	}
+	baseResult := allResults[0]
+
+	// Each round expands the best builds which weren't expanded yet, so the search
+	// keeps going from the next best builds when a round finds nothing better.
+	expanded := map[string]bool{}
+	cancelled := false
+	for round := 0; round < maxRounds && !cancelled; round++ {
+		sort.SliceStable(allResults, func(i, j int) bool {
+			return allResults[i].Score() > allResults[j].Score()
+		})
+		var beam []*itemSubstitutionSimResult
+		for _, r := range allResults {
+			if key := talentRuneKey(r.Request); !expanded[key] && len(beam) < beamWidth {
+				expanded[key] = true
+				beam = append(beam, r)
+			}
+		}
+
+		neighbors := s.neighbors(beam)
+		if len(neighbors) == 0 {
+			if len(beam) == 0 {
+				break
+			}
+			continue
+		}
+
+		// Prune with short sims, then sim the builds which could make the beam properly.
+		pruned, _, err := b.getRankedResults(ctx, neighbors, pruningIterations, progress)
+		if err != nil {
+			return nil, err
+		}
+		if len(pruned) > beamWidth {
+			pruned = pruned[:beamWidth]
+		}
+		survivors := make([]singleBulkSim, len(pruned))
+		for i, r := range pruned {
+			survivors[i] = singleBulkSim{req: r.Request, cl: r.ChangeLog, eq: r.Substitution}
+		}
+		results, _, err := b.getRankedResults(ctx, survivors, iterations, progress)
+		if err != nil {
+			return nil, err
+		}
+		cancelled = ctx.Err() != nil
+		allResults = append(allResults, results...)
+	}
+
+	sort.SliceStable(allResults, func(i, j int) bool {
+		return allResults[i].Score() > allResults[j].Score()
+	})
+	bestResult := allResults[0]
+	if len(allResults) > maxBulkResults {
+		allResults = allResults[:maxBulkResults]
+	}
+
+	result := &proto.BulkSimResult{
+		Cancelled:          cancelled,
+		EquippedGearResult: newBulkComboResult(baseResult, baseResult, bestResult),
+	}
+	for _, r := range allResults {
+		comboResult := newBulkComboResult(r, baseResult, bestResult)
+		comboResult.ItemsAdded = r.ChangeLog.AddedItems
+		comboResult.TalentLoadout = &proto.TalentLoadout{
+			TalentsString: r.Request.Raid.Parties[0].Players[0].TalentsString,
+		}
+		result.Results = append(result.Results, comboResult)
+	}
+	return result, nil
+}
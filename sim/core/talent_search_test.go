@@ -0,0 +1,131 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func TestTalentBuildIsLegal(t *testing.T) {
+	talentTrees, err := talentTreesForClass(proto.Class_ClassMage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		comment string
+		talents string
+		level   int
+		want    bool
+	}{
+		{"Empty", "", 60, true},
+		{"First row", "25", 60, true},
+		{"Too many points in a talent", "5", 60, false},
+		{"Not enough points above", "2001", 60, false},
+		{"Enough points above", "23001", 60, true},
+		{"Prerequisite maxed", "05505500100001", 60, true},
+		{"Prerequisite missing", "05505503000001", 60, false},
+		{"Too many points for the level", "05505500100001", 30, false},
+		{"Other trees", "-5-05", 60, true},
+	} {
+		build := parseTalentBuild(talentTrees, tc.talents)
+		if got := build.isLegal(talentTrees, tc.level-9); got != tc.want {
+			t.Errorf("%s: isLegal(%q) = %v, want %v", tc.comment, tc.talents, got, tc.want)
+		}
+		if tc.want && build.String() != tc.talents {
+			t.Errorf("%s: got talents string %q, want %q", tc.comment, build.String(), tc.talents)
+		}
+	}
+}
+
+func TestTalentNeighbors(t *testing.T) {
+	talentTrees, err := talentTreesForClass(proto.Class_ClassMage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With unspent points, each neighbor spends one more point.
+	build := parseTalentBuild(talentTrees, "23001")
+	neighbors := talentNeighbors(talentTrees, build, 51)
+	if len(neighbors) == 0 {
+		t.Fatalf("Expected neighbors")
+	}
+	for _, neighbor := range neighbors {
+		if !neighbor.isLegal(talentTrees, 51) || neighbor.numPoints() != build.numPoints()+1 {
+			t.Errorf("Unexpected neighbor %s", neighbor)
+		}
+	}
+
+	// With all points spent, each neighbor moves one point.
+	neighbors = talentNeighbors(talentTrees, build, build.numPoints())
+	if len(neighbors) == 0 {
+		t.Fatalf("Expected neighbors")
+	}
+	for _, neighbor := range neighbors {
+		if !neighbor.isLegal(talentTrees, build.numPoints()) || neighbor.numPoints() != build.numPoints() {
+			t.Errorf("Unexpected neighbor %s", neighbor)
+		}
+		if neighbor.String() == "13002" {
+			t.Errorf("Neighbor %s breaks the row requirement", neighbor)
+		}
+	}
+}
+
+func TestTalentNeighborsMoveSeveralPoints(t *testing.T) {
+	talentTrees, err := talentTreesForClass(proto.Class_ClassMage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Points can be moved to another talent all at once, but not out of the first
+	// row while the second row needs them.
+	build := parseTalentBuild(talentTrees, "05001")
+	neighbors := talentNeighbors(talentTrees, build, build.numPoints())
+	var got []string
+	for _, neighbor := range neighbors {
+		got = append(got, neighbor.String())
+	}
+	if !slices.Contains(got, "00501") {
+		t.Errorf("Expected moving all points between talents, got %v", got)
+	}
+	if slices.Contains(got, "04002") {
+		t.Errorf("Unexpected illegal neighbor 04002")
+	}
+}
+
+func TestRuneNeighbors(t *testing.T) {
+	addToDatabase(&proto.SimDatabase{
+		Runes: []*proto.SimRune{
+			{Id: 990101, Type: proto.ItemType_ItemTypeChest, ClassAllowlist: []proto.Class{proto.Class_ClassMage}},
+			{Id: 990102, Type: proto.ItemType_ItemTypeChest, ClassAllowlist: []proto.Class{proto.Class_ClassMage}},
+			{Id: 990103, Type: proto.ItemType_ItemTypeChest, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}},
+			{Id: 990104, Type: proto.ItemType_ItemTypeFinger, ClassAllowlist: []proto.Class{proto.Class_ClassMage}},
+			{Id: 990105, Type: proto.ItemType_ItemTypeLegs, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, RequiresLevel: 50},
+		},
+	})
+
+	// Ignore the runes of the real database, if loaded.
+	runes := runesBySlot(proto.Class_ClassMage, 40)
+	for slot := range runes {
+		runes[slot] = slices.DeleteFunc(runes[slot], func(runeID int32) bool { return runeID < 990101 })
+	}
+	if !slices.Equal(runes[proto.ItemSlot_ItemSlotFinger2], []int32{990104}) {
+		t.Errorf("Expected the ring rune for both rings, got %v", runes[proto.ItemSlot_ItemSlotFinger2])
+	}
+	equipment := &proto.EquipmentSpec{Items: make([]*proto.ItemSpec, len(proto.ItemSlot_name))}
+	equipment.Items[proto.ItemSlot_ItemSlotChest] = &proto.ItemSpec{Id: 1, Rune: 990101}
+	equipment.Items[proto.ItemSlot_ItemSlotFinger1] = &proto.ItemSpec{Id: 2, Rune: 990104}
+	equipment.Items[proto.ItemSlot_ItemSlotFinger2] = &proto.ItemSpec{Id: 3}
+	equipment.Items[proto.ItemSlot_ItemSlotLegs] = &proto.ItemSpec{Id: 4}
+
+	// Only the other mage chest rune is new, the ring rune is already used and the
+	// legs rune needs a higher level.
+	neighbors := runeNeighbors(equipment, &equipmentSubstitution{}, runes)
+	if len(neighbors) != 1 {
+		t.Fatalf("Expected 1 neighbor, got %d", len(neighbors))
+	}
+	if is := neighbors[0].Items[0]; is.Slot != proto.ItemSlot_ItemSlotChest || is.Item.Id != 1 || is.Item.Rune != 990102 {
+		t.Errorf("Unexpected neighbor %v", is)
+	}
+}
@@ -0,0 +1,593 @@
+// Code generated by tools/gen_talent_trees from ui/core/talents/trees. DO NOT EDIT.
+
+package core
+
+import "github.com/wowsims/sod/sim/core/proto"
+
+var talentTreesByClass = map[proto.Class][]talentTreeConfig{
+	proto.Class_ClassDruid: {
+		{
+			Name: "Balance",
+			Talents: []talentConfig{
+				{FieldName: "improvedWrath", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "naturesGrasp", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedNaturesGrasp", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 4, PrereqLocation: &talentLocation{RowIdx: 0, ColIdx: 1}},
+				{FieldName: "improvedEntanglingRoots", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedMoonfire", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "naturalWeapons", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "naturalShapeshifter", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "improvedThorns", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "omenOfClarity", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 2}},
+				{FieldName: "naturesReach", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "vengeance", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 1}},
+				{FieldName: "improvedStarfire", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "naturesGrace", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "moonglow", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "moonfury", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "moonkinForm", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1},
+			},
+		},
+		{
+			Name: "Feral Combat",
+			Talents: []talentConfig{
+				{FieldName: "ferocity", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "feralAggression", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "feralInstinct", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "brutalImpact", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "thickHide", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "felineSwiftness", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "feralCharge", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "sharpenedClaws", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "improvedShred", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "predatoryStrikes", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "bloodFrenzy", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "primalFury", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "savageFury", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "faerieFireFeral", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "heartOfTheWild", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 1}},
+				{FieldName: "leaderOfThePack", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1},
+			},
+		},
+		{
+			Name: "Restoration",
+			Talents: []talentConfig{
+				{FieldName: "improvedMarkOfTheWild", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "furor", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedHealingTouch", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "naturesFocus", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedEnrage", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "reflection", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "insectSwarm", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "subtlety", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "tranquilSpirit", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedRejuvenation", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "naturesSwiftness", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 0}},
+				{FieldName: "giftOfNature", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "improvedTranquility", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "improvedRegrowth", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "swiftmend", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassHunter: {
+		{
+			Name: "Beast Mastery",
+			Talents: []talentConfig{
+				{FieldName: "improvedAspectOfTheHawk", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "enduranceTraining", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedEyesOfTheBeast", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedAspectOfTheMonkey", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "thickHide", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "improvedRevivePet", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "pathfinding", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "bestialSwiftness", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "unleashedFury", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedMendPet", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "ferocity", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "spiritBond", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "intimidation", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "bestialDiscipline", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "frenzy", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 2}},
+				{FieldName: "bestialWrath", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Marksmanship",
+			Talents: []talentConfig{
+				{FieldName: "improvedConcussiveShot", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "efficiency", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedHuntersMark", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "lethalShots", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "aimedShot", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 1},
+				{FieldName: "improvedArcaneShot", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "hawkEye", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "improvedSerpentSting", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "mortalShots", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 2}},
+				{FieldName: "scatterShot", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 1},
+				{FieldName: "barrage", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "improvedScorpidSting", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "rangedWeaponSpecialization", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "trueshotAura", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Survival",
+			Talents: []talentConfig{
+				{FieldName: "monsterSlaying", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "humanoidSlaying", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "deflection", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "entrapment", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "savageStrikes", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedWingClip", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "cleverTraps", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "survivalist", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "deterrence", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "trapMastery", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "surefooted", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "improvedFeignDeath", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "killerInstinct", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "counterattack", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "lightningReflexes", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "wyvernSting", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassMage: {
+		{
+			Name: "Arcane",
+			Talents: []talentConfig{
+				{FieldName: "arcaneSubtlety", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "arcaneFocus", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedArcaneMissiles", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "wandSpecialization", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "magicAbsorption", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "arcaneConcentration", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "magicAttunement", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedArcaneExplosion", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "arcaneResilience", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "improvedManaShield", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedCounterspell", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "arcaneMeditation", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "presenceOfMind", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "arcaneMind", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "arcaneInstability", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 3, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "arcanePower", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 5, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Fire",
+			Talents: []talentConfig{
+				{FieldName: "improvedFireball", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "impact", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "ignite", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "flameThrowing", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedFireBlast", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "incinerate", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedFlamestrike", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "pyroblast", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "burningSoul", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "improvedScorch", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedFireWard", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "masterOfElements", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "criticalMass", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "blastWave", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "firePower", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "combustion", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Frost",
+			Talents: []talentConfig{
+				{FieldName: "frostWarding", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedFrostbolt", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "elementalPrecision", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "iceShards", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "frostbite", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "improvedFrostNova", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "permafrost", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "piercingIce", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "coldSnap", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedBlizzard", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "arcticReach", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "frostChanneling", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "shatter", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 2}},
+				{FieldName: "iceBlock", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedConeOfCold", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "wintersChill", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "iceBarrier", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassPaladin: {
+		{
+			Name: "Holy",
+			Talents: []talentConfig{
+				{FieldName: "divineStrength", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "divineIntellect", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "spiritualFocus", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedSealOfRighteousness", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "healingLight", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "consecration", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedLayOnHands", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "unyieldingFaith", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "illumination", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedBlessingOfWisdom", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "divineFavor", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 1}},
+				{FieldName: "lastingJudgement", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "holyPower", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "holyShock", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Protection",
+			Talents: []talentConfig{
+				{FieldName: "improvedDevotionAura", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "redoubt", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "precision", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "guardiansFavor", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "toughness", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "blessingOfKings", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 1},
+				{FieldName: "improvedRighteousFury", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "shieldSpecialization", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3, PrereqLocation: &talentLocation{RowIdx: 0, ColIdx: 2}},
+				{FieldName: "anticipation", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "improvedHammerOfJustice", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "improvedConcentrationAura", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "blessingOfSanctuary", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "reckoning", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "oneHandedWeaponSpecialization", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "holyShield", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Retribution",
+			Talents: []talentConfig{
+				{FieldName: "improvedBlessingOfMight", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "benediction", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedJudgement", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedSealOfTheCrusader", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "deflection", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "vindication", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "conviction", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "sealOfCommand", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "pursuitOfJustice", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "eyeForAnEye", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedRetributionAura", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "twoHandedWeaponSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "sanctityAura", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "vengeance", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 1}},
+				{FieldName: "repentance", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1},
+			},
+		},
+	},
+	proto.Class_ClassPriest: {
+		{
+			Name: "Discipline",
+			Talents: []talentConfig{
+				{FieldName: "unbreakableWill", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "wandSpecialization", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "silentResolve", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "improvedPowerWordFortitude", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedPowerWordShield", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "martyrdom", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "innerFocus", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "meditation", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "improvedInnerFire", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "mentalAgility", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedManaBurn", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "mentalStrength", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "divineSpirit", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "forceOfWill", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "powerInfusion", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Holy",
+			Talents: []talentConfig{
+				{FieldName: "healingFocus", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedRenew", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "holySpecialization", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "spellWarding", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "divineFury", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "holyNova", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 1},
+				{FieldName: "blessedRecovery", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "inspiration", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "holyReach", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedHealing", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "searingLight", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 2}},
+				{FieldName: "improvedPrayerOfHealing", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "spiritOfRedemption", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "spiritualGuidance", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "spiritualHealing", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "lightwell", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Shadow",
+			Talents: []talentConfig{
+				{FieldName: "spiritTap", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "blackout", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "shadowAffinity", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedShadowWordPain", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "shadowFocus", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedPsychicScream", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedMindBlast", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "mindFlay", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "improvedFade", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "shadowReach", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "shadowWeaving", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "silence", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 0}},
+				{FieldName: "vampiricEmbrace", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedVampiricEmbrace", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "darkness", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "shadowform", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassRogue: {
+		{
+			Name: "Assassination",
+			Talents: []talentConfig{
+				{FieldName: "improvedEviscerate", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "remorselessAttacks", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "malice", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "ruthlessness", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "murder", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedSliceAndDice", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "relentlessStrikes", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 1},
+				{FieldName: "improvedExposeArmor", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "lethality", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 0, ColIdx: 2}},
+				{FieldName: "vilePoisons", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedPoisons", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "coldBlood", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedKidneyShot", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "sealFate", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "vigor", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1},
+			},
+		},
+		{
+			Name: "Combat",
+			Talents: []talentConfig{
+				{FieldName: "improvedGouge", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedSinisterStrike", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "lightningReflexes", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedBackstab", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "deflection", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "precision", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "endurance", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "riposte", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 1}},
+				{FieldName: "improvedSprint", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "improvedKick", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "daggerSpecialization", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "dualWieldSpecialization", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 2}},
+				{FieldName: "maceSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "bladeFlurry", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "swordSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "fistWeaponSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "weaponExpertise", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "aggression", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "adrenalineRush", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1},
+			},
+		},
+		{
+			Name: "Subtlety",
+			Talents: []talentConfig{
+				{FieldName: "masterOfDeception", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "opportunity", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "sleightOfHand", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "elusiveness", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "camouflage", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "initiative", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "ghostlyStrike", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedAmbush", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "setup", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedSap", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "serratedBlades", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "heightenedSenses", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "preparation", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "dirtyDeeds", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "hemorrhage", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 2}},
+				{FieldName: "deadliness", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "premeditation", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassShaman: {
+		{
+			Name: "Elemental",
+			Talents: []talentConfig{
+				{FieldName: "convection", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "concussion", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "earthsGrasp", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "elementalWarding", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "callOfFlame", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "elementalFocus", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 1},
+				{FieldName: "reverberation", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "callOfThunder", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedFireTotems", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "eyeOfTheStorm", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "elementalDevastation", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "stormReach", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "elementalFury", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "lightningMastery", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "elementalMastery", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Enhancement",
+			Talents: []talentConfig{
+				{FieldName: "ancestralKnowledge", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "shieldSpecialization", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "guardianTotems", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "thunderingStrikes", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedGhostWolf", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "improvedLightningShield", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "enhancingTotems", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "twoHandedAxesAndMaces", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "anticipation", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "flurry", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 1}},
+				{FieldName: "toughness", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedWeaponTotems", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "elementalWeapons", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "parry", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "weaponMastery", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "stormstrike", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Restoration",
+			Talents: []talentConfig{
+				{FieldName: "improvedHealingWave", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "tidalFocus", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedReincarnation", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "ancestralHealing", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "totemicFocus", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "naturesGuidance", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "healingFocus", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "totemicMastery", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "healingGrace", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "restorativeTotems", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "tidalMastery", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "healingWay", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "naturesSwiftness", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "purification", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "manaTideTotem", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassWarlock: {
+		{
+			Name: "Affliction",
+			Talents: []talentConfig{
+				{FieldName: "suppression", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedCorruption", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedCurseOfWeakness", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedDrainSoul", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedLifeTap", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "improvedDrainLife", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "improvedCurseOfAgony", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "felConcentration", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "amplifyCurse", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 1},
+				{FieldName: "grimReach", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "nightfall", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedDrainMana", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "siphonLife", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "curseOfExhaustion", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "improvedCurseOfExhaustion", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 4, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 2}},
+				{FieldName: "shadowMastery", Location: talentLocation{RowIdx: 5, ColIdx: 1}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "darkPact", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1},
+			},
+		},
+		{
+			Name: "Demonology",
+			Talents: []talentConfig{
+				{FieldName: "improvedHealthstone", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedImp", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "demonicEmbrace", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedHealthFunnel", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedVoidwalker", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "felIntellect", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedSayaad", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "felDomination", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "felStamina", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "masterSummoner", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 1}},
+				{FieldName: "unholyPower", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedSubjugateDemon", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "demonicSacrifice", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedFirestone", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "masterDemonologist", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 2}},
+				{FieldName: "soulLink", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+				{FieldName: "improvedSpellstone", Location: talentLocation{RowIdx: 6, ColIdx: 2}, MaxPoints: 2},
+			},
+		},
+		{
+			Name: "Destruction",
+			Talents: []talentConfig{
+				{FieldName: "improvedShadowBolt", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "cataclysm", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "bane", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "aftermath", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedFirebolt", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "improvedLashOfPain", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "devastation", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "shadowburn", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 1},
+				{FieldName: "intensity", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "destructiveReach", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "improvedSearingPain", Location: talentLocation{RowIdx: 3, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "pyroclasm", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 0}},
+				{FieldName: "improvedImmolate", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "ruin", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "emberstorm", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "conflagrate", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+	},
+	proto.Class_ClassWarrior: {
+		{
+			Name: "Arms",
+			Talents: []talentConfig{
+				{FieldName: "improvedHeroicStrike", Location: talentLocation{RowIdx: 0, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "deflection", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedRend", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "improvedCharge", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "tacticalMastery", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "improvedThunderClap", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 3},
+				{FieldName: "improvedOverpower", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "angerManagement", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 1}},
+				{FieldName: "deepWounds", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3, PrereqLocation: &talentLocation{RowIdx: 0, ColIdx: 2}},
+				{FieldName: "twoHandedWeaponSpecialization", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "impale", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 2, PrereqLocation: &talentLocation{RowIdx: 2, ColIdx: 2}},
+				{FieldName: "axeSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "sweepingStrikes", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "maceSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "swordSpecialization", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "polearmSpecialization", Location: talentLocation{RowIdx: 5, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "improvedHamstring", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "mortalStrike", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Fury",
+			Talents: []talentConfig{
+				{FieldName: "boomingVoice", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "cruelty", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedDemoralizingShout", Location: talentLocation{RowIdx: 1, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "unbridledWrath", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedCleave", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "piercingHowl", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "bloodCraze", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "improvedBattleShout", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "dualWieldSpecialization", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "improvedExecute", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 2},
+				{FieldName: "enrage", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedSlam", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 5},
+				{FieldName: "deathWish", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedIntercept", Location: talentLocation{RowIdx: 4, ColIdx: 3}, MaxPoints: 2},
+				{FieldName: "improvedBerserkerRage", Location: talentLocation{RowIdx: 5, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "flurry", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5, PrereqLocation: &talentLocation{RowIdx: 3, ColIdx: 2}},
+				{FieldName: "bloodthirst", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+		{
+			Name: "Protection",
+			Talents: []talentConfig{
+				{FieldName: "shieldSpecialization", Location: talentLocation{RowIdx: 0, ColIdx: 1}, MaxPoints: 5},
+				{FieldName: "anticipation", Location: talentLocation{RowIdx: 0, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "improvedBloodrage", Location: talentLocation{RowIdx: 1, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "toughness", Location: talentLocation{RowIdx: 1, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "ironWill", Location: talentLocation{RowIdx: 1, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "lastStand", Location: talentLocation{RowIdx: 2, ColIdx: 0}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 1, ColIdx: 0}},
+				{FieldName: "improvedShieldBlock", Location: talentLocation{RowIdx: 2, ColIdx: 1}, MaxPoints: 3, PrereqLocation: &talentLocation{RowIdx: 0, ColIdx: 1}},
+				{FieldName: "improvedRevenge", Location: talentLocation{RowIdx: 2, ColIdx: 2}, MaxPoints: 3},
+				{FieldName: "defiance", Location: talentLocation{RowIdx: 2, ColIdx: 3}, MaxPoints: 5},
+				{FieldName: "improvedSunderArmor", Location: talentLocation{RowIdx: 3, ColIdx: 0}, MaxPoints: 3},
+				{FieldName: "improvedDisarm", Location: talentLocation{RowIdx: 3, ColIdx: 1}, MaxPoints: 3},
+				{FieldName: "improvedTaunt", Location: talentLocation{RowIdx: 3, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "improvedShieldWall", Location: talentLocation{RowIdx: 4, ColIdx: 0}, MaxPoints: 2},
+				{FieldName: "concussionBlow", Location: talentLocation{RowIdx: 4, ColIdx: 1}, MaxPoints: 1},
+				{FieldName: "improvedShieldBash", Location: talentLocation{RowIdx: 4, ColIdx: 2}, MaxPoints: 2},
+				{FieldName: "oneHandedWeaponSpecialization", Location: talentLocation{RowIdx: 5, ColIdx: 2}, MaxPoints: 5},
+				{FieldName: "shieldSlam", Location: talentLocation{RowIdx: 6, ColIdx: 1}, MaxPoints: 1, PrereqLocation: &talentLocation{RowIdx: 4, ColIdx: 1}},
+			},
+		},
+	},
+}
@@ -14,6 +14,16 @@ type Encounter struct {
 	Targets           []*Target
 	TargetUnits       []*Unit
 
+	// Targets which are currently active, in the same order as Targets. All
+	// targets are active unless the encounter has phases.
+	ActiveTargets     []*Target
+	ActiveTargetUnits []*Unit
+
+	phases *encounterPhases
+
+	// Incoming damage on the raid, for healing sims.
+	raidDamage *raidDamageModel
+
 	ExecuteProportion_20 float64
 	ExecuteProportion_25 float64
 	ExecuteProportion_35 float64
@@ -64,6 +74,13 @@ func NewEncounter(options *proto.Encounter) Encounter {
 		encounter.TargetUnits = append(encounter.TargetUnits, &target.Unit)
 	}
 
+	encounter.ActiveTargets = append([]*Target{}, encounter.Targets...)
+	encounter.ActiveTargetUnits = append([]*Unit{}, encounter.TargetUnits...)
+	if len(options.Phases) > 0 {
+		encounter.phases = newEncounterPhases(options.Phases, len(encounter.Targets))
+	}
+	encounter.raidDamage = newRaidDamageModel(options.RaidDamage)
+
 	if encounter.EndFightAtHealth > 0 {
 		// Until we pre-sim set duration to 10m
 		encounter.Duration = time.Minute * 10
@@ -79,7 +96,72 @@ func (encounter *Encounter) AOECapMultiplier() float64 {
 	return encounter.aoeCapMultiplier
 }
 func (encounter *Encounter) updateAOECapMultiplier() {
-	encounter.aoeCapMultiplier = min(10/float64(len(encounter.Targets)), 1)
+	encounter.aoeCapMultiplier = min(10/float64(max(len(encounter.ActiveTargets), 1)), 1)
+}
+
+// Rebuilds the active target lists after targets were enabled or disabled.
+func (encounter *Encounter) updateActiveTargets() {
+	encounter.ActiveTargets = encounter.ActiveTargets[:0]
+	encounter.ActiveTargetUnits = encounter.ActiveTargetUnits[:0]
+	for _, target := range encounter.Targets {
+		if target.IsEnabled() {
+			encounter.ActiveTargets = append(encounter.ActiveTargets, target)
+			encounter.ActiveTargetUnits = append(encounter.ActiveTargetUnits, &target.Unit)
+		}
+	}
+	encounter.updateAOECapMultiplier()
+}
+
+// EnableTarget makes a despawned or untargetable target active again.
+func (encounter *Encounter) EnableTarget(sim *Simulation, target *Target) {
+	if target.IsEnabled() {
+		return
+	}
+	if sim.Log != nil {
+		target.Log(sim, "Enabled")
+	}
+
+	target.enabled = true
+	encounter.updateActiveTargets()
+	if sim.CurrentTime >= 0 {
+		target.AutoAttacks.EnableAutoSwing(sim)
+	}
+}
+
+// DisableTarget despawns a target or makes it untargetable. Its temporary auras,
+// including DoTs, are removed and it stops attacking. Permanent auras, like raid
+// debuffs, stay active so they still apply if the target is enabled again. Players targeting it switch
+// to the first active target.
+func (encounter *Encounter) DisableTarget(sim *Simulation, target *Target) {
+	if !target.IsEnabled() {
+		return
+	}
+	if sim.Log != nil {
+		target.Log(sim, "Disabled")
+	}
+
+	target.enabled = false
+	encounter.updateActiveTargets()
+	target.AutoAttacks.CancelAutoSwing(sim)
+	target.auraTracker.expireTemporary(sim)
+
+	if len(encounter.ActiveTargetUnits) > 0 {
+		for _, unit := range sim.Raid.AllUnits {
+			if unit.CurrentTarget == &target.Unit {
+				unit.changeTarget(sim, encounter.ActiveTargetUnits[0])
+			}
+		}
+	}
+}
+
+func (encounter *Encounter) reset(sim *Simulation) {
+	encounter.updateActiveTargets()
+	if encounter.phases != nil {
+		encounter.phases.reset(sim)
+	}
+	if encounter.raidDamage != nil {
+		encounter.raidDamage.reset(sim)
+	}
 }
 
 func (encounter *Encounter) doneIteration(sim *Simulation) {
@@ -108,6 +190,9 @@ type Target struct {
 	Unit
 
 	AI TargetAI
+
+	// Damage taken this iteration, for health-based triggers.
+	damageTaken float64
 }
 
 func NewTarget(options *proto.Target, targetIndex int32) *Target {
@@ -143,28 +228,49 @@ func NewTarget(options *proto.Target, targetIndex int32) *Target {
 	target.PseudoStats.InFrontOfTarget = true
 	target.PseudoStats.DamageSpread = options.DamageSpread
 
-	preset := GetPresetTargetWithID(options.Id)
+	preset := GetPresetTargetWithIDAndName(options.Id, options.Name)
 	if preset != nil && preset.AI != nil {
 		target.AI = preset.AI()
 	}
+	if len(options.GetScript().GetAbilities()) > 0 {
+		target.AI = newScriptedAI(options.Script)
+	}
 
 	return target
 }
 
 func (target *Target) Reset(sim *Simulation) {
 	target.Unit.reset(sim, nil)
+	target.damageTaken = 0
 	target.SetGCDTimer(sim, 0)
 	if target.AI != nil {
 		target.AI.Reset(sim)
 	}
 }
 
+// HealthPercent returns the fraction (0-1) of its health the target has left,
+// based on the damage it has taken this iteration. Targets without health use
+// the encounter's remaining duration instead.
+func (target *Target) HealthPercent(sim *Simulation) float64 {
+	maxHealth := target.GetStat(stats.Health)
+	if maxHealth <= 0 {
+		return sim.GetRemainingDurationPercent()
+	}
+	return max(1-target.damageTaken/maxHealth, 0)
+}
+
+// NextTarget returns the next active target, or this target if no other target is active.
 func (target *Target) NextTarget() *Target {
-	nextIndex := target.Index + 1
-	if nextIndex >= target.Env.GetNumTargets() {
-		nextIndex = 0
+	nextIndex := target.Index
+	for {
+		nextIndex++
+		if nextIndex >= target.Env.GetNumTargets() {
+			nextIndex = 0
+		}
+		if next := target.Env.GetTarget(nextIndex); next == target || next.IsEnabled() {
+			return next
+		}
 	}
-	return target.Env.GetTarget(nextIndex)
 }
 
 func (target *Target) GetMetricsProto() *proto.UnitMetrics {
@@ -43,6 +43,18 @@ func (target *Target) initialize(config *proto.Target) {
 		target.gcdAction = &PendingAction{
 			Priority: ActionPriorityGCD,
 			OnAction: func(sim *Simulation) {
+				// Hardcasts that end with the GCD are completed here, like for characters.
+				if hc := &target.Hardcast; hc.Expires != startingCDTime && hc.Expires <= sim.CurrentTime {
+					hc.Expires = startingCDTime
+					if hc.OnComplete != nil {
+						hc.OnComplete(sim, hc.Target)
+						// Completing the cast already picked the next action.
+						if !sim.Options.Interactive {
+							return
+						}
+					}
+				}
+
 				target.Rotation.DoNextAction(sim)
 			},
 		}
@@ -58,7 +70,7 @@ func (target *Target) GetCharacter() *Character          { return nil }
 func (target *Target) Initialize()                       {}
 
 func (target *Target) ExecuteCustomRotation(sim *Simulation) {
-	if target.AI != nil {
+	if target.AI != nil && target.IsEnabled() {
 		target.AI.ExecuteCustomRotation(sim)
 	}
 }
@@ -115,7 +127,22 @@ func GetPresetTargetWithID(id int32) *PresetTarget {
 	return nil
 }
 
+// Generic presets can reuse the ID of the boss they are modeled after, so
+// prefer the preset that also has the same name.
+func GetPresetTargetWithIDAndName(id int32, name string) *PresetTarget {
+	for _, preset := range presetTargets {
+		if preset.Config.Id == id && preset.Config.Name == name {
+			return preset
+		}
+	}
+	return GetPresetTargetWithID(id)
+}
+
 func AddPresetEncounter(name string, targetPaths []string) {
+	AddPresetEncounterWithPhases(name, targetPaths, nil)
+}
+
+func AddPresetEncounterWithPhases(name string, targetPaths []string, phases []*proto.EncounterPhase) {
 	if len(targetPaths) == 0 {
 		log.Fatalf("Encounter must have targets!")
 	}
@@ -144,5 +171,6 @@ func AddPresetEncounter(name string, targetPaths []string) {
 	PresetEncounters = append(PresetEncounters, &proto.PresetEncounter{
 		Path:    path,
 		Targets: targetProtos,
+		Phases:  phases,
 	})
 }
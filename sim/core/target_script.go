@@ -0,0 +1,319 @@
+package core
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+// scriptedAI is the TargetAI of targets with a TargetScript.
+type scriptedAI struct {
+	target    *Target
+	script    *proto.TargetScript
+	abilities []*ScriptedAbility
+}
+
+// A ScriptedAbility is a target ability configured like those of target
+// scripts. The AIs of presets use them too, for their common abilities.
+type ScriptedAbility struct {
+	Config *proto.ScriptedAbility
+	Spell  *Spell
+
+	// Debuff on each unit, indexed by UnitIndex.
+	Debuffs AuraArray
+	Buff    *Aura
+
+	target *Target
+}
+
+func newScriptedAI(script *proto.TargetScript) TargetAI {
+	return &scriptedAI{
+		script: script,
+	}
+}
+
+func (ai *scriptedAI) Initialize(target *Target, _ *proto.Target) {
+	ai.target = target
+	for i, config := range ai.script.Abilities {
+		// Tag by index, so that abilities without a spell ID don't collide.
+		ai.abilities = append(ai.abilities, newScriptedAbility(target, config, int32(i+1)))
+	}
+}
+
+func (ai *scriptedAI) Reset(sim *Simulation) {
+	for _, ability := range ai.abilities {
+		ability.Reset(sim)
+	}
+}
+
+func (ai *scriptedAI) ExecuteCustomRotation(sim *Simulation) {
+	for _, ability := range ai.abilities {
+		if ability.TryCast(sim) {
+			return
+		}
+	}
+}
+
+// Puts the ability on its initial cooldown, for the start of an iteration.
+func (ability *ScriptedAbility) Reset(_ *Simulation) {
+	ability.Spell.CD.Set(DurationFromSeconds(ability.Config.InitialCooldown))
+}
+
+// Whether the ability is ready and allowed in the current phase and health.
+func (ability *ScriptedAbility) IsUsable(sim *Simulation) bool {
+	if !ability.Spell.IsReady(sim) {
+		return false
+	}
+	if len(ability.Config.Phases) > 0 && !slices.Contains(ability.Config.Phases, int32(sim.Encounter.CurrentPhase())+1) {
+		return false
+	}
+	if ability.Config.BelowHealthPercent > 0 && ability.target.HealthPercent(sim) >= ability.Config.BelowHealthPercent {
+		return false
+	}
+	return true
+}
+
+// Casts the ability on the unit chosen by its targeting, if it's usable.
+// Returns whether it was cast.
+func (ability *ScriptedAbility) TryCast(sim *Simulation) bool {
+	if !ability.IsUsable(sim) {
+		return false
+	}
+
+	var target *Unit
+	switch ability.Config.Targeting {
+	case proto.ScriptedAbility_TargetingTank:
+		target = ability.target.CurrentTarget
+	case proto.ScriptedAbility_TargetingRandomPlayer, proto.ScriptedAbility_TargetingChain:
+		target = randomUnit(sim, sim.Raid.AllPlayerUnits)
+	case proto.ScriptedAbility_TargetingAllPlayers:
+		target = sim.Raid.AllPlayerUnits[0]
+	case proto.ScriptedAbility_TargetingSelf:
+		target = &ability.target.Unit
+	}
+	if target == nil {
+		return false
+	}
+
+	return ability.Spell.Cast(sim, target)
+}
+
+// NewScriptedAbility registers an ability for the target, for AIs that pick
+// their abilities themselves.
+func NewScriptedAbility(target *Target, config *proto.ScriptedAbility) *ScriptedAbility {
+	return newScriptedAbility(target, config, 0)
+}
+
+func newScriptedAbility(target *Target, config *proto.ScriptedAbility, tag int32) *ScriptedAbility {
+	ability := &ScriptedAbility{
+		Config: config,
+		target: target,
+	}
+
+	school := SpellSchoolFromProto(config.School)
+	minDamage := config.MinDamage
+	maxDamage := max(config.MaxDamage, minDamage)
+	dealsDamage := maxDamage > 0 && config.Targeting != proto.ScriptedAbility_TargetingSelf
+
+	if config.Debuff != nil {
+		ability.Debuffs = ability.makeDebuffs(target, config.Debuff)
+	}
+	if config.Buff != nil {
+		label := config.Buff.Name
+		if label == "" {
+			label = config.Name
+		}
+		ability.Buff = NewScriptedBuff(target, ActionID{SpellID: config.SpellId}, label, config.Buff)
+	}
+
+	spellConfig := SpellConfig{
+		ActionID:    ActionID{SpellID: config.SpellId, Tag: tag},
+		SpellSchool: school,
+		DefenseType: DefenseTypeMagic,
+		ProcMask:    ProcMaskSpellDamage,
+
+		Cast: CastConfig{
+			DefaultCast: Cast{
+				CastTime: DurationFromSeconds(config.CastTime),
+			},
+			CD: Cooldown{
+				Timer:    target.NewTimer(),
+				Duration: DurationFromSeconds(config.Cooldown),
+			},
+		},
+
+		DamageMultiplier: 1,
+
+		ApplyEffects: func(sim *Simulation, target *Unit, spell *Spell) {
+			if ability.Buff != nil {
+				ability.Buff.Activate(sim)
+			}
+			if config.Targeting == proto.ScriptedAbility_TargetingSelf {
+				return
+			}
+
+			switch config.Targeting {
+			case proto.ScriptedAbility_TargetingAllPlayers:
+				for _, player := range sim.Raid.AllPlayerUnits {
+					ability.hit(sim, player, spell, dealsDamage, minDamage, maxDamage)
+				}
+			case proto.ScriptedAbility_TargetingChain:
+				for _, player := range chainTargets(sim, target, int(config.ChainTargets)) {
+					ability.hit(sim, player, spell, dealsDamage, minDamage, maxDamage)
+				}
+			default:
+				ability.hit(sim, target, spell, dealsDamage, minDamage, maxDamage)
+			}
+		},
+	}
+
+	if school == SpellSchoolPhysical {
+		spellConfig.DefenseType = DefenseTypeMelee
+		spellConfig.ProcMask = ProcMaskMeleeMHSpecial
+		spellConfig.Flags |= SpellFlagMeleeMetrics
+	}
+	if config.CastTime > 0 {
+		// Targets stop attacking while casting.
+		spellConfig.Flags |= SpellFlagResetAttackSwing
+	}
+
+	ability.Spell = target.RegisterSpell(spellConfig)
+	return ability
+}
+
+func randomUnit(sim *Simulation, units []*Unit) *Unit {
+	return units[min(int(sim.RandomFloat("Scripted Ability")*float64(len(units))), len(units)-1)]
+}
+
+// Returns the first target and up to numTargets-1 other random players.
+func chainTargets(sim *Simulation, first *Unit, numTargets int) []*Unit {
+	targets := []*Unit{first}
+	others := slices.DeleteFunc(slices.Clone(sim.Raid.AllPlayerUnits), func(unit *Unit) bool {
+		return unit == first
+	})
+	for len(targets) < numTargets && len(others) > 0 {
+		next := randomUnit(sim, others)
+		targets = append(targets, next)
+		others = slices.DeleteFunc(others, func(unit *Unit) bool {
+			return unit == next
+		})
+	}
+	return targets
+}
+
+func (ability *ScriptedAbility) hit(sim *Simulation, target *Unit, spell *Spell, dealsDamage bool, minDamage float64, maxDamage float64) {
+	outcome := spell.OutcomeMagicHit
+	if spell.SpellSchool == SpellSchoolPhysical {
+		outcome = spell.OutcomeEnemyMeleeWhite
+	}
+
+	var result *SpellResult
+	if dealsDamage {
+		result = spell.CalcAndDealDamage(sim, target, sim.Roll(minDamage, maxDamage), outcome)
+	} else {
+		result = spell.CalcAndDealOutcome(sim, target, outcome)
+	}
+
+	if ability.Debuffs != nil && result.Landed() {
+		debuff := ability.Debuffs.Get(target)
+		debuff.Activate(sim)
+		debuff.AddStack(sim)
+	}
+}
+
+func (ability *ScriptedAbility) makeDebuffs(target *Target, config *proto.ScriptedDebuff) AuraArray {
+	label := config.Name
+	if label == "" {
+		label = ability.Config.Name
+	}
+	// Several targets can use the same script, so keep their debuffs apart.
+	label += "-" + strconv.Itoa(int(target.UnitIndex))
+
+	maxStacks := max(config.MaxStacks, 1)
+	tickInterval := DurationFromSeconds(config.TickInterval)
+	duration := DurationFromSeconds(config.Duration)
+	if duration <= 0 {
+		duration = NeverExpires
+	}
+
+	debuffs := make(AuraArray, len(target.Env.AllUnits))
+	for _, unit := range target.Env.Raid.AllUnits {
+		var tickAction *PendingAction
+
+		debuffs[unit.UnitIndex] = unit.GetOrRegisterAura(Aura{
+			ActionID:  ActionID{SpellID: ability.Config.SpellId},
+			Label:     label,
+			Duration:  duration,
+			MaxStacks: maxStacks,
+			OnGain: func(aura *Aura, sim *Simulation) {
+				if config.TickDamage <= 0 || tickInterval <= 0 {
+					return
+				}
+				tickAction = StartPeriodicAction(sim, PeriodicActionOptions{
+					Period: tickInterval,
+					OnAction: func(sim *Simulation) {
+						damage := config.TickDamage * float64(aura.GetStacks())
+						// Ticks have no Dot of their own, so they can't use CalcPeriodicDamage.
+						result := ability.Spell.CalcDamage(sim, aura.Unit, damage, ability.Spell.OutcomeAlwaysHit)
+						ability.Spell.DealPeriodicDamage(sim, result)
+					},
+				})
+			},
+			OnExpire: func(aura *Aura, sim *Simulation) {
+				if tickAction != nil {
+					tickAction.Cancel(sim)
+					tickAction = nil
+				}
+			},
+			OnStacksChange: func(aura *Aura, sim *Simulation, oldStacks int32, newStacks int32) {
+				delta := float64(newStacks - oldStacks)
+				if config.ArmorReduction != 0 {
+					aura.Unit.AddStatDynamic(sim, stats.Armor, -config.ArmorReduction*delta)
+				}
+				if config.DamageTakenMultiplier != 0 {
+					for i := oldStacks; i < newStacks; i++ {
+						aura.Unit.PseudoStats.DamageTakenMultiplier *= config.DamageTakenMultiplier
+					}
+					for i := newStacks; i < oldStacks; i++ {
+						aura.Unit.PseudoStats.DamageTakenMultiplier /= config.DamageTakenMultiplier
+					}
+				}
+			},
+		})
+	}
+
+	return debuffs
+}
+
+// NewScriptedBuff registers a buff that changes the target's damage and attack
+// speed, e.g. an enrage.
+func NewScriptedBuff(target *Target, actionID ActionID, label string, config *proto.ScriptedBuff) *Aura {
+	duration := DurationFromSeconds(config.Duration)
+	if duration <= 0 {
+		duration = NeverExpires
+	}
+	damageMultiplier := config.DamageMultiplier
+	if damageMultiplier == 0 {
+		damageMultiplier = 1
+	}
+	attackSpeedMultiplier := config.AttackSpeedMultiplier
+	if attackSpeedMultiplier == 0 {
+		attackSpeedMultiplier = 1
+	}
+
+	return target.GetOrRegisterAura(Aura{
+		ActionID: actionID,
+		Label:    label,
+		Duration: duration,
+		OnGain: func(aura *Aura, sim *Simulation) {
+			aura.Unit.PseudoStats.DamageDealtMultiplier *= damageMultiplier
+			aura.Unit.MultiplyAttackSpeed(sim, attackSpeedMultiplier)
+		},
+		OnExpire: func(aura *Aura, sim *Simulation) {
+			aura.Unit.PseudoStats.DamageDealtMultiplier /= damageMultiplier
+			aura.Unit.MultiplyAttackSpeed(sim, 1/attackSpeedMultiplier)
+		},
+	})
+}
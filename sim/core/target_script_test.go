@@ -0,0 +1,227 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+func setupScriptedSim(numPlayers int, abilities []*proto.ScriptedAbility, phases ...*proto.EncounterPhase) *Simulation {
+	players := make([]*proto.Player, numPlayers)
+	for i := range players {
+		players[i] = &proto.Player{
+			Name:      "Caster " + strconv.Itoa(i+1),
+			Class:     proto.Class_ClassShaman,
+			Consumes:  &proto.Consumes{},
+			Buffs:     &proto.IndividualBuffs{},
+			Spec:      &proto.Player_ElementalShaman{},
+			Equipment: &proto.EquipmentSpec{},
+		}
+	}
+
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 100,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: players,
+					Buffs:   &proto.PartyBuffs{},
+				},
+			},
+		},
+		Encounter: &proto.Encounter{
+			Targets: []*proto.Target{
+				{
+					Name:   "Scripted Boss",
+					Level:  60,
+					Stats:  stats.Stats{stats.Health: 1_000_000}.ToFloatArray(),
+					Script: &proto.TargetScript{Abilities: abilities},
+				},
+			},
+			Duration: 180,
+			Phases:   phases,
+		},
+	})
+	sim.Reset()
+
+	return sim
+}
+
+func TestScriptedTargetAI(t *testing.T) {
+	sim := setupScriptedSim(1, []*proto.ScriptedAbility{
+		{
+			Name:            "Enrage",
+			Targeting:       proto.ScriptedAbility_TargetingSelf,
+			InitialCooldown: 10,
+			Cooldown:        1000,
+			Buff:            &proto.ScriptedBuff{DamageMultiplier: 1.5},
+		},
+		{
+			Name:      "Corrosion",
+			School:    proto.SpellSchool_SpellSchoolNature,
+			Targeting: proto.ScriptedAbility_TargetingAllPlayers,
+			Cooldown:  1,
+			Debuff: &proto.ScriptedDebuff{
+				Duration:       30,
+				MaxStacks:      3,
+				ArmorReduction: 100,
+				TickDamage:     10,
+				TickInterval:   1,
+			},
+		},
+	})
+	target := sim.Encounter.Targets[0]
+	player := sim.Raid.AllPlayerUnits[0]
+
+	ai, ok := target.AI.(*scriptedAI)
+	if !ok {
+		t.Fatalf("Expected targets with a script to use the scripted AI")
+	}
+	enrage, corrosion := ai.abilities[0], ai.abilities[1]
+	debuff := corrosion.Debuffs.Get(player)
+	baseArmor := player.GetStat(stats.Armor)
+
+	for sim.CurrentTime < time.Second*5 {
+		sim.Step()
+	}
+	if enrage.Buff.IsActive() {
+		t.Fatalf("Expected the enrage to wait for its initial cooldown")
+	}
+	if debuff.GetStacks() == 0 {
+		t.Fatalf("Expected the debuff to be applied")
+	}
+	if corrosion.Spell.SpellMetrics[player.UnitIndex].TotalDamage <= 0 {
+		t.Fatalf("Expected the debuff to deal periodic damage")
+	}
+	if armor := player.GetStat(stats.Armor); armor != baseArmor-100*float64(debuff.GetStacks()) {
+		t.Fatalf("Expected %d stacks to reduce armor by %d, got %0.0f", debuff.GetStacks(), 100*debuff.GetStacks(), baseArmor-armor)
+	}
+
+	for sim.CurrentTime <= time.Second*10 {
+		sim.Step()
+	}
+	if !enrage.Buff.IsActive() {
+		t.Fatalf("Expected the enrage after its initial cooldown")
+	}
+	if target.PseudoStats.DamageDealtMultiplier != 1.5 {
+		t.Fatalf("Expected the enrage to increase damage dealt, got %0.2f", target.PseudoStats.DamageDealtMultiplier)
+	}
+	if debuff.GetStacks() != 3 {
+		t.Fatalf("Expected the debuff to stack up to 3 times, got %d", debuff.GetStacks())
+	}
+}
+
+func TestScriptedChainAbility(t *testing.T) {
+	sim := setupScriptedSim(5, []*proto.ScriptedAbility{
+		{
+			Name:         "Chain Bolt",
+			School:       proto.SpellSchool_SpellSchoolNature,
+			MinDamage:    100,
+			Targeting:    proto.ScriptedAbility_TargetingChain,
+			ChainTargets: 3,
+			Cooldown:     1000,
+		},
+	})
+	bolt := sim.Encounter.Targets[0].AI.(*scriptedAI).abilities[0]
+
+	for sim.CurrentTime < time.Second {
+		sim.Step()
+	}
+
+	playersHit := 0
+	for _, player := range sim.Raid.AllPlayerUnits {
+		if bolt.Spell.SpellMetrics[player.UnitIndex].Hits+bolt.Spell.SpellMetrics[player.UnitIndex].Misses > 0 {
+			playersHit++
+		}
+	}
+	if playersHit != 3 {
+		t.Fatalf("Expected the chain to hit 3 players, got %d", playersHit)
+	}
+}
+
+func TestScriptedAbilityBelowHealthPercent(t *testing.T) {
+	sim := setupScriptedSim(1, []*proto.ScriptedAbility{
+		{
+			Name:               "Enrage",
+			Targeting:          proto.ScriptedAbility_TargetingSelf,
+			Cooldown:           1000,
+			BelowHealthPercent: 0.2,
+			Buff:               &proto.ScriptedBuff{DamageMultiplier: 1.5},
+		},
+	})
+	target := sim.Encounter.Targets[0]
+	enrage := target.AI.(*scriptedAI).abilities[0]
+
+	// Most of the fight passes, but the boss hasn't taken any damage.
+	for sim.CurrentTime < time.Second*170 {
+		sim.Step()
+	}
+	if enrage.Buff.IsActive() {
+		t.Fatalf("Expected the enrage to wait for the target's health, not the fight's duration")
+	}
+
+	target.damageTaken = 850_000
+	for sim.CurrentTime < time.Second*175 {
+		sim.Step()
+	}
+	if !enrage.Buff.IsActive() {
+		t.Fatalf("Expected the enrage below 20%% health, at %0.2f", target.HealthPercent(sim))
+	}
+}
+
+func TestScriptedAbilityCastTime(t *testing.T) {
+	sim := setupScriptedSim(1, []*proto.ScriptedAbility{
+		{
+			Name:      "Shadow Bolt",
+			School:    proto.SpellSchool_SpellSchoolShadow,
+			MinDamage: 100,
+			CastTime:  2,
+			Cooldown:  5,
+			Targeting: proto.ScriptedAbility_TargetingRandomPlayer,
+		},
+	})
+	bolt := sim.Encounter.Targets[0].AI.(*scriptedAI).abilities[0]
+	player := sim.Raid.AllPlayerUnits[0]
+
+	for sim.CurrentTime < time.Second*20 {
+		sim.Step()
+	}
+	if casts := bolt.Spell.SpellMetrics[player.UnitIndex].Casts; casts < 3 {
+		t.Fatalf("Expected hardcasts to complete and the ability to be recast, got %d casts", casts)
+	}
+}
+
+func TestScriptedAbilityPhases(t *testing.T) {
+	sim := setupScriptedSim(1, []*proto.ScriptedAbility{
+		{
+			Name:      "Enrage",
+			Targeting: proto.ScriptedAbility_TargetingSelf,
+			Cooldown:  1000,
+			Phases:    []int32{2},
+			Buff:      &proto.ScriptedBuff{DamageMultiplier: 1.5},
+		},
+	},
+		&proto.EncounterPhase{Name: "Phase 1"},
+		&proto.EncounterPhase{Name: "Phase 2", StartTime: 60},
+	)
+	enrage := sim.Encounter.Targets[0].AI.(*scriptedAI).abilities[0]
+
+	for sim.CurrentTime < time.Second*55 {
+		sim.Step()
+	}
+	if enrage.Buff.IsActive() {
+		t.Fatalf("Expected the enrage to wait for phase 2")
+	}
+
+	for sim.CurrentTime < time.Second*65 {
+		sim.Step()
+	}
+	if !enrage.Buff.IsActive() {
+		t.Fatalf("Expected the enrage in phase 2")
+	}
+}
@@ -118,6 +118,10 @@ type SettingsCombos struct {
 	SimOptions  *proto.SimOptions
 	IsHealer    bool
 	Cooldowns   *proto.Cooldowns
+
+	// Tanks are placed in front of the target, which attacks them.
+	IsTank       bool
+	HealingModel *proto.HealingModel
 }
 
 func (combos *SettingsCombos) NumTests() int {
@@ -196,6 +200,12 @@ func (combos *SettingsCombos) GetTest(testIdx int) (string, *proto.ComputeStatsR
 	if combos.IsHealer {
 		rsr.Raid.TargetDummies = 1
 	}
+	if combos.IsTank {
+		player := rsr.Raid.Parties[0].Players[0]
+		player.InFrontOfTarget = true
+		player.HealingModel = combos.HealingModel
+		rsr.Raid.Tanks = append(rsr.Raid.Tanks, &proto.UnitReference{Type: proto.UnitReference_Player, Index: 0})
+	}
 
 	return strings.Join(testNameParts, "-"), nil, nil, rsr
 }
@@ -410,6 +420,11 @@ type CharacterSuiteConfig struct {
 	IsTank          bool
 	InFrontOfTarget bool
 
+	// Heals tanks receive, used for their TMI and chance of death.
+	HealingModel *proto.HealingModel
+	// Damage taken by the raid, so healers have something to heal.
+	RaidDamage *proto.RaidDamageModel
+
 	OtherRaces       []proto.Race
 	OtherGearSets    []GearSetCombo
 	OtherSpecOptions []SpecOptionsCombo
@@ -450,6 +465,7 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 				Rotation:      config.Rotation.Rotation,
 
 				InFrontOfTarget:    config.InFrontOfTarget,
+				HealingModel:       config.HealingModel,
 				DistanceFromTarget: 5,
 				ReactionTimeMs:     150,
 				ChannelClipDelayMs: 50,
@@ -464,6 +480,16 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 			defaultRaid.TargetDummies = 1
 		}
 
+		encounters := MakeDefaultEncounterCombos(config.Level)
+		for _, encounter := range encounters {
+			encounter.Encounter.RaidDamage = config.RaidDamage
+		}
+		makeSingleTargetEncounter := func(variation float64) *proto.Encounter {
+			encounter := MakeSingleTargetEncounter(config.Level, variation)
+			encounter.RaidDamage = config.RaidDamage
+			return encounter
+		}
+
 		// Ensure we don't generate tests where the agent equips items above its level
 		// This previously caused bugs with effects with a specified minimum level above the agent's level
 		config.ItemFilter.Level = config.Level
@@ -503,9 +529,12 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 							},
 						},
 						IsHealer:   config.IsHealer,
-						Encounters: MakeDefaultEncounterCombos(config.Level),
+						Encounters: encounters,
 						SimOptions: DefaultSimTestOptions,
 						Cooldowns:  config.Cooldowns,
+
+						IsTank:       config.IsTank,
+						HealingModel: config.HealingModel,
 					},
 				},
 				{
@@ -515,7 +544,7 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 						RaidBuffs:  config.Buffs.Raid,
 						PartyBuffs: config.Buffs.Party,
 						Debuffs:    config.Buffs.Debuffs,
-						Encounter:  MakeSingleTargetEncounter(config.Level, 0),
+						Encounter:  makeSingleTargetEncounter(0),
 						SimOptions: DefaultSimTestOptions,
 						ItemFilter: config.ItemFilter,
 						IsHealer:   config.IsHealer,
@@ -533,7 +562,7 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 				Name: "Default",
 				Request: &proto.RaidSimRequest{
 					Raid:       newRaid,
-					Encounter:  MakeSingleTargetEncounter(config.Level, 0),
+					Encounter:  makeSingleTargetEncounter(0),
 					SimOptions: DefaultSimTestOptions,
 				},
 			},
@@ -549,7 +578,7 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 						RaidBuffs:  config.Buffs.Raid,
 						PartyBuffs: config.Buffs.Party,
 						Debuffs:    config.Buffs.Debuffs,
-						Encounter:  MakeSingleTargetEncounter(config.Level, 0),
+						Encounter:  makeSingleTargetEncounter(0),
 						SimOptions: StatWeightsDefaultSimTestOptions,
 						Tanks:      defaultRaid.Tanks,
 
@@ -568,7 +597,7 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 				Name: "Default",
 				Request: &proto.RaidSimRequest{
 					Raid:       defaultRaid,
-					Encounter:  MakeSingleTargetEncounter(config.Level, 5),
+					Encounter:  makeSingleTargetEncounter(5),
 					SimOptions: AverageDefaultSimTestOptions,
 				},
 			},
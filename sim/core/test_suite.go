@@ -71,6 +71,9 @@ func (testSuite *IndividualTestSuite) TestDPS(testName string, rsr *proto.RaidSi
 		Tps:  toFixed(result.RaidMetrics.Parties[0].Players[0].Threat.Avg, storagePrecision),
 		Dtps: toFixed(result.RaidMetrics.Parties[0].Players[0].Dtps.Avg, storagePrecision),
 		Hps:  toFixed(result.RaidMetrics.Parties[0].Players[0].Hps.Avg, storagePrecision),
+		Tmi:  toFixed(result.RaidMetrics.Parties[0].Players[0].Tmi.Avg, storagePrecision),
+
+		ChanceOfDeath: toFixed(result.RaidMetrics.Parties[0].Players[0].ChanceOfDeath, storagePrecision),
 	}
 }
 
@@ -247,6 +250,14 @@ func RunTestSuite(t *testing.T, suiteName string, generators []TestGenerator) {
 								t.Logf("DTPS expected %0.03f but was %0.03f!.", expectedDpsResult.Dtps, actualDpsResult.Dtps)
 								t.Fail()
 							}
+							if actualDpsResult.Tmi < expectedDpsResult.Tmi-tolerance || actualDpsResult.Tmi > expectedDpsResult.Tmi+tolerance {
+								t.Logf("TMI expected %0.03f but was %0.03f!.", expectedDpsResult.Tmi, actualDpsResult.Tmi)
+								t.Fail()
+							}
+							if actualDpsResult.ChanceOfDeath < expectedDpsResult.ChanceOfDeath-tolerance || actualDpsResult.ChanceOfDeath > expectedDpsResult.ChanceOfDeath+tolerance {
+								t.Logf("Chance of death expected %0.03f but was %0.03f!.", expectedDpsResult.ChanceOfDeath, actualDpsResult.ChanceOfDeath)
+								t.Fail()
+							}
 						} else {
 							t.Logf("Unexpected test %s with %0.03f DPS!", fullTestName, actualDpsResult.Dps)
 							t.Fail()
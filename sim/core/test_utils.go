@@ -1,7 +1,9 @@
 package core
 
 import (
+	"context"
 	"log"
+	"math"
 	"os"
 	"testing"
 
@@ -525,3 +527,80 @@ func GetGearSet(dir string, file string) GearSetCombo {
 
 	return GearSetCombo{Label: file, GearSet: EquipmentSpecFromJsonString(string(data))}
 }
+
+// Number of iterations run before measuring single iterations, so that pools
+// and lazily sized buffers have reached their steady state.
+const iterationWarmup = 20
+
+// Returns a function which runs the next iteration of rsr on the same
+// Simulation each time it's called.
+func newIterationRunner(rsr *proto.RaidSimRequest) func() {
+	sim := NewSim(rsr)
+	sim.runPresims(context.Background(), rsr)
+
+	var i int64
+	runIteration := func() {
+		i++
+		sim.reseedRands(i)
+		sim.runOnce()
+	}
+	for j := 0; j < iterationWarmup; j++ {
+		runIteration()
+	}
+	return runIteration
+}
+
+// Benchmarks single iterations of rsr, excluding the sim's setup.
+func IterationBenchmark(b *testing.B, rsr *proto.RaidSimRequest) {
+	runIteration := newIterationRunner(rsr)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runIteration()
+	}
+}
+
+// Fails if iterations of rsr allocate once the sim has warmed up.
+func IterationAllocsTest(t *testing.T, rsr *proto.RaidSimRequest) {
+	runIteration := newIterationRunner(rsr)
+
+	if allocs := testing.AllocsPerRun(100, runIteration); allocs > 0 {
+		t.Fatalf("Expected no allocations per iteration, got %0.1f", allocs)
+	}
+}
+
+// Fails if running rsr in shards gives different results than running all of its
+// iterations serially on one Simulation, within the tolerance of the golden tests.
+func ShardedResultsTest(t *testing.T, rsr *proto.RaidSimRequest) {
+	if rsr.SimOptions.IsTest || rsr.SimOptions.Iterations <= iterationsPerShard {
+		t.Fatalf("Request must have more than %d iterations and IsTest unset to be sharded", iterationsPerShard)
+	}
+
+	sharded := RunSim(context.Background(), googleProto.Clone(rsr).(*proto.RaidSimRequest), nil)
+	serial := RunSim(withoutShards(context.Background()), googleProto.Clone(rsr).(*proto.RaidSimRequest), nil)
+	if sharded.ErrorResult != "" || serial.ErrorResult != "" {
+		t.Fatalf("Sim failed: %s%s", sharded.ErrorResult, serial.ErrorResult)
+	}
+	if sharded.CompletedIterations != serial.CompletedIterations {
+		t.Fatalf("Expected %d iterations, got %d", serial.CompletedIterations, sharded.CompletedIterations)
+	}
+
+	compare := func(name string, expected, actual *proto.DistributionMetrics) {
+		if math.Abs(actual.Avg-expected.Avg) > tolerance || math.Abs(actual.Stdev-expected.Stdev) > tolerance {
+			t.Errorf("Sharded %s %0.5f (stdev %0.5f) differs from serial %0.5f (stdev %0.5f)", name, actual.Avg, actual.Stdev, expected.Avg, expected.Stdev)
+		}
+	}
+	for i, party := range serial.RaidMetrics.Parties {
+		for j, player := range party.Players {
+			shardedPlayer := sharded.RaidMetrics.Parties[i].Players[j]
+			compare(player.Name+" DPS", player.Dps, shardedPlayer.Dps)
+			compare(player.Name+" HPS", player.Hps, shardedPlayer.Hps)
+			compare(player.Name+" TPS", player.Threat, shardedPlayer.Threat)
+			compare(player.Name+" DTPS", player.Dtps, shardedPlayer.Dtps)
+		}
+	}
+	for i, target := range serial.EncounterMetrics.Targets {
+		compare(target.Name+" DPS", target.Dps, sharded.EncounterMetrics.Targets[i].Dps)
+	}
+}
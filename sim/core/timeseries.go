@@ -0,0 +1,283 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Metrics binned over the course of the fight, see SimOptions.timeseries.
+type timeseriesMetrics struct {
+	binWidth time.Duration
+
+	// Number of iterations which lasted into each bin, and number of samples
+	// taken in each bin.
+	iterations []int32
+	samples    []int32
+
+	executePhase35 []float64
+	executePhase25 []float64
+	executePhase20 []float64
+
+	// Indexed by UnitIndex.
+	units []*unitTimeseries
+
+	sampleAction *PendingAction
+}
+
+type unitTimeseries struct {
+	damage []float64
+
+	actionIDs     []ActionID
+	actionDamage  map[ActionID][]float64
+	resourceTypes []proto.ResourceType
+	resources     map[proto.ResourceType][]float64
+
+	// Indexed like the unit's auras.
+	auraUptimes [][]float64
+}
+
+func newTimeseriesMetrics(env *Environment, options *proto.TimeseriesOptions) *timeseriesMetrics {
+	if options.GetBinWidth() <= 0 {
+		return nil
+	}
+
+	tm := &timeseriesMetrics{
+		binWidth: DurationFromSeconds(options.BinWidth),
+		units:    make([]*unitTimeseries, len(env.AllUnits)),
+	}
+	for i := range tm.units {
+		tm.units[i] = &unitTimeseries{
+			actionDamage: make(map[ActionID][]float64),
+			resources:    make(map[proto.ResourceType][]float64),
+		}
+	}
+	return tm
+}
+
+// Adds value to the given bin, growing the series as needed.
+func addToBin(series []float64, bin int, value float64) []float64 {
+	for len(series) <= bin {
+		series = append(series, 0)
+	}
+	series[bin] += value
+	return series
+}
+
+// Events at the very end of the fight still count towards the last bin.
+func (tm *timeseriesMetrics) bin(sim *Simulation) int {
+	return int(max(min(sim.CurrentTime, sim.Duration-1), 0) / tm.binWidth)
+}
+
+// Schedules the samples of the next iteration.
+func (tm *timeseriesMetrics) reset(sim *Simulation) {
+	if tm.sampleAction == nil {
+		pa := &PendingAction{
+			Priority: ActionPriorityLow,
+		}
+		pa.OnAction = func(sim *Simulation) {
+			tm.sample(sim)
+			pa.NextActionAt += tm.binWidth
+			sim.AddPendingAction(pa)
+		}
+		tm.sampleAction = pa
+	}
+
+	tm.sampleAction.NextActionAt = tm.binWidth / 2
+	sim.AddPendingAction(tm.sampleAction)
+}
+
+func (tm *timeseriesMetrics) addDamage(sim *Simulation, spell *Spell, damage float64) {
+	bin := tm.bin(sim)
+	ut := tm.units[spell.Unit.UnitIndex]
+	ut.damage = addToBin(ut.damage, bin, damage)
+
+	series, ok := ut.actionDamage[spell.ActionID]
+	if !ok {
+		ut.actionIDs = append(ut.actionIDs, spell.ActionID)
+	}
+	ut.actionDamage[spell.ActionID] = addToBin(series, bin, damage)
+}
+
+func (tm *timeseriesMetrics) sample(sim *Simulation) {
+	bin := tm.bin(sim)
+	for len(tm.samples) <= bin {
+		tm.samples = append(tm.samples, 0)
+	}
+	tm.samples[bin]++
+
+	if sim.IsExecutePhase35() {
+		tm.executePhase35 = addToBin(tm.executePhase35, bin, 1)
+	}
+	if sim.IsExecutePhase25() {
+		tm.executePhase25 = addToBin(tm.executePhase25, bin, 1)
+	}
+	if sim.IsExecutePhase20() {
+		tm.executePhase20 = addToBin(tm.executePhase20, bin, 1)
+	}
+
+	for _, unit := range sim.AllUnits {
+		ut := tm.units[unit.UnitIndex]
+
+		if unit.HasManaBar() {
+			ut.addResource(proto.ResourceType_ResourceTypeMana, bin, unit.CurrentMana())
+		}
+		if unit.HasRageBar() {
+			ut.addResource(proto.ResourceType_ResourceTypeRage, bin, unit.CurrentRage())
+		}
+		if unit.HasEnergyBar() {
+			ut.addResource(proto.ResourceType_ResourceTypeEnergy, bin, unit.CurrentEnergy())
+		}
+		if unit.HasFocusBar() {
+			ut.addResource(proto.ResourceType_ResourceTypeFocus, bin, unit.CurrentFocus())
+		}
+
+		for len(ut.auraUptimes) < len(unit.auras) {
+			ut.auraUptimes = append(ut.auraUptimes, nil)
+		}
+		for i, aura := range unit.auras {
+			if aura.IsActive() {
+				ut.auraUptimes[i] = addToBin(ut.auraUptimes[i], bin, 1)
+			}
+		}
+	}
+}
+
+func (ut *unitTimeseries) addResource(resourceType proto.ResourceType, bin int, value float64) {
+	series, ok := ut.resources[resourceType]
+	if !ok {
+		ut.resourceTypes = append(ut.resourceTypes, resourceType)
+	}
+	ut.resources[resourceType] = addToBin(series, bin, value)
+}
+
+func (tm *timeseriesMetrics) doneIteration(sim *Simulation) {
+	numBins := int((sim.Duration + tm.binWidth - 1) / tm.binWidth)
+	for len(tm.iterations) < numBins {
+		tm.iterations = append(tm.iterations, 0)
+	}
+	for i := 0; i < numBins; i++ {
+		tm.iterations[i]++
+	}
+}
+
+// Merges in the metrics of the same sim from another Simulation.
+func (tm *timeseriesMetrics) merge(other *timeseriesMetrics) {
+	mergeSeries := func(series []float64, otherSeries []float64) []float64 {
+		for bin, value := range otherSeries {
+			series = addToBin(series, bin, value)
+		}
+		return series
+	}
+	mergeCounts := func(counts []int32, otherCounts []int32) []int32 {
+		for len(counts) < len(otherCounts) {
+			counts = append(counts, 0)
+		}
+		for bin, count := range otherCounts {
+			counts[bin] += count
+		}
+		return counts
+	}
+
+	tm.iterations = mergeCounts(tm.iterations, other.iterations)
+	tm.samples = mergeCounts(tm.samples, other.samples)
+	tm.executePhase35 = mergeSeries(tm.executePhase35, other.executePhase35)
+	tm.executePhase25 = mergeSeries(tm.executePhase25, other.executePhase25)
+	tm.executePhase20 = mergeSeries(tm.executePhase20, other.executePhase20)
+
+	for i, ut := range tm.units {
+		otherUt := other.units[i]
+		ut.damage = mergeSeries(ut.damage, otherUt.damage)
+
+		for _, actionID := range otherUt.actionIDs {
+			series, ok := ut.actionDamage[actionID]
+			if !ok {
+				ut.actionIDs = append(ut.actionIDs, actionID)
+			}
+			ut.actionDamage[actionID] = mergeSeries(series, otherUt.actionDamage[actionID])
+		}
+		for _, resourceType := range otherUt.resourceTypes {
+			series, ok := ut.resources[resourceType]
+			if !ok {
+				ut.resourceTypes = append(ut.resourceTypes, resourceType)
+			}
+			ut.resources[resourceType] = mergeSeries(series, otherUt.resources[resourceType])
+		}
+
+		for len(ut.auraUptimes) < len(otherUt.auraUptimes) {
+			ut.auraUptimes = append(ut.auraUptimes, nil)
+		}
+		for j, uptimes := range otherUt.auraUptimes {
+			ut.auraUptimes[j] = mergeSeries(ut.auraUptimes[j], uptimes)
+		}
+	}
+}
+
+func (tm *timeseriesMetrics) ToProto(env *Environment) *proto.Timeseries {
+	if tm == nil {
+		return nil
+	}
+
+	// Sums of damage are averaged over the iterations which lasted into each bin,
+	// sums of samples over the number of samples.
+	perSecond := func(series []float64) []float64 {
+		averaged := make([]float64, len(tm.iterations))
+		for bin := range averaged {
+			if bin < len(series) && tm.iterations[bin] > 0 {
+				averaged[bin] = series[bin] / float64(tm.iterations[bin]) / tm.binWidth.Seconds()
+			}
+		}
+		return averaged
+	}
+	perSample := func(series []float64) []float64 {
+		averaged := make([]float64, len(tm.iterations))
+		for bin := range averaged {
+			if bin < len(series) && bin < len(tm.samples) && tm.samples[bin] > 0 {
+				averaged[bin] = series[bin] / float64(tm.samples[bin])
+			}
+		}
+		return averaged
+	}
+
+	timeseries := &proto.Timeseries{
+		BinWidth:        tm.binWidth.Seconds(),
+		ExecutePhase_35: perSample(tm.executePhase35),
+		ExecutePhase_25: perSample(tm.executePhase25),
+		ExecutePhase_20: perSample(tm.executePhase20),
+	}
+
+	for _, unit := range env.AllUnits {
+		ut := tm.units[unit.UnitIndex]
+		unitTimeseries := &proto.UnitTimeseries{
+			Unit: unit.unitReference(),
+			Name: unit.Label,
+			Dps:  perSecond(ut.damage),
+		}
+
+		for _, actionID := range ut.actionIDs {
+			unitTimeseries.Actions = append(unitTimeseries.Actions, &proto.ActionTimeseries{
+				Id:  actionID.ToProto(),
+				Dps: perSecond(ut.actionDamage[actionID]),
+			})
+		}
+		for _, resourceType := range ut.resourceTypes {
+			unitTimeseries.Resources = append(unitTimeseries.Resources, &proto.ResourceTimeseries{
+				Type:   resourceType,
+				Values: perSample(ut.resources[resourceType]),
+			})
+		}
+		for i, uptimes := range ut.auraUptimes {
+			if len(uptimes) == 0 || unit.auras[i].ActionID.IsEmptyAction() {
+				continue
+			}
+			unitTimeseries.Auras = append(unitTimeseries.Auras, &proto.AuraTimeseries{
+				Id:     unit.auras[i].ActionID.ToProto(),
+				Uptime: perSample(uptimes),
+			})
+		}
+
+		timeseries.Units = append(timeseries.Units, unitTimeseries)
+	}
+
+	return timeseries
+}
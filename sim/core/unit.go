@@ -134,6 +134,9 @@ type Unit struct {
 	AttackTables                []map[proto.CastType]*AttackTable
 	DynamicDamageTakenModifiers []DynamicDamageTakenModifier
 
+	// Absorption effects that can be placed on this unit.
+	shields []*Shield
+
 	GCD *Timer
 
 	// Used for applying the effect of a hardcast spell when casting finishes.
@@ -161,7 +164,7 @@ type Unit struct {
 
 // Units can be disabled for several reasons:
 //  1. Downtime for temporary pets (e.g. Water Elemental)
-//  2. Enemy units in various phases (not yet implemented)
+//  2. Enemy units in various phases
 //  3. Dead units (not yet implemented)
 func (unit *Unit) IsEnabled() bool {
 	return unit.enabled
@@ -191,6 +194,13 @@ func (unit *Unit) Log(sim *Simulation, message string, vals ...interface{}) {
 	sim.Log(unit.LogLabel()+" "+message, vals...)
 }
 
+func (unit *Unit) changeTarget(sim *Simulation, newTarget *Unit) {
+	if sim.Log != nil {
+		sim.logUnitEvent(proto.CombatEvent_TypeTargetChanged, unit, newTarget)
+	}
+	unit.CurrentTarget = newTarget
+}
+
 func (unit *Unit) GetInitialStat(stat stats.Stat) float64 {
 	return unit.initialStats[stat]
 }
@@ -192,3 +192,24 @@ func (x *aggregator) meanAndStdDev() (float64, float64) {
 	stdDev := math.Sqrt(x.sumSq/float64(x.n) - mean*mean)
 	return mean, stdDev
 }
+
+// Standard error of the mean.
+func (x *aggregator) stdError() float64 {
+	n := float64(x.n)
+	mean := x.sum / n
+	// Rounding errors can make this slightly negative when all values are the same.
+	variance := max(0, x.sumSq/n-mean*mean)
+	return math.Sqrt(variance / n)
+}
+
+// 95% confidence interval for the mean.
+func (x *aggregator) confidenceInterval() *proto.ConfidenceInterval {
+	mean := x.sum / float64(x.n)
+	stdError := x.stdError()
+	return &proto.ConfidenceInterval{
+		Mean:     mean,
+		StdError: stdError,
+		Lower:    mean - 1.96*stdError,
+		Upper:    mean + 1.96*stdError,
+	}
+}
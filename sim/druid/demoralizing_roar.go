@@ -1,18 +1,23 @@
 package druid
 
 import (
-	"time"
-
 	"github.com/wowsims/sod/sim/core"
 )
 
 func (druid *Druid) registerDemoralizingRoarSpell() {
-	druid.DemoralizingRoarAuras = druid.NewEnemyAuraArray(func(target *core.Unit) *core.Aura {
-		return core.DemoralizingRoarAura(target, druid.Talents.FeralAggression)
+	actionID := core.ActionID{SpellID: map[int32]int32{
+		25: 1735,
+		40: 9490,
+		50: 9747,
+		60: 9898,
+	}[druid.Level]}
+
+	druid.DemoralizingRoarAuras = druid.NewEnemyAuraArray(func(target *core.Unit, level int32) *core.Aura {
+		return core.DemoralizingRoarAura(target, druid.Talents.FeralAggression, level)
 	})
 
 	druid.DemoralizingRoar = druid.RegisterSpell(Bear, core.SpellConfig{
-		ActionID:    core.ActionID{SpellID: 48560},
+		ActionID:    actionID,
 		SpellSchool: core.SpellSchoolPhysical,
 		ProcMask:    core.ProcMaskEmpty,
 		Flags:       SpellFlagOmen | core.SpellFlagAPL,
@@ -28,10 +33,9 @@ func (druid *Druid) registerDemoralizingRoarSpell() {
 		},
 
 		ThreatMultiplier: 1,
-		FlatThreatBonus:  62 * 2,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			for _, aoeTarget := range sim.Encounter.TargetUnits {
+			for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 				result := spell.CalcAndDealOutcome(sim, aoeTarget, spell.OutcomeMagicHit)
 				if result.Landed() {
 					druid.DemoralizingRoarAuras.Get(aoeTarget).Activate(sim)
@@ -42,22 +46,3 @@ func (druid *Druid) registerDemoralizingRoarSpell() {
 		RelatedAuras: []core.AuraArray{druid.DemoralizingRoarAuras},
 	})
 }
-
-func (druid *Druid) ShouldDemoralizingRoar(sim *core.Simulation, filler bool, maintainOnly bool) bool {
-	if !druid.DemoralizingRoar.CanCast(sim, druid.CurrentTarget) {
-		return false
-	}
-
-	if filler {
-		return true
-	}
-
-	refreshWindow := time.Second * 2
-
-	if (druid.MangleBear != nil) && (!druid.MangleBear.IsReady(sim)) {
-		refreshWindow = druid.MangleBear.ReadyAt() - sim.CurrentTime + core.GCDDefault
-	}
-
-	return maintainOnly &&
-		druid.DemoralizingRoarAuras.Get(druid.CurrentTarget).ShouldRefreshExclusiveEffects(sim, refreshWindow)
-}
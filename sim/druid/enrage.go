@@ -7,35 +7,29 @@ import (
 	"github.com/wowsims/sod/sim/core/stats"
 )
 
+// https://www.wowhead.com/classic/spell=5229/enrage
+// Generates 20 rage, and then generates an additional 10 rage over 10 sec, but reduces base armor by 27% in Bear Form
+// and 16% in Dire Bear Form.
 func (druid *Druid) registerEnrageSpell() {
 	actionID := core.ActionID{SpellID: 5229}
 	rageMetrics := druid.NewRageMetrics(actionID)
 
-	instantRage := []float64{20, 24, 27, 30}[druid.Talents.Intensity]
+	instantRage := 20 + 5*float64(druid.Talents.ImprovedEnrage)
+	armorReduction := core.TernaryFloat64(druid.Level >= 40, 0.16, 0.27)
 
-	dmgBonus := 0.05 * float64(druid.Talents.KingOfTheJungle)
-
-	t10_4p := druid.HasSetBonus(ItemSetLasherweaveBattlegear, 4)
+	armorLost := 0.0
 
 	druid.EnrageAura = druid.RegisterAura(core.Aura{
 		Label:    "Enrage Aura",
 		ActionID: actionID,
 		Duration: 10 * time.Second,
 		OnGain: func(aura *core.Aura, sim *core.Simulation) {
-			druid.PseudoStats.DamageDealtMultiplier *= 1.0 + dmgBonus
-			if !t10_4p {
-				druid.ApplyDynamicEquipScaling(sim, stats.Armor, 0.84)
-			} else {
-				druid.PseudoStats.DamageTakenMultiplier *= 0.88
-			}
+			// Druids have no base armor besides what they get from agility.
+			armorLost = 2 * druid.GetStat(stats.Agility) * armorReduction
+			druid.AddStatDynamic(sim, stats.Armor, -armorLost)
 		},
 		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
-			druid.PseudoStats.DamageDealtMultiplier /= 1.0 + dmgBonus
-			if !t10_4p {
-				druid.RemoveDynamicEquipScaling(sim, stats.Armor, 0.84)
-			} else {
-				druid.PseudoStats.DamageTakenMultiplier /= 0.88
-			}
+			druid.AddStatDynamic(sim, stats.Armor, armorLost)
 		},
 	})
 
@@ -62,15 +62,17 @@ func (druid *Druid) GetCatWeapon(level int32) core.Weapon {
 	return claws
 }
 
-// Func (druid *Druid) GetBearWeapon() core.Weapon {
-// 	return core.Weapon{
-// 		BaseDamageMin:        109,
-// 		BaseDamageMax:        165,
-// 		SwingSpeed:           2.5,
-// 		NormalizedSwingSpeed: 2.5,
-// 		AttackPowerPerDPS:    core.DefaultAttackPowerPerDPS,
-// 	}
-// }
+// Bear paws swing slower than cat claws, but for the same DPS.
+func (druid *Druid) GetBearWeapon(level int32) core.Weapon {
+	claws := druid.GetCatWeapon(level)
+	return core.Weapon{
+		BaseDamageMin:        claws.BaseDamageMin * 2.5,
+		BaseDamageMax:        claws.BaseDamageMax * 2.5,
+		SwingSpeed:           2.5,
+		NormalizedSwingSpeed: 2.5,
+		AttackPowerPerDPS:    core.DefaultAttackPowerPerDPS,
+	}
+}
 
 // TODO: Class bonus stats for both cat and bear.
 func (druid *Druid) GetFormShiftStats() stats.Stats {
@@ -263,144 +265,147 @@ func (druid *Druid) registerCatFormSpell() {
 	})
 }
 
-// func (druid *Druid) registerBearFormSpell() {
-// 	actionID := core.ActionID{SpellID: 9634}
-// 	healthMetrics := druid.NewHealthMetrics(actionID)
-
-// 	statBonus := druid.GetFormShiftStats().Add(stats.Stats{
-// 		stats.AttackPower: 3 * float64(druid.Level),
-// 	})
-
-// 	stamDep := druid.NewDynamicMultiplyStat(stats.Stamina, 1.25)
-
-// 	var potpDep *stats.StatDependency
-// 	if druid.Talents.ProtectorOfThePack > 0 {
-// 		potpDep = druid.NewDynamicMultiplyStat(stats.AttackPower, 1.0+0.02*float64(druid.Talents.ProtectorOfThePack))
-// 	}
-
-// 	var hotwDep *stats.StatDependency
-// 	if druid.Talents.HeartOfTheWild > 0 {
-// 		hotwDep = druid.NewDynamicMultiplyStat(stats.Stamina, 1.0+0.02*float64(druid.Talents.HeartOfTheWild))
-// 	}
-
-// 	potpdtm := 1 - 0.04*float64(druid.Talents.ProtectorOfThePack)
-
-// 	clawWeapon := druid.GetBearWeapon()
-// 	predBonus := stats.Stats{}
-
-// 	druid.BearFormAura = druid.RegisterAura(core.Aura{
-// 		Label:      "Bear Form",
-// 		ActionID:   actionID,
-// 		Duration:   core.NeverExpires,
-// 		BuildPhase: core.Ternary(druid.StartingForm.Matches(Bear), core.CharacterBuildPhaseBase, core.CharacterBuildPhaseNone),
-// 		OnGain: func(aura *core.Aura, sim *core.Simulation) {
-// 			if !druid.Env.MeasuringStats && druid.form != Humanoid {
-// 				druid.CancelShapeshift(sim)
-// 			}
-// 			druid.form = Bear
-// 			druid.SetCurrentPowerBar(core.RageBar)
-
-// 			druid.AutoAttacks.SetMH(clawWeapon)
-
-// 			druid.PseudoStats.ThreatMultiplier *= 2.1021
-// 			druid.PseudoStats.SchoolDamageDealtMultiplier[stats.SchoolIndexPhysical] *= 1.0 + 0.02*float64(druid.Talents.MasterShapeshifter)
-// 			druid.PseudoStats.DamageTakenMultiplier *= potpdtm
-//			Switch to using AddStat as PseudoStat is being removed
-// 			druid.PseudoStats.BaseDodge += 0.02 * float64(druid.Talents.FeralSwiftness+druid.Talents.NaturalReaction)
-
-// 			predBonus = druid.GetDynamicPredStrikeStats()
-// 			druid.AddStatsDynamic(sim, predBonus)
-// 			druid.AddStatsDynamic(sim, statBonus)
-// 			druid.ApplyDynamicEquipScaling(sim, stats.Armor, druid.BearArmorMultiplier())
-// 			if potpDep != nil {
-// 				druid.EnableDynamicStatDep(sim, potpDep)
-// 			}
-
-// 			// Preserve fraction of max health when shifting
-// 			healthFrac := druid.CurrentHealth() / druid.MaxHealth()
-// 			druid.EnableDynamicStatDep(sim, stamDep)
-// 			if hotwDep != nil {
-// 				druid.EnableDynamicStatDep(sim, hotwDep)
-// 			}
-// 			druid.GainHealth(sim, healthFrac*druid.MaxHealth()-druid.CurrentHealth(), healthMetrics)
-
-// 			if !druid.Env.MeasuringStats {
-// 				druid.AutoAttacks.SetReplaceMHSwing(druid.ReplaceBearMHFunc)
-// 				druid.AutoAttacks.EnableAutoSwing(sim)
-
-// 				druid.manageCooldownsEnabled()
-// 				druid.UpdateManaRegenRates()
-// 			}
-// 		},
-// 		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
-// 			druid.form = Humanoid
-// 			druid.AutoAttacks.SetMH(druid.WeaponFromMainHand())
-
-// 			druid.PseudoStats.ThreatMultiplier /= 2.1021
-// 			druid.PseudoStats.SchoolDamageDealtMultiplier[stats.SchoolIndexPhysical] /= 1.0 + 0.02*float64(druid.Talents.MasterShapeshifter)
-// 			druid.PseudoStats.DamageTakenMultiplier /= potpdtm
-//			Switch to using AddStat as PseudoStat is being removed
-// 			druid.PseudoStats.BaseDodge -= 0.02 * float64(druid.Talents.FeralSwiftness+druid.Talents.NaturalReaction)
-
-// 			druid.AddStatsDynamic(sim, predBonus.Invert())
-// 			druid.AddStatsDynamic(sim, statBonus.Invert())
-// 			druid.RemoveDynamicEquipScaling(sim, stats.Armor, druid.BearArmorMultiplier())
-// 			if potpDep != nil {
-// 				druid.DisableDynamicStatDep(sim, potpDep)
-// 			}
-
-// 			healthFrac := druid.CurrentHealth() / druid.MaxHealth()
-// 			druid.DisableDynamicStatDep(sim, stamDep)
-// 			if hotwDep != nil {
-// 				druid.DisableDynamicStatDep(sim, hotwDep)
-// 			}
-// 			druid.RemoveHealth(sim, druid.CurrentHealth()-healthFrac*druid.MaxHealth())
-
-// 			if !druid.Env.MeasuringStats {
-// 				druid.AutoAttacks.SetReplaceMHSwing(nil)
-// 				druid.AutoAttacks.EnableAutoSwing(sim)
-
-// 				druid.manageCooldownsEnabled()
-// 				druid.UpdateManaRegenRates()
-// 				druid.EnrageAura.Deactivate(sim)
-// 				druid.MaulQueueAura.Deactivate(sim)
-// 			}
-// 		},
-// 	})
-
-// 	rageMetrics := druid.NewRageMetrics(actionID)
-
-// 	furorProcChance := []float64{0, 0.2, 0.4, 0.6, 0.8, 1}[druid.Talents.Furor]
-
-// 	druid.BearForm = druid.RegisterSpell(Any, core.SpellConfig{
-// 		ActionID: actionID,
-// 		Flags:    core.SpellFlagNoOnCastComplete | core.SpellFlagAPL,
-
-// 		ManaCost: core.ManaCostOptions{
-// 			BaseCost:   0.55,
-// 			Multiplier: (1 - 0.2*float64(druid.Talents.KingOfTheJungle)) * (1 - 0.1*float64(druid.Talents.NaturalShapeshifter)),
-// 		},
-// 		Cast: core.CastConfig{
-// 			DefaultCast: core.Cast{
-// 				GCD: core.GCDDefault,
-// 			},
-// 			IgnoreHaste: true,
-// 		},
-
-// 		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
-// 			rageDelta := 0 - druid.CurrentRage()
-// 			if sim.Proc(furorProcChance, "Furor") {
-// 				rageDelta += 10
-// 			}
-// 			if rageDelta > 0 {
-// 				druid.AddRage(sim, rageDelta, rageMetrics)
-// 			} else if rageDelta < 0 {
-// 				druid.SpendRage(sim, -rageDelta, rageMetrics)
-// 			}
-// 			druid.BearFormAura.Activate(sim)
-// 		},
-// 	})
-// }
+// https://www.wowhead.com/classic/spell=9634/dire-bear-form
+// Dire Bear Form replaces Bear Form at level 40, and also increases health by 1240.
+func (druid *Druid) registerBearFormSpell() {
+	isDireBear := druid.Level >= 40
+	actionID := core.ActionID{SpellID: core.TernaryInt32(isDireBear, 9634, 5487)}
+	healthMetrics := druid.NewHealthMetrics(actionID)
+
+	statBonus := druid.GetFormShiftStats().Add(stats.Stats{
+		stats.AttackPower: 3 * float64(druid.Level),
+		stats.Health:      core.TernaryFloat64(isDireBear, 1240, 0),
+	})
+
+	feralApDep := druid.NewDynamicStatDependency(stats.FeralAttackPower, stats.AttackPower, 1)
+
+	var hotwDep *stats.StatDependency
+	if druid.Talents.HeartOfTheWild > 0 {
+		hotwDep = druid.NewDynamicMultiplyStat(stats.Stamina, 1.0+0.04*float64(druid.Talents.HeartOfTheWild))
+	}
+
+	// Bear forms cause 30% more threat, and Feral Instinct adds to that.
+	threatMultiplier := 1.3 + 0.03*float64(druid.Talents.FeralInstinct)
+
+	armorMultiplier := druid.BearArmorMultiplier()
+	clawWeapon := druid.GetBearWeapon(druid.Level)
+	predBonus := stats.Stats{}
+
+	druid.BearFormAura = druid.RegisterAura(core.Aura{
+		Label:      "Bear Form",
+		ActionID:   actionID,
+		Duration:   core.NeverExpires,
+		BuildPhase: core.Ternary(druid.StartingForm.Matches(Bear), core.CharacterBuildPhaseBase, core.CharacterBuildPhaseNone),
+		OnGain: func(aura *core.Aura, sim *core.Simulation) {
+			if !druid.Env.MeasuringStats && druid.form != Humanoid {
+				druid.CancelShapeshift(sim)
+			}
+			druid.form = Bear
+			druid.SetCurrentPowerBar(core.RageBar)
+
+			druid.AutoAttacks.SetMH(clawWeapon)
+
+			druid.PseudoStats.ThreatMultiplier *= threatMultiplier
+			druid.SetShapeshift(aura)
+
+			// Preserve the fraction of max health when shifting
+			healthFrac := druid.CurrentHealth() / druid.MaxHealth()
+
+			predBonus = druid.GetDynamicPredStrikeStats()
+			druid.AddStatsDynamic(sim, predBonus)
+			druid.AddStatsDynamic(sim, statBonus)
+			druid.ApplyDynamicEquipScaling(sim, stats.Armor, armorMultiplier)
+			druid.EnableDynamicStatDep(sim, feralApDep)
+			if hotwDep != nil {
+				druid.EnableDynamicStatDep(sim, hotwDep)
+			}
+
+			if !druid.Env.MeasuringStats {
+				if healthGain := healthFrac*druid.MaxHealth() - druid.CurrentHealth(); healthGain > 0 {
+					druid.GainHealth(sim, healthGain, healthMetrics)
+				}
+
+				druid.AutoAttacks.SetReplaceMHSwing(druid.ReplaceBearMHFunc)
+				druid.AutoAttacks.EnableAutoSwing(sim)
+				druid.manageCooldownsEnabled()
+				druid.UpdateManaRegenRates()
+			}
+		},
+		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
+			druid.form = Humanoid
+			druid.SetCurrentPowerBar(core.ManaBar)
+
+			druid.AutoAttacks.SetMH(druid.WeaponFromMainHand())
+
+			druid.PseudoStats.ThreatMultiplier /= threatMultiplier
+			druid.SetShapeshift(nil)
+
+			healthFrac := druid.CurrentHealth() / druid.MaxHealth()
+
+			druid.AddStatsDynamic(sim, predBonus.Invert())
+			druid.AddStatsDynamic(sim, statBonus.Invert())
+			druid.RemoveDynamicEquipScaling(sim, stats.Armor, armorMultiplier)
+			druid.DisableDynamicStatDep(sim, feralApDep)
+			if hotwDep != nil {
+				druid.DisableDynamicStatDep(sim, hotwDep)
+			}
+
+			if !druid.Env.MeasuringStats {
+				if healthLoss := druid.CurrentHealth() - healthFrac*druid.MaxHealth(); healthLoss > 0 {
+					druid.RemoveHealth(sim, healthLoss)
+				}
+
+				druid.AutoAttacks.SetReplaceMHSwing(nil)
+				druid.AutoAttacks.EnableAutoSwing(sim)
+				druid.manageCooldownsEnabled()
+				druid.UpdateManaRegenRates()
+
+				if druid.EnrageAura != nil {
+					druid.EnrageAura.Deactivate(sim)
+				}
+				if druid.MaulQueueAura != nil {
+					druid.MaulQueueAura.Deactivate(sim)
+				}
+			}
+		},
+	})
+
+	rageMetrics := druid.NewRageMetrics(actionID)
+
+	furorProcChance := 0.2 * float64(druid.Talents.Furor)
+
+	druid.BearForm = druid.RegisterSpell(Any, core.SpellConfig{
+		ActionID: actionID,
+		Flags:    core.SpellFlagNoOnCastComplete | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCost:   0.55,
+			Multiplier: 1.0 - 0.1*float64(druid.Talents.NaturalShapeshifter),
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			IgnoreHaste: true,
+		},
+
+		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
+			return !druid.BearFormAura.IsActive()
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
+			// Shifting drops all rage, but Furor can grant 10.
+			rageDelta := core.TernaryFloat64(sim.Proc(furorProcChance, "Furor"), 10, 0) - druid.CurrentRage()
+			if rageDelta > 0 {
+				druid.AddRage(sim, rageDelta, rageMetrics)
+			} else if rageDelta < 0 {
+				druid.SpendRage(sim, -rageDelta, rageMetrics)
+			}
+
+			druid.BearFormAura.Activate(sim)
+		},
+	})
+}
 
 func (druid *Druid) manageCooldownsEnabled() {
 	// Disable cooldowns not usable in form and/or delay others
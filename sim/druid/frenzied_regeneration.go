@@ -0,0 +1,86 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+)
+
+const FrenziedRegenerationRanks = 3
+
+var FrenziedRegenerationSpellId = [FrenziedRegenerationRanks + 1]int32{0, 22842, 22895, 22896}
+var FrenziedRegenerationHealthPerRage = [FrenziedRegenerationRanks + 1]float64{0, 10, 15, 20}
+var FrenziedRegenerationLevel = [FrenziedRegenerationRanks + 1]int{0, 36, 46, 56}
+
+// https://www.wowhead.com/classic/spell=22896/frenzied-regeneration
+// Converts up to 10 rage per second into health for 10 sec.
+func (druid *Druid) registerFrenziedRegenerationCD() {
+	rank := map[int32]int{
+		25: 0,
+		40: 1,
+		50: 2,
+		60: 3,
+	}[druid.Level]
+
+	if rank == 0 {
+		return
+	}
+
+	level := FrenziedRegenerationLevel[rank]
+	actionID := core.ActionID{SpellID: FrenziedRegenerationSpellId[rank]}
+	healthPerRage := FrenziedRegenerationHealthPerRage[rank]
+
+	healthMetrics := druid.NewHealthMetrics(actionID)
+	rageMetrics := druid.NewRageMetrics(actionID)
+
+	druid.FrenziedRegenerationAura = druid.RegisterAura(core.Aura{
+		Label:    "Frenzied Regeneration",
+		ActionID: actionID,
+		Duration: time.Second * 10,
+	})
+
+	druid.FrenziedRegeneration = druid.RegisterSpell(Bear, core.SpellConfig{
+		ActionID: actionID,
+		Flags:    core.SpellFlagAPL,
+
+		Rank:          rank,
+		RequiredLevel: level,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    druid.NewTimer(),
+				Duration: time.Minute * 3,
+			},
+			IgnoreHaste: true,
+		},
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			core.StartPeriodicAction(sim, core.PeriodicActionOptions{
+				NumTicks: 10,
+				Period:   time.Second * 1,
+				OnAction: func(sim *core.Simulation) {
+					if !druid.FrenziedRegenerationAura.IsActive() {
+						return
+					}
+
+					rageDumped := min(druid.CurrentRage(), 10.0)
+					if rageDumped <= 0 {
+						return
+					}
+
+					druid.SpendRage(sim, rageDumped, rageMetrics)
+					druid.GainHealth(sim, rageDumped*healthPerRage*druid.PseudoStats.HealingTakenMultiplier, healthMetrics)
+				},
+			})
+
+			druid.FrenziedRegenerationAura.Activate(sim)
+		},
+	})
+
+	druid.AddMajorCooldown(core.MajorCooldown{
+		Spell: druid.FrenziedRegeneration.Spell,
+		Type:  core.CooldownTypeSurvival,
+	})
+}
@@ -80,7 +80,7 @@ func (druid *Druid) registerHurricaneSpell() {
 					dot.Snapshot(target, damage, isRollover)
 				},
 				OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
-					for _, aoeTarget := range sim.Encounter.TargetUnits {
+					for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 						dot.CalcAndDealPeriodicSnapshotDamage(sim, aoeTarget, dot.OutcomeTickCounted)
 					}
 				},
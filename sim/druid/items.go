@@ -72,7 +72,7 @@ func init() {
 	// https://www.wowhead.com/classic/item=228182/idol-of-exsanguination-bear
 	// Equip: Your Lacerate ticks energize you for 3 rage.
 	core.NewItemEffect(IdolOfExsanguinationBear, func(agent core.Agent) {
-		// TODO: Not yet implemented
+		// Implemented in lacerate.go
 	})
 
 	// https://www.wowhead.com/classic/item=228180/idol-of-the-swarm
@@ -158,6 +158,7 @@ func (druid *Druid) newBloodbarkCleaveItem(itemID int32) {
 		DamageMultiplier: 1,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcDamage(sim, target, 5, spell.OutcomeMagicCrit)
 				target = sim.Environment.NextTargetUnit(target)
@@ -0,0 +1,95 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Lacerate causes a high amount of threat with its initial hit.
+const LacerateThreatMultiplier = 3.33
+
+func (druid *Druid) registerLacerateSpell() {
+	if !druid.HasRune(proto.DruidRune_RuneLegsLacerate) {
+		return
+	}
+
+	tickDamage := druid.baseRuneAbilityDamage() * 0.1
+	initialDamageMulti := 0.2
+
+	rageMetrics := druid.NewRageMetrics(core.ActionID{ItemID: IdolOfExsanguinationBear})
+	hasExsanguinationIdol := druid.Ranged().ID == IdolOfExsanguinationBear
+
+	druid.Lacerate = druid.RegisterSpell(Bear, core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: int32(proto.DruidRune_RuneLegsLacerate)},
+		SpellSchool: core.SpellSchoolPhysical,
+		DefenseType: core.DefenseTypeMelee,
+		ProcMask:    core.ProcMaskMeleeMHSpecial,
+		Flags:       SpellFlagOmen | core.SpellFlagMeleeMetrics | core.SpellFlagAPL,
+
+		RageCost: core.RageCostOptions{
+			Cost:   10,
+			Refund: 0.8,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			IgnoreHaste: true,
+		},
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		Dot: core.DotConfig{
+			Aura: core.Aura{
+				Label:     "Lacerate",
+				MaxStacks: 5,
+				Duration:  time.Second * 15,
+			},
+			NumberOfTicks: 5,
+			TickLength:    time.Second * 3,
+
+			OnSnapshot: func(sim *core.Simulation, target *core.Unit, dot *core.Dot, isRollover bool) {
+				dot.SnapshotBaseDamage = (tickDamage + 0.01*dot.Spell.MeleeAttackPower()) * float64(dot.Aura.GetStacks())
+
+				if !isRollover {
+					attackTable := dot.Spell.Unit.AttackTables[target.UnitIndex][dot.Spell.CastType]
+					dot.SnapshotAttackerMultiplier = dot.Spell.AttackerDamageMultiplier(attackTable)
+				}
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				dot.CalcAndDealPeriodicSnapshotDamage(sim, target, dot.Spell.OutcomeAlwaysHit)
+
+				if hasExsanguinationIdol {
+					druid.AddRage(sim, 3, rageMetrics)
+				}
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			baseDamage := initialDamageMulti * spell.Unit.MHWeaponDamage(sim, spell.MeleeAttackPower())
+
+			// Only the initial hit causes the extra threat.
+			spell.ThreatMultiplier = LacerateThreatMultiplier
+			result := spell.CalcAndDealDamage(sim, target, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
+			spell.ThreatMultiplier = 1
+
+			if result.Landed() {
+				dot := spell.Dot(target)
+				if dot.IsActive() {
+					dot.Refresh(sim)
+					dot.AddStack(sim)
+					dot.TakeSnapshot(sim, true)
+				} else {
+					dot.Apply(sim)
+					dot.SetStacks(sim, 1)
+					dot.TakeSnapshot(sim, true)
+				}
+			} else {
+				spell.IssueRefund(sim)
+			}
+		},
+	})
+}
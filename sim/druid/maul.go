@@ -2,10 +2,32 @@ package druid
 
 import (
 	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
 )
 
+const MaulRanks = 7
+
+var MaulSpellId = [MaulRanks + 1]int32{0, 6807, 6808, 6809, 8972, 9745, 9880, 9881}
+var MaulBaseDamage = [MaulRanks + 1]float64{0, 18, 27, 37, 49, 71, 101, 128}
+var MaulLevel = [MaulRanks + 1]int{0, 10, 18, 26, 34, 42, 50, 58}
+
+// Maul causes 75% additional threat.
+const MaulThreatMultiplier = 1.75
+
 func (druid *Druid) registerMaulSpell() {
-	flatBaseDamage := 578.0
+	hasGoreRune := druid.HasRune(proto.DruidRune_RuneHelmGore)
+
+	rank := map[int32]int{
+		25: 2,
+		40: 4,
+		50: 6,
+		60: 7,
+	}[druid.Level]
+
+	level := MaulLevel[rank]
+	spellID := MaulSpellId[rank]
+	flatBaseDamage := MaulBaseDamage[rank]
+
 	rageCost := 15 - float64(druid.Talents.Ferocity)
 
 	switch druid.Ranged().ID {
@@ -14,11 +36,14 @@ func (druid *Druid) registerMaulSpell() {
 	}
 
 	druid.Maul = druid.RegisterSpell(Bear, core.SpellConfig{
-		ActionID:    core.ActionID{SpellID: 48480},
+		ActionID:    core.ActionID{SpellID: spellID},
 		SpellSchool: core.SpellSchoolPhysical,
 		DefenseType: core.DefenseTypeMelee,
 		ProcMask:    core.ProcMaskMeleeMHSpecial | core.ProcMaskMeleeMHAuto,
-		Flags:       SpellFlagOmen | core.SpellFlagMeleeMetrics | core.SpellFlagIncludeTargetBonusDamage | core.SpellFlagNoOnCastComplete,
+		Flags:       SpellFlagOmen | core.SpellFlagMeleeMetrics | core.SpellFlagNoOnCastComplete,
+
+		Rank:          rank,
+		RequiredLevel: level,
 
 		RageCost: core.RageCostOptions{
 			Cost:   rageCost,
@@ -26,8 +51,8 @@ func (druid *Druid) registerMaulSpell() {
 		},
 
 		DamageMultiplier: 1 + 0.1*float64(druid.Talents.SavageFury),
-		ThreatMultiplier: 1,
-		FlatThreatBonus:  424,
+		ThreatMultiplier: MaulThreatMultiplier,
+		BonusCoefficient: 1,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			// Need to specially deactivate CC here in case maul is cast simultaneously with another spell.
@@ -35,22 +60,14 @@ func (druid *Druid) registerMaulSpell() {
 				druid.ClearcastingAura.Deactivate(sim)
 			}
 
-			modifier := 1.0
-			if druid.BleedCategories.Get(target).AnyActive() {
-				modifier += .3
-			}
-			if druid.AssumeBleedActive || druid.Rip.Dot(target).IsActive() || druid.Rake.Dot(target).IsActive() || druid.Lacerate.Dot(target).IsActive() {
-				modifier *= 1.0 + (0.04 * float64(druid.Talents.RendAndTear))
-			}
-
-			baseDamage := flatBaseDamage +
-				spell.Unit.MHWeaponDamage(sim, spell.MeleeAttackPower()) +
-				spell.BonusWeaponDamage()
-			baseDamage *= modifier
-
-			result := spell.CalcAndDealDamage(sim, curTarget, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
+			baseDamage := flatBaseDamage + spell.Unit.MHWeaponDamage(sim, spell.MeleeAttackPower())
+			result := spell.CalcAndDealDamage(sim, target, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
 
-			if !result.Landed() {
+			if result.Landed() {
+				if hasGoreRune {
+					druid.rollGoreBearReset(sim)
+				}
+			} else {
 				spell.IssueRefund(sim)
 			}
 
@@ -88,7 +105,8 @@ func (druid *Druid) QueueMaul(sim *core.Simulation) {
 	}
 }
 
-// Returns true if the regular melee swing should be used, false otherwise.
+// Returns the spell to use for the next main hand swing: Maul if it's queued and
+// can be cast, the regular swing otherwise.
 func (druid *Druid) MaulReplaceMH(sim *core.Simulation, mhSwingSpell *core.Spell) *core.Spell {
 	if !druid.MaulQueueAura.IsActive() {
 		return mhSwingSpell
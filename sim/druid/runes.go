@@ -35,10 +35,12 @@ func (druid *Druid) ApplyRunes() {
 	// Legs
 	druid.applyStarsurge()
 	druid.applySavageRoar()
+	druid.registerLacerateSpell()
 
 	// Feet
 	druid.applyDreamstate()
 	druid.applyKingOfTheJungle()
+	druid.registerSurvivalInstinctsCD()
 }
 
 func (druid *Druid) applyGaleWinds() {
@@ -76,8 +78,12 @@ const (
 	Gore_CatResetProcChance  = .15
 )
 
-// TODO: Bear spells not implemented: MangleBear, Swipe, Maul
+// Rolled by Maul, Swipe and Mangle (Bear)
 func (druid *Druid) rollGoreBearReset(sim *core.Simulation) {
+	if druid.MangleBear == nil {
+		return
+	}
+
 	if sim.RandomFloat("Gore (Bear)") < Gore_BearResetProcChance {
 		druid.MangleBear.CD.Reset()
 	}
@@ -273,7 +279,7 @@ func (druid *Druid) tryElunesFiresRipExtension(sim *core.Simulation, unit *core.
 }
 
 func (druid *Druid) applyMangle() {
-	//druid.registerMangleBearSpell()
+	druid.registerMangleBearSpell()
 	druid.registerMangleCatSpell()
 }
 
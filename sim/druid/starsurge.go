@@ -91,9 +91,7 @@ func (druid *Druid) applyStarsurge() {
 			// Aura applies on cast
 			starfireDamageAura.Activate(sim)
 
-			spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-				spell.DealDamage(sim, result)
-			})
+			spell.DealDamageAfterTravelTime(sim, result)
 		},
 	})
 }
@@ -0,0 +1,59 @@
+package druid
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+// Temporarily grants 30% of maximum health for 20 sec. The health is lost when the effect expires.
+func (druid *Druid) registerSurvivalInstinctsCD() {
+	if !druid.HasRune(proto.DruidRune_RuneFeetSurvivalInstincts) {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: int32(proto.DruidRune_RuneFeetSurvivalInstincts)}
+	healthMetrics := druid.NewHealthMetrics(actionID)
+
+	bonusHealth := 0.0
+
+	druid.SurvivalInstinctsAura = druid.RegisterAura(core.Aura{
+		Label:    "Survival Instincts",
+		ActionID: actionID,
+		Duration: time.Second * 20,
+		OnGain: func(aura *core.Aura, sim *core.Simulation) {
+			bonusHealth = druid.MaxHealth() * 0.3
+			druid.AddStatsDynamic(sim, stats.Stats{stats.Health: bonusHealth})
+			druid.GainHealth(sim, bonusHealth, healthMetrics)
+		},
+		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
+			druid.AddStatsDynamic(sim, stats.Stats{stats.Health: -bonusHealth})
+			if excess := druid.CurrentHealth() - druid.MaxHealth(); excess > 0 {
+				druid.RemoveHealth(sim, excess)
+			}
+		},
+	})
+
+	druid.SurvivalInstincts = druid.RegisterSpell(Bear|Cat, core.SpellConfig{
+		ActionID: actionID,
+		Flags:    core.SpellFlagAPL,
+
+		Cast: core.CastConfig{
+			CD: core.Cooldown{
+				Timer:    druid.NewTimer(),
+				Duration: time.Minute * 3,
+			},
+			IgnoreHaste: true,
+		},
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			druid.SurvivalInstinctsAura.Activate(sim)
+		},
+	})
+
+	druid.AddMajorCooldown(core.MajorCooldown{
+		Spell: druid.SurvivalInstincts.Spell,
+		Type:  core.CooldownTypeSurvival,
+	})
+}
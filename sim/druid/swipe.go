@@ -19,12 +19,13 @@ const SwipeThreatMultiplier = 2.0
 
 func (druid *Druid) registerSwipeBearSpell() {
 	hasImprovedSwipeRune := druid.HasRune(proto.DruidRune_RuneCloakImprovedSwipe)
+	hasGoreRune := druid.HasRune(proto.DruidRune_RuneHelmGore)
 
 	rank := map[int32]int{
 		25: 2,
 		40: 3,
 		50: 4,
-		60: 6,
+		60: 5,
 	}[druid.Level]
 
 	level := SwipeLevel[rank]
@@ -52,7 +53,7 @@ func (druid *Druid) registerSwipeBearSpell() {
 		RequiredLevel: level,
 
 		RageCost: core.RageCostOptions{
-			Cost: 20 - float64(druid.Talents.Ferocity),
+			Cost: rageCost,
 		},
 
 		Cast: core.CastConfig{
@@ -66,6 +67,7 @@ func (druid *Druid) registerSwipeBearSpell() {
 		ThreatMultiplier: SwipeThreatMultiplier,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
 				target = sim.Environment.NextTargetUnit(target)
@@ -74,6 +76,10 @@ func (druid *Druid) registerSwipeBearSpell() {
 			for _, result := range results {
 				spell.DealDamage(sim, result)
 			}
+
+			if hasGoreRune {
+				druid.rollGoreBearReset(sim)
+			}
 		},
 	})
 }
@@ -108,7 +114,7 @@ func (druid *Druid) registerSwipeCatSpell() {
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			baseDamage := spell.Unit.MHNormalizedWeaponDamage(sim, spell.MeleeAttackPower())
-			for _, aoeTarget := range sim.Encounter.TargetUnits {
+			for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 				spell.CalcAndDealDamage(sim, aoeTarget, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
 			}
 		},
@@ -42,9 +42,9 @@ func (druid *Druid) ThickHideMultiplier() float64 {
 	return thickHideMulti
 }
 
+// Bear Form increases the armor contribution from items by 180%, and Dire Bear Form by 360%.
 func (druid *Druid) BearArmorMultiplier() float64 {
-	sotfMulti := 1.0 + 0.33/3.0
-	return 4.7 * sotfMulti
+	return core.TernaryFloat64(druid.Level >= 40, 4.6, 2.8)
 }
 
 func (druid *Druid) applyNaturesGrace() {
@@ -38,18 +38,21 @@ func NewFeralTankDruid(character *core.Character, options *proto.Player) *FeralT
 	}
 
 	bear.EnableRageBar(core.RageBarOptions{
-		StartingRage:   bear.Options.StartingRage,
-		RageMultiplier: 1,
+		StartingRage:          bear.Options.StartingRage,
+		DamageDealtMultiplier: 1,
+		DamageTakenMultiplier: 1,
 	})
 
 	bear.EnableAutoAttacks(bear, core.AutoAttackOptions{
 		// Base paw weapon.
-		MainHand:       bear.GetBearWeapon(),
+		MainHand:       bear.GetBearWeapon(bear.Level),
 		AutoSwingMelee: true,
 		ReplaceMHSwing: bear.TryMaul,
 	})
 	bear.ReplaceBearMHFunc = bear.TryMaul
 
+	bear.PseudoStats.FeralCombatEnabled = true
+
 	healingModel := options.HealingModel
 	if healingModel != nil {
 		if healingModel.InspirationUptime > 0.0 {
@@ -77,7 +80,6 @@ func (bear *FeralTankDruid) Initialize() {
 
 func (bear *FeralTankDruid) Reset(sim *core.Simulation) {
 	bear.Druid.Reset(sim)
-	bear.Druid.ClearForm(sim)
+	bear.Druid.CancelShapeshift(sim)
 	bear.BearFormAura.Activate(sim)
-	bear.Druid.PseudoStats.Stunned = false
 }
@@ -0,0 +1,127 @@
+package tank
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/sod/sim/common" // imported to get item effects included.
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func init() {
+	RegisterFeralTankDruid()
+}
+
+func TestFeralTank(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:      proto.Class_ClassDruid,
+			Level:      60,
+			Race:       proto.Race_RaceTauren,
+			OtherRaces: []proto.Race{proto.Race_RaceNightElf},
+
+			Talents:     P4BearTalents,
+			GearSet:     core.GetGearSet("../../../ui/feral_tank_druid/gear_sets", "phase_4_tank"),
+			Rotation:    core.GetAplRotation("../../../ui/feral_tank_druid/apls", "phase_4_tank"),
+			Buffs:       core.FullBuffsPhase4,
+			Consumes:    Phase4Consumes,
+			SpecOptions: core.SpecOptionsCombo{Label: "Default", SpecOptions: PlayerOptionsDefault},
+
+			IsTank:          true,
+			InFrontOfTarget: true,
+			HealingModel:    TankHealingModel,
+
+			ItemFilter:      ItemFilters,
+			EPReferenceStat: proto.Stat_StatAttackPower,
+			StatsToWeigh:    Stats,
+		},
+	}))
+}
+
+func BenchmarkSimulate(b *testing.B) {
+	rsr := &proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(
+			&proto.Player{
+				Race:          proto.Race_RaceTauren,
+				Class:         proto.Class_ClassDruid,
+				Level:         60,
+				Equipment:     core.GetGearSet("../../../ui/feral_tank_druid/gear_sets", "phase_4_tank").GearSet,
+				Rotation:      core.GetAplRotation("../../../ui/feral_tank_druid/apls", "phase_4_tank").Rotation,
+				Consumes:      Phase4Consumes.Consumes,
+				Spec:          PlayerOptionsDefault,
+				Buffs:         core.FullIndividualBuffsPhase4,
+				TalentsString: P4BearTalents,
+				HealingModel:  TankHealingModel,
+
+				InFrontOfTarget: true,
+			},
+			core.FullPartyBuffs,
+			core.FullRaidBuffsPhase4,
+			core.FullDebuffsPhase4),
+		Encounter: &proto.Encounter{
+			Duration: 180,
+			Targets: []*proto.Target{
+				core.NewDefaultTarget(60),
+			},
+		},
+		SimOptions: core.AverageDefaultSimTestOptions,
+	}
+	rsr.Raid.Tanks = append(rsr.Raid.Tanks, &proto.UnitReference{Type: proto.UnitReference_Player, Index: 0})
+
+	core.RaidBenchmark(b, rsr)
+}
+
+var P4BearTalents = "500005001-5050501303022151-0502"
+
+var PlayerOptionsDefault = &proto.Player_FeralTankDruid{
+	FeralTankDruid: &proto.FeralTankDruid{
+		Options: &proto.FeralTankDruid_Options{
+			InnervateTarget: &proto.UnitReference{}, // no Innervate
+			StartingRage:    0,
+		},
+	},
+}
+
+var TankHealingModel = &proto.HealingModel{
+	Hps:            850,
+	CadenceSeconds: 2,
+	BurstWindow:    6,
+}
+
+var Phase4Consumes = core.ConsumesCombo{
+	Label: "Phase 4 Consumes",
+	Consumes: &proto.Consumes{
+		AgilityElixir:     proto.AgilityElixir_ElixirOfTheMongoose,
+		ArmorElixir:       proto.ArmorElixir_ElixirOfSuperiorDefense,
+		DragonBreathChili: true,
+		Flask:             proto.Flask_FlaskOfTheTitans,
+		Food:              proto.Food_FoodSmokedDesertDumpling,
+		StrengthBuff:      proto.StrengthBuff_JujuPower,
+	},
+}
+
+var ItemFilters = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeLeather,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+		proto.WeaponType_WeaponTypePolearm,
+	},
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeIdol,
+	},
+}
+
+var Stats = []proto.Stat{
+	proto.Stat_StatStrength,
+	proto.Stat_StatAgility,
+	proto.Stat_StatStamina,
+	proto.Stat_StatAttackPower,
+	proto.Stat_StatFeralAttackPower,
+	proto.Stat_StatArmor,
+	proto.Stat_StatDefense,
+	proto.Stat_StatDodge,
+}
@@ -78,9 +78,7 @@ func (druid *Druid) newWrathSpellConfig(rank int) core.SpellConfig {
 				druid.NaturesGraceProcAura.Activate(sim)
 			}
 
-			spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-				spell.DealDamage(sim, result)
-			})
+			spell.DealDamageAfterTravelTime(sim, result)
 		},
 	}
 }
@@ -0,0 +1,300 @@
+package encounters
+
+import (
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+func addBlackfathomDeeps(raidPrefix string) {
+	addGhamoora(raidPrefix)
+	addLadySarevess(raidPrefix)
+	addLorgusJett(raidPrefix)
+	addBaronAquanis(raidPrefix)
+	addAkumai(raidPrefix)
+}
+
+// Base config of Blackfathom Deeps bosses, all of which are level 27.
+func blackfathomDeepsTarget(id int32, name string, mobType proto.MobType, health float64) *proto.Target {
+	return &proto.Target{
+		Id:        id,
+		Name:      name,
+		Level:     27,
+		MobType:   mobType,
+		TankIndex: 0,
+
+		Stats: stats.Stats{
+			stats.Health:      health,
+			stats.Armor:       1104,
+			stats.AttackPower: 574,
+		}.ToFloatArray(),
+
+		SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
+		SwingSpeed:       2,
+		MinBaseDamage:    400,
+		DamageSpread:     0.3333,
+		ParryHaste:       true,
+		DualWield:        false,
+		DualWieldPenalty: false,
+		TargetInputs:     make([]*proto.TargetInput, 0),
+	}
+}
+
+func addGhamoora(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     blackfathomDeepsTarget(201722, "Ghamoo-ra", proto.MobType_MobTypeBeast, 51_600),
+		AI:         NewGhamooraAI(),
+	})
+}
+
+type GhamooraAI struct {
+	Target *core.Target
+
+	Trample *core.ScriptedAbility
+}
+
+func NewGhamooraAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &GhamooraAI{}
+	}
+}
+
+func (ai *GhamooraAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Hits everyone in melee range.
+	ai.Trample = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Trample",
+		SpellId:         5568,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       150,
+		MaxDamage:       200,
+		Cooldown:        10,
+		InitialCooldown: 6,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *GhamooraAI) Reset(sim *core.Simulation) {
+	ai.Trample.Reset(sim)
+}
+
+func (ai *GhamooraAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.Trample.TryCast(sim)
+}
+
+func addLadySarevess(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     blackfathomDeepsTarget(204068, "Lady Sarevess", proto.MobType_MobTypeHumanoid, 60_800),
+		AI:         NewLadySarevessAI(),
+	})
+}
+
+type LadySarevessAI struct {
+	Target *core.Target
+
+	ForkedLightning *core.ScriptedAbility
+	FrostArrow      *core.ScriptedAbility
+}
+
+func NewLadySarevessAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &LadySarevessAI{}
+	}
+}
+
+func (ai *LadySarevessAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Frontal cone, only hits the tank.
+	ai.ForkedLightning = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Forked Lightning",
+		SpellId:         8435,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       200,
+		MaxDamage:       260,
+		Cooldown:        12,
+		InitialCooldown: 8,
+	})
+
+	ai.FrostArrow = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Frost Arrow",
+		SpellId:         9672,
+		School:          proto.SpellSchool_SpellSchoolFrost,
+		MinDamage:       120,
+		MaxDamage:       160,
+		Cooldown:        8,
+		InitialCooldown: 4,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+}
+
+func (ai *LadySarevessAI) Reset(sim *core.Simulation) {
+	ai.ForkedLightning.Reset(sim)
+	ai.FrostArrow.Reset(sim)
+}
+
+func (ai *LadySarevessAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.ForkedLightning.TryCast(sim) {
+		return
+	}
+	ai.FrostArrow.TryCast(sim)
+}
+
+func addLorgusJett(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     blackfathomDeepsTarget(207356, "Lorgus Jett", proto.MobType_MobTypeHumanoid, 76_000),
+		AI:         NewLorgusJettAI(),
+	})
+}
+
+type LorgusJettAI struct {
+	Target *core.Target
+
+	LightningBolt *core.ScriptedAbility
+}
+
+func NewLorgusJettAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &LorgusJettAI{}
+	}
+}
+
+func (ai *LorgusJettAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.LightningBolt = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Lightning Bolt",
+		SpellId:         12167,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       180,
+		MaxDamage:       220,
+		CastTime:        2,
+		Cooldown:        8,
+		InitialCooldown: 5,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+}
+
+func (ai *LorgusJettAI) Reset(sim *core.Simulation) {
+	ai.LightningBolt.Reset(sim)
+}
+
+func (ai *LorgusJettAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.LightningBolt.TryCast(sim)
+}
+
+func addBaronAquanis(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     blackfathomDeepsTarget(202699, "Baron Aquanis", proto.MobType_MobTypeElemental, 76_000),
+		AI:         NewBaronAquanisAI(),
+	})
+}
+
+type BaronAquanisAI struct {
+	Target *core.Target
+
+	Frostbolt *core.ScriptedAbility
+	FrostNova *core.ScriptedAbility
+}
+
+func NewBaronAquanisAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &BaronAquanisAI{}
+	}
+}
+
+func (ai *BaronAquanisAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.Frostbolt = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Frostbolt",
+		SpellId:         15043,
+		School:          proto.SpellSchool_SpellSchoolFrost,
+		MinDamage:       200,
+		MaxDamage:       250,
+		CastTime:        2,
+		Cooldown:        6,
+		InitialCooldown: 3,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+
+	ai.FrostNova = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Frost Nova",
+		SpellId:         15531,
+		School:          proto.SpellSchool_SpellSchoolFrost,
+		MinDamage:       80,
+		MaxDamage:       100,
+		Cooldown:        20,
+		InitialCooldown: 12,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *BaronAquanisAI) Reset(sim *core.Simulation) {
+	ai.Frostbolt.Reset(sim)
+	ai.FrostNova.Reset(sim)
+}
+
+func (ai *BaronAquanisAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.FrostNova.TryCast(sim) {
+		return
+	}
+	ai.Frostbolt.TryCast(sim)
+}
+
+func addAkumai(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     blackfathomDeepsTarget(213334, "Aku'mai", proto.MobType_MobTypeBeast, 127_393),
+		AI:         NewAkumaiAI(),
+	})
+}
+
+type AkumaiAI struct {
+	Target *core.Target
+
+	CorrosiveBlast *core.ScriptedAbility
+	FrenziedRage   *core.Aura
+}
+
+func NewAkumaiAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &AkumaiAI{}
+	}
+}
+
+func (ai *AkumaiAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Frontal cone, only hits the tank.
+	ai.CorrosiveBlast = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Corrosive Blast",
+		SpellId:         429356,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       250,
+		MaxDamage:       350,
+		Cooldown:        12,
+		InitialCooldown: 8,
+	})
+
+	ai.FrenziedRage = core.NewScriptedBuff(target, core.ActionID{SpellID: 429351}, "Frenzied Rage", &proto.ScriptedBuff{
+		AttackSpeedMultiplier: 1.5,
+	})
+}
+
+func (ai *AkumaiAI) Reset(sim *core.Simulation) {
+	ai.CorrosiveBlast.Reset(sim)
+}
+
+func (ai *AkumaiAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if !ai.FrenziedRage.IsActive() && ai.Target.HealthPercent(sim) < 0.2 {
+		ai.FrenziedRage.Activate(sim)
+	}
+
+	ai.CorrosiveBlast.TryCast(sim)
+}
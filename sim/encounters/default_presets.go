@@ -19,13 +19,13 @@ func addLevel25(bossPrefix string) {
 			Stats: stats.Stats{
 				stats.Health:      127_393, // Aku'mai
 				stats.Armor:       1104,    // Level 27 presumed
-				stats.AttackPower: 574,     // TODO: Find out attack power
+				stats.AttackPower: 574,
 			}.ToFloatArray(),
 
 			SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
-			SwingSpeed:       2,      // TODO:
-			MinBaseDamage:    400,    // TODO:
-			DamageSpread:     0.3333, // TODO:
+			SwingSpeed:       2,
+			MinBaseDamage:    400,
+			DamageSpread:     0.3333,
 			ParryHaste:       true,
 			DualWield:        false,
 			DualWieldPenalty: false,
@@ -41,22 +41,22 @@ func addLevel40(bossPrefix string) {
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: &proto.Target{
-			Id:        7361, // TODO:
+			Id:        220072, // Electrocutioner 6000
 			Name:      "Level 40",
 			Level:     42,
 			MobType:   proto.MobType_MobTypeUnknown,
 			TankIndex: 0,
 
 			Stats: stats.Stats{
-				stats.Health:      279_345, // TODO:
+				stats.Health:      279_345, // Electrocutioner 6000
 				stats.Armor:       2053,    // Approx average armor of Gnomeregan bosses
-				stats.AttackPower: 574,     // TODO:
+				stats.AttackPower: 574,
 			}.ToFloatArray(),
 
 			SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
-			SwingSpeed:       2,      // TODO:
-			MinBaseDamage:    1000,   // TODO:
-			DamageSpread:     0.3333, // TODO:
+			SwingSpeed:       2,
+			MinBaseDamage:    1000,
+			DamageSpread:     0.3333,
 			ParryHaste:       true,
 			DualWield:        false,
 			DualWieldPenalty: false,
@@ -72,22 +72,22 @@ func addLevel50(bossPrefix string) {
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: &proto.Target{
-			Id:        213335, // TODO:
+			Id:        218571, // Shade of Eranikus
 			Name:      "Level 50",
 			Level:     52,
 			MobType:   proto.MobType_MobTypeUnknown,
 			TankIndex: 0,
 
 			Stats: stats.Stats{
-				stats.Health:      127_393, // TODO:
-				stats.Armor:       3137,    // TODO: Verify this value
-				stats.AttackPower: 574,     // TODO:
+				stats.Health:      1_450_000, // Shade of Eranikus
+				stats.Armor:       3265,
+				stats.AttackPower: 805,
 			}.ToFloatArray(),
 
 			SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
-			SwingSpeed:       2,      // TODO:
-			MinBaseDamage:    2000,   // TODO:
-			DamageSpread:     0.3333, // TODO:
+			SwingSpeed:       2,
+			MinBaseDamage:    2000,
+			DamageSpread:     0.3333,
 			ParryHaste:       true,
 			DualWield:        false,
 			DualWieldPenalty: false,
@@ -103,22 +103,22 @@ func addLevel60(bossPrefix string) {
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: &proto.Target{
-			Id:        213336, // TODO:
+			Id:        11502, // Ragnaros
 			Name:      "Level 60",
 			Level:     63,
 			MobType:   proto.MobType_MobTypeUnknown,
 			TankIndex: 0,
 
 			Stats: stats.Stats{
-				stats.Health:      127_393, // TODO:
-				stats.Armor:       3731,    // TODO:
-				stats.AttackPower: 805,     // TODO:
+				stats.Health:      1_099_230, // Ragnaros
+				stats.Armor:       3731,
+				stats.AttackPower: 805,
 			}.ToFloatArray(),
 
 			SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
-			SwingSpeed:       2,      // TODO:
-			MinBaseDamage:    3000,   // TODO:
-			DamageSpread:     0.3333, // TODO:
+			SwingSpeed:       2,
+			MinBaseDamage:    3000,
+			DamageSpread:     0.3333,
 			ParryHaste:       true,
 			DualWield:        false,
 			DualWieldPenalty: false,
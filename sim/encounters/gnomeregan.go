@@ -9,36 +9,40 @@ import (
 func addGnomereganMechanical(bossPrefix string) {
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
-		Config: &proto.Target{
-			Id:        218537, // TODO:
-			Name:      "Gnomeregan Mechanical Boss",
-			Level:     42,
-			MobType:   proto.MobType_MobTypeMechanical,
-			TankIndex: 0,
-
-			Stats: stats.Stats{
-				stats.Health:      279_345, // Electrocutioner 6000 health
-				stats.Armor:       3700,    // Approx average armor of Gnomeregan bosses
-				stats.AttackPower: 574,     // TODO:
-				// TODO: Resistances
-			}.ToFloatArray(),
-
-			SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
-			SwingSpeed:       2,      // TODO:
-			MinBaseDamage:    1000,   // TODO:
-			DamageSpread:     0.3333, // TODO:
-			ParryHaste:       true,
-			DualWield:        false,
-			DualWieldPenalty: false,
-			TargetInputs:     make([]*proto.TargetInput, 0),
-		},
-		AI: NewGnomereganMechanicalAI(),
+		Config:     gnomereganTarget(220072, "Gnomeregan Mechanical Boss", 279_345), // Electrocutioner 6000
+		AI:         NewGnomereganMechanicalAI(),
 	})
 	core.AddPresetEncounter("Gnomeregan Mechanical Boss", []string{
 		bossPrefix + "/Gnomeregan Mechanical Boss",
 	})
 }
 
+// Base config of Gnomeregan bosses, all of which are level 42 mechanicals.
+func gnomereganTarget(id int32, name string, health float64) *proto.Target {
+	return &proto.Target{
+		Id:        id,
+		Name:      name,
+		Level:     42,
+		MobType:   proto.MobType_MobTypeMechanical,
+		TankIndex: 0,
+
+		Stats: stats.Stats{
+			stats.Health:      health,
+			stats.Armor:       2053,
+			stats.AttackPower: 574,
+		}.ToFloatArray(),
+
+		SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
+		SwingSpeed:       2,
+		MinBaseDamage:    1000,
+		DamageSpread:     0.3333,
+		ParryHaste:       true,
+		DualWield:        false,
+		DualWieldPenalty: false,
+		TargetInputs:     make([]*proto.TargetInput, 0),
+	}
+}
+
 type GnomereganMechanicalAI struct {
 	Target *core.Target
 }
@@ -61,3 +65,287 @@ func (ai *GnomereganMechanicalAI) Reset(*core.Simulation) {
 
 func (ai *GnomereganMechanicalAI) ExecuteCustomRotation(_ *core.Simulation) {
 }
+
+func addGnomeregan(raidPrefix string) {
+	addGrubbis(raidPrefix)
+	addViscousFallout(raidPrefix)
+	addCrowdPummeler(raidPrefix)
+	addElectrocutioner(raidPrefix)
+	addMekgineerThermaplugg(raidPrefix)
+}
+
+func addCrowdPummeler(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     gnomereganTarget(215728, "Crowd Pummeler 9-60", 250_000),
+		AI:         NewCrowdPummelerAI(),
+	})
+}
+
+type CrowdPummelerAI struct {
+	GnomereganMechanicalAI
+
+	ArcingSmash *core.ScriptedAbility
+	CrowdPummel *core.ScriptedAbility
+}
+
+func NewCrowdPummelerAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &CrowdPummelerAI{}
+	}
+}
+
+func (ai *CrowdPummelerAI) Initialize(target *core.Target, config *proto.Target) {
+	ai.GnomereganMechanicalAI.Initialize(target, config)
+
+	ai.ArcingSmash = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Arcing Smash",
+		SpellId:         8374,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       1200,
+		MaxDamage:       1500,
+		Cooldown:        10,
+		InitialCooldown: 5,
+	})
+
+	// Knocks back everyone nearby, including the tank.
+	ai.CrowdPummel = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Crowd Pummel",
+		SpellId:         10887,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       500,
+		MaxDamage:       700,
+		Cooldown:        20,
+		InitialCooldown: 15,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *CrowdPummelerAI) Reset(sim *core.Simulation) {
+	ai.ArcingSmash.Reset(sim)
+	ai.CrowdPummel.Reset(sim)
+}
+
+func (ai *CrowdPummelerAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.CrowdPummel.TryCast(sim) {
+		return
+	}
+	ai.ArcingSmash.TryCast(sim)
+}
+
+func addElectrocutioner(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     gnomereganTarget(220072, "Electrocutioner 6000", 279_345),
+		AI:         NewElectrocutionerAI(),
+	})
+}
+
+type ElectrocutionerAI struct {
+	GnomereganMechanicalAI
+
+	Shock     *core.ScriptedAbility
+	ChainBolt *core.ScriptedAbility
+}
+
+func NewElectrocutionerAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &ElectrocutionerAI{}
+	}
+}
+
+func (ai *ElectrocutionerAI) Initialize(target *core.Target, config *proto.Target) {
+	ai.GnomereganMechanicalAI.Initialize(target, config)
+
+	ai.Shock = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Shock",
+		SpellId:         11084,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       500,
+		MaxDamage:       600,
+		Cooldown:        8,
+		InitialCooldown: 4,
+	})
+
+	ai.ChainBolt = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Chain Bolt",
+		SpellId:         11085,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       400,
+		MaxDamage:       500,
+		Cooldown:        12,
+		InitialCooldown: 10,
+		Targeting:       proto.ScriptedAbility_TargetingChain,
+		ChainTargets:    5,
+	})
+}
+
+func (ai *ElectrocutionerAI) Reset(sim *core.Simulation) {
+	ai.Shock.Reset(sim)
+	ai.ChainBolt.Reset(sim)
+}
+
+func (ai *ElectrocutionerAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.ChainBolt.TryCast(sim) {
+		return
+	}
+	ai.Shock.TryCast(sim)
+}
+
+func addGrubbis(raidPrefix string) {
+	config := gnomereganTarget(217280, "Grubbis", 210_000)
+	config.MobType = proto.MobType_MobTypeHumanoid
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     config,
+		AI:         NewGrubbisAI(),
+	})
+}
+
+type GrubbisAI struct {
+	Target *core.Target
+
+	CloudOfDisease *core.ScriptedAbility
+}
+
+func NewGrubbisAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &GrubbisAI{}
+	}
+}
+
+func (ai *GrubbisAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.CloudOfDisease = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Cloud of Disease",
+		SpellId:         12627,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		Cooldown:        20,
+		InitialCooldown: 10,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+		Debuff: &proto.ScriptedDebuff{
+			Duration:     10,
+			TickDamage:   60,
+			TickInterval: 2,
+		},
+	})
+}
+
+func (ai *GrubbisAI) Reset(sim *core.Simulation) {
+	ai.CloudOfDisease.Reset(sim)
+}
+
+func (ai *GrubbisAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.CloudOfDisease.TryCast(sim)
+}
+
+func addViscousFallout(raidPrefix string) {
+	config := gnomereganTarget(220007, "Viscous Fallout", 200_000)
+	config.MobType = proto.MobType_MobTypeElemental
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     config,
+		AI:         NewViscousFalloutAI(),
+	})
+}
+
+type ViscousFalloutAI struct {
+	Target *core.Target
+
+	ToxicVolley *core.ScriptedAbility
+}
+
+func NewViscousFalloutAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &ViscousFalloutAI{}
+	}
+}
+
+func (ai *ViscousFalloutAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.ToxicVolley = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Toxic Volley",
+		SpellId:         21687,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       150,
+		MaxDamage:       200,
+		Cooldown:        15,
+		InitialCooldown: 8,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+		Debuff: &proto.ScriptedDebuff{
+			Duration:     15,
+			TickDamage:   50,
+			TickInterval: 3,
+		},
+	})
+}
+
+func (ai *ViscousFalloutAI) Reset(sim *core.Simulation) {
+	ai.ToxicVolley.Reset(sim)
+}
+
+func (ai *ViscousFalloutAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.ToxicVolley.TryCast(sim)
+}
+
+func addMekgineerThermaplugg(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     gnomereganTarget(218537, "Mekgineer Thermaplugg", 300_000),
+		AI:         NewMekgineerThermapluggAI(),
+	})
+}
+
+type MekgineerThermapluggAI struct {
+	GnomereganMechanicalAI
+
+	KnockAway *core.ScriptedAbility
+	Enrage    *core.ScriptedAbility
+}
+
+func NewMekgineerThermapluggAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &MekgineerThermapluggAI{}
+	}
+}
+
+func (ai *MekgineerThermapluggAI) Initialize(target *core.Target, config *proto.Target) {
+	ai.GnomereganMechanicalAI.Initialize(target, config)
+
+	ai.KnockAway = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Knock Away",
+		SpellId:         10101,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       800,
+		MaxDamage:       1000,
+		Cooldown:        15,
+		InitialCooldown: 10,
+	})
+
+	// Speeds up once the bombs have been kicked off for the last phase.
+	ai.Enrage = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:               "Enrage",
+		SpellId:            8599,
+		Cooldown:           600,
+		Targeting:          proto.ScriptedAbility_TargetingSelf,
+		BelowHealthPercent: 0.3,
+		Buff: &proto.ScriptedBuff{
+			DamageMultiplier:      1.25,
+			AttackSpeedMultiplier: 1.25,
+		},
+	})
+}
+
+func (ai *MekgineerThermapluggAI) Reset(sim *core.Simulation) {
+	ai.KnockAway.Reset(sim)
+	ai.Enrage.Reset(sim)
+}
+
+func (ai *MekgineerThermapluggAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.Enrage.TryCast(sim) {
+		return
+	}
+	ai.KnockAway.TryCast(sim)
+}
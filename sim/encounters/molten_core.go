@@ -0,0 +1,463 @@
+package encounters
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+	"github.com/wowsims/sod/sim/core/stats"
+)
+
+func addMoltenCore(raidPrefix string) {
+	addLucifron(raidPrefix)
+	addMagmadar(raidPrefix)
+	addGolemagg(raidPrefix)
+	addRagnaros(raidPrefix)
+}
+
+// Base config of Molten Core bosses and adds, all of which are level 63 elementals.
+func moltenCoreTarget(id int32, name string, health float64, minBaseDamage float64) *proto.Target {
+	return &proto.Target{
+		Id:        id,
+		Name:      name,
+		Level:     63,
+		MobType:   proto.MobType_MobTypeElemental,
+		TankIndex: 0,
+
+		Stats: stats.Stats{
+			stats.Health:      health,
+			stats.Armor:       3731,
+			stats.AttackPower: 805,
+		}.ToFloatArray(),
+
+		SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
+		SwingSpeed:       2,
+		MinBaseDamage:    minBaseDamage,
+		DamageSpread:     0.3333,
+		ParryHaste:       true,
+		DualWield:        false,
+		DualWieldPenalty: false,
+		TargetInputs:     make([]*proto.TargetInput, 0),
+	}
+}
+
+func addLucifron(raidPrefix string) {
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     moltenCoreTarget(12118, "Lucifron", 666_000, 3000),
+		AI:         NewLucifronAI(),
+	})
+
+	protector := moltenCoreTarget(12119, "Flamewaker Protector", 400_000, 2500)
+	protector.MobType = proto.MobType_MobTypeHumanoid
+	protector.TankIndex = 1
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     protector,
+		AI:         NewFlamewakerProtectorAI(),
+	})
+
+	core.AddPresetEncounter("Lucifron", []string{
+		raidPrefix + "/Lucifron",
+		raidPrefix + "/Flamewaker Protector",
+		raidPrefix + "/Flamewaker Protector",
+	})
+}
+
+type LucifronAI struct {
+	Target *core.Target
+
+	ImpendingDoom *core.Spell
+	ShadowShock   *core.ScriptedAbility
+}
+
+func NewLucifronAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &LucifronAI{}
+	}
+}
+
+func (ai *LucifronAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Hits every player once the debuff expires.
+	ai.ImpendingDoom = target.RegisterSpell(core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: 19702},
+		SpellSchool: core.SpellSchoolShadow,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellDamage,
+		Flags:       core.SpellFlagPureDot,
+
+		Cast: core.CastConfig{
+			CD: core.Cooldown{
+				Timer:    target.NewTimer(),
+				Duration: time.Second * 20,
+			},
+		},
+
+		DamageMultiplier: 1,
+
+		Dot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Impending Doom",
+			},
+			NumberOfTicks: 1,
+			TickLength:    time.Second * 10,
+			OnSnapshot: func(_ *core.Simulation, target *core.Unit, dot *core.Dot, isRollover bool) {
+				dot.Snapshot(target, 2000, isRollover)
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				dot.CalcAndDealPeriodicSnapshotDamage(sim, target, dot.OutcomeTick)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
+			for _, player := range sim.Raid.AllPlayerUnits {
+				if result := spell.CalcAndDealOutcome(sim, player, spell.OutcomeMagicHit); result.Landed() {
+					spell.Dot(player).Apply(sim)
+				}
+			}
+		},
+	})
+
+	ai.ShadowShock = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Shadow Shock",
+		SpellId:         20603,
+		School:          proto.SpellSchool_SpellSchoolShadow,
+		MinDamage:       800,
+		MaxDamage:       1000,
+		Cooldown:        6,
+		InitialCooldown: 5,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *LucifronAI) Reset(sim *core.Simulation) {
+	ai.ImpendingDoom.CD.Set(time.Second * 10)
+	ai.ShadowShock.Reset(sim)
+}
+
+func (ai *LucifronAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.ImpendingDoom.IsReady(sim) {
+		ai.ImpendingDoom.Cast(sim, sim.Raid.AllPlayerUnits[0])
+		return
+	}
+	ai.ShadowShock.TryCast(sim)
+}
+
+type FlamewakerProtectorAI struct {
+	Target *core.Target
+
+	Cleave *core.ScriptedAbility
+}
+
+func NewFlamewakerProtectorAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &FlamewakerProtectorAI{}
+	}
+}
+
+func (ai *FlamewakerProtectorAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.Cleave = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Cleave",
+		SpellId:         20691,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       1500,
+		MaxDamage:       1800,
+		Cooldown:        8,
+		InitialCooldown: 4,
+	})
+}
+
+func (ai *FlamewakerProtectorAI) Reset(sim *core.Simulation) {
+	ai.Cleave.Reset(sim)
+}
+
+func (ai *FlamewakerProtectorAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.Cleave.TryCast(sim)
+}
+
+func addMagmadar(raidPrefix string) {
+	config := moltenCoreTarget(11982, "Magmadar", 832_000, 3500)
+	config.MobType = proto.MobType_MobTypeBeast
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     config,
+		AI:         NewMagmadarAI(),
+	})
+}
+
+type MagmadarAI struct {
+	Target *core.Target
+
+	Frenzy   *core.ScriptedAbility
+	LavaBomb *core.ScriptedAbility
+}
+
+func NewMagmadarAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &MagmadarAI{}
+	}
+}
+
+func (ai *MagmadarAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Periodic enrage, removable with Tranquilizing Shot.
+	ai.Frenzy = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Frenzy",
+		SpellId:         19451,
+		Cooldown:        18,
+		InitialCooldown: 15,
+		Targeting:       proto.ScriptedAbility_TargetingSelf,
+		Buff: &proto.ScriptedBuff{
+			Duration:              8,
+			AttackSpeedMultiplier: 2.5,
+		},
+	})
+
+	ai.LavaBomb = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Lava Bomb",
+		SpellId:         19411,
+		School:          proto.SpellSchool_SpellSchoolFire,
+		MinDamage:       3000,
+		MaxDamage:       3400,
+		Cooldown:        12,
+		InitialCooldown: 8,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+}
+
+func (ai *MagmadarAI) Reset(sim *core.Simulation) {
+	ai.Frenzy.Reset(sim)
+	ai.LavaBomb.Reset(sim)
+}
+
+func (ai *MagmadarAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.Frenzy.TryCast(sim) {
+		return
+	}
+	ai.LavaBomb.TryCast(sim)
+}
+
+func addGolemagg(raidPrefix string) {
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     moltenCoreTarget(11988, "Golemagg the Incinerator", 832_000, 3500),
+		AI:         NewGolemaggAI(),
+	})
+
+	rager := moltenCoreTarget(11672, "Core Rager", 200_000, 2000)
+	rager.MobType = proto.MobType_MobTypeBeast
+	rager.TankIndex = 1
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     rager,
+		AI:         NewCoreRagerAI(),
+	})
+
+	core.AddPresetEncounter("Golemagg the Incinerator", []string{
+		raidPrefix + "/Golemagg the Incinerator",
+		raidPrefix + "/Core Rager",
+		raidPrefix + "/Core Rager",
+	})
+}
+
+type GolemaggAI struct {
+	Target *core.Target
+
+	Pyroblast  *core.ScriptedAbility
+	Earthquake *core.ScriptedAbility
+	Enrage     *core.Aura
+}
+
+func NewGolemaggAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &GolemaggAI{}
+	}
+}
+
+func (ai *GolemaggAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.Pyroblast = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Pyroblast",
+		SpellId:         20228,
+		School:          proto.SpellSchool_SpellSchoolFire,
+		MinDamage:       1500,
+		MaxDamage:       2000,
+		Cooldown:        7,
+		InitialCooldown: 7,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+
+	// Only used below 10% health, hits everyone in melee range.
+	ai.Earthquake = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:               "Earthquake",
+		SpellId:            19798,
+		School:             proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:          1000,
+		MaxDamage:          1200,
+		Cooldown:           3,
+		Targeting:          proto.ScriptedAbility_TargetingAllPlayers,
+		BelowHealthPercent: 0.1,
+	})
+
+	ai.Enrage = core.NewScriptedBuff(target, core.ActionID{SpellID: 19953}, "Enrage", &proto.ScriptedBuff{
+		DamageMultiplier:      1.5,
+		AttackSpeedMultiplier: 1.5,
+	})
+}
+
+func (ai *GolemaggAI) Reset(sim *core.Simulation) {
+	ai.Pyroblast.Reset(sim)
+	ai.Earthquake.Reset(sim)
+}
+
+func (ai *GolemaggAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if !ai.Enrage.IsActive() && ai.Target.HealthPercent(sim) < 0.1 {
+		ai.Enrage.Activate(sim)
+	}
+
+	if ai.Earthquake.TryCast(sim) {
+		return
+	}
+	ai.Pyroblast.TryCast(sim)
+}
+
+type CoreRagerAI struct {
+	Target *core.Target
+
+	Mangle *core.ScriptedAbility
+}
+
+func NewCoreRagerAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &CoreRagerAI{}
+	}
+}
+
+func (ai *CoreRagerAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.Mangle = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Mangle",
+		SpellId:         19820,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       1000,
+		MaxDamage:       1300,
+		Cooldown:        10,
+		InitialCooldown: 5,
+	})
+}
+
+func (ai *CoreRagerAI) Reset(sim *core.Simulation) {
+	ai.Mangle.Reset(sim)
+}
+
+func (ai *CoreRagerAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.Mangle.TryCast(sim)
+}
+
+const numSonsOfFlame = 8
+
+func addRagnaros(raidPrefix string) {
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     moltenCoreTarget(11502, "Ragnaros", 1_099_230, 5000),
+		AI:         NewRagnarosAI(),
+	})
+
+	son := moltenCoreTarget(12143, "Son of Flame", 43_500, 1000)
+	son.TankIndex = 1
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     son,
+	})
+
+	targetPaths := []string{raidPrefix + "/Ragnaros"}
+	sonIndices := make([]int32, numSonsOfFlame)
+	for i := range sonIndices {
+		targetPaths = append(targetPaths, raidPrefix+"/Son of Flame")
+		sonIndices[i] = int32(i + 1)
+	}
+
+	// Ragnaros submerges after 3 minutes and the Sons of Flame spawn. He
+	// emerges again after 90 seconds.
+	core.AddPresetEncounterWithPhases("Ragnaros", targetPaths, []*proto.EncounterPhase{
+		{Name: "Ragnaros", ActiveTargetIndices: []int32{0}},
+		{Name: "Sons of Flame", StartTime: 180, ActiveTargetIndices: sonIndices, SwitchPlayerTargets: true},
+		{Name: "Ragnaros", StartTime: 270, ActiveTargetIndices: []int32{0}, SwitchPlayerTargets: true},
+	})
+}
+
+type RagnarosAI struct {
+	Target *core.Target
+
+	WrathOfRagnaros *core.ScriptedAbility
+	ElementalFire   *core.ScriptedAbility
+	LavaBurst       *core.ScriptedAbility
+}
+
+func NewRagnarosAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &RagnarosAI{}
+	}
+}
+
+func (ai *RagnarosAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Knocks back everyone in melee range.
+	ai.WrathOfRagnaros = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Wrath of Ragnaros",
+		SpellId:         20566,
+		School:          proto.SpellSchool_SpellSchoolFire,
+		MinDamage:       2300,
+		MaxDamage:       2700,
+		Cooldown:        25,
+		InitialCooldown: 25,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+
+	ai.ElementalFire = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Elemental Fire",
+		SpellId:         20564,
+		School:          proto.SpellSchool_SpellSchoolFire,
+		MinDamage:       1000,
+		MaxDamage:       1300,
+		Cooldown:        10,
+		InitialCooldown: 5,
+		Debuff: &proto.ScriptedDebuff{
+			Duration:     8,
+			TickDamage:   400,
+			TickInterval: 2,
+		},
+	})
+
+	ai.LavaBurst = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Lava Burst",
+		SpellId:         21158,
+		School:          proto.SpellSchool_SpellSchoolFire,
+		MinDamage:       3000,
+		MaxDamage:       3600,
+		Cooldown:        15,
+		InitialCooldown: 10,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+}
+
+func (ai *RagnarosAI) Reset(sim *core.Simulation) {
+	ai.WrathOfRagnaros.Reset(sim)
+	ai.ElementalFire.Reset(sim)
+	ai.LavaBurst.Reset(sim)
+}
+
+func (ai *RagnarosAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.WrathOfRagnaros.TryCast(sim) || ai.ElementalFire.TryCast(sim) {
+		return
+	}
+	ai.LavaBurst.TryCast(sim)
+}
@@ -1,11 +1,10 @@
 package naxxramas
 
 func Register() {
-	addPatchwerk25("Naxxrammas 25")
-	addKelThuzad25("Naxxrammas 25")
-	addThaddius25("Naxxrammas 25")
-	addLoatheb25("Naxxrammas 25")
+	addPatchwerk25("Naxxramas 25")
+	addKelThuzad25("Naxxramas 25")
+	addThaddius25("Naxxramas 25")
+	addLoatheb25("Naxxramas 25")
 
-	// TODO: Figure out why this isn't pickable
-	//addPatchwerk10("Naxxrammas")
+	addPatchwerk10("Naxxramas 10")
 }
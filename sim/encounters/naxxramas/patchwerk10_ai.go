@@ -9,11 +9,13 @@ import (
 )
 
 func addPatchwerk10(bossPrefix string) {
+	// Named apart from the 25 player Patchwerk, as targets are matched to their
+	// AI by ID and name.
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: &proto.Target{
 			Id:        16028,
-			Name:      "Patchwerk",
+			Name:      "Patchwerk 10",
 			Level:     83,
 			MobType:   proto.MobType_MobTypeUndead,
 			TankIndex: 0,
@@ -35,8 +37,8 @@ func addPatchwerk10(bossPrefix string) {
 		},
 		AI: NewPatchwerk10AI(),
 	})
-	core.AddPresetEncounter("Patchwerk", []string{
-		bossPrefix + "/Patchwerk",
+	core.AddPresetEncounter("Patchwerk 10", []string{
+		bossPrefix + "/Patchwerk 10",
 	})
 }
 
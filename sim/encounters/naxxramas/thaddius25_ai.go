@@ -10,7 +10,7 @@ func addThaddius25(bossPrefix string) {
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: &proto.Target{
-			Id:        15990,
+			Id:        15928,
 			Name:      "Thaddius",
 			Level:     83,
 			MobType:   proto.MobType_MobTypeUndead,
@@ -2,17 +2,23 @@ package encounters
 
 import (
 	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/encounters/naxxramas"
 )
 
 func init() {
-	// TODO: Classic encounters?
-	// naxxramas.Register()
 	addLevel25("SoD")
 	addLevel40("SoD")
 	addGnomereganMechanical("SoD")
 	addLevel50("SoD")
 	addSunkenTempleDragonkin("SoD")
 	addLevel60("SoD")
+
+	addBlackfathomDeeps("SoD/Blackfathom Deeps")
+	addGnomeregan("SoD/Gnomeregan")
+	addSunkenTemple("SoD/Sunken Temple")
+	addMoltenCore("SoD/Molten Core")
+
+	naxxramas.Register()
 }
 
 func AddSingleTargetBossEncounter(presetTarget *core.PresetTarget) {
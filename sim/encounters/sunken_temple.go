@@ -9,36 +9,40 @@ import (
 func addSunkenTempleDragonkin(bossPrefix string) {
 	core.AddPresetTarget(&core.PresetTarget{
 		PathPrefix: bossPrefix,
-		Config: &proto.Target{
-			Id:        218571, // SoD Shade of Eranikus
-			Name:      "Sunken Temple Dragonkin Boss",
-			Level:     52,
-			MobType:   proto.MobType_MobTypeDragonkin,
-			TankIndex: 0,
-
-			Stats: stats.Stats{
-				stats.Health:      1_450_000, // Approx Shdae of Eranikus health
-				stats.Armor:       3700,      // TODO:
-				stats.AttackPower: 574,       // TODO:
-				// TODO: Resistances
-			}.ToFloatArray(),
-
-			SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
-			SwingSpeed:       2,      // TODO:
-			MinBaseDamage:    3000,   // TODO:
-			DamageSpread:     0.3333, // TODO:
-			ParryHaste:       true,
-			DualWield:        false,
-			DualWieldPenalty: false,
-			TargetInputs:     make([]*proto.TargetInput, 0),
-		},
-		AI: NewSunkenTempleDragonkinAI(),
+		Config:     sunkenTempleTarget(218571, "Sunken Temple Dragonkin Boss", proto.MobType_MobTypeDragonkin, 1_450_000), // Shade of Eranikus
+		AI:         NewSunkenTempleDragonkinAI(),
 	})
 	core.AddPresetEncounter("Sunken Temple Dragonkin Boss", []string{
 		bossPrefix + "/Sunken Temple Dragonkin Boss",
 	})
 }
 
+// Base config of Sunken Temple bosses, all of which are level 52.
+func sunkenTempleTarget(id int32, name string, mobType proto.MobType, health float64) *proto.Target {
+	return &proto.Target{
+		Id:        id,
+		Name:      name,
+		Level:     52,
+		MobType:   mobType,
+		TankIndex: 0,
+
+		Stats: stats.Stats{
+			stats.Health:      health,
+			stats.Armor:       3265,
+			stats.AttackPower: 805,
+		}.ToFloatArray(),
+
+		SpellSchool:      proto.SpellSchool_SpellSchoolPhysical,
+		SwingSpeed:       2,
+		MinBaseDamage:    2000,
+		DamageSpread:     0.3333,
+		ParryHaste:       true,
+		DualWield:        false,
+		DualWieldPenalty: false,
+		TargetInputs:     make([]*proto.TargetInput, 0),
+	}
+}
+
 type SunkenTempleDragonkinAI struct {
 	Target *core.Target
 }
@@ -58,3 +62,279 @@ func (ai *SunkenTempleDragonkinAI) Reset(*core.Simulation) {
 
 func (ai *SunkenTempleDragonkinAI) ExecuteCustomRotation(_ *core.Simulation) {
 }
+
+func addSunkenTemple(raidPrefix string) {
+	addAtalalarion(raidPrefix)
+	addJammalanAndOgom(raidPrefix)
+	addDreamscytheAndWeaver(raidPrefix)
+	addAvatarOfHakkar(raidPrefix)
+	addShadeOfEranikus(raidPrefix)
+}
+
+func addShadeOfEranikus(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     sunkenTempleTarget(218571, "Shade of Eranikus", proto.MobType_MobTypeDragonkin, 1_450_000),
+		AI:         NewShadeOfEranikusAI(),
+	})
+}
+
+type ShadeOfEranikusAI struct {
+	Target *core.Target
+
+	AcidBreath *core.ScriptedAbility
+	WarStomp   *core.ScriptedAbility
+}
+
+func NewShadeOfEranikusAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &ShadeOfEranikusAI{}
+	}
+}
+
+func (ai *ShadeOfEranikusAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Frontal cone, only hits the tank.
+	ai.AcidBreath = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Acid Breath",
+		SpellId:         12891,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       1200,
+		MaxDamage:       1500,
+		Cooldown:        15,
+		InitialCooldown: 10,
+	})
+
+	ai.WarStomp = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "War Stomp",
+		SpellId:         11876,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       600,
+		MaxDamage:       800,
+		Cooldown:        20,
+		InitialCooldown: 15,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *ShadeOfEranikusAI) Reset(sim *core.Simulation) {
+	ai.AcidBreath.Reset(sim)
+	ai.WarStomp.Reset(sim)
+}
+
+func (ai *ShadeOfEranikusAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.WarStomp.TryCast(sim) {
+		return
+	}
+	ai.AcidBreath.TryCast(sim)
+}
+
+func addAtalalarion(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     sunkenTempleTarget(218624, "Atal'alarion", proto.MobType_MobTypeGiant, 600_000),
+		AI:         NewAtalalarionAI(),
+	})
+}
+
+type AtalalarionAI struct {
+	Target *core.Target
+
+	SweepingSlam *core.ScriptedAbility
+}
+
+func NewAtalalarionAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &AtalalarionAI{}
+	}
+}
+
+func (ai *AtalalarionAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.SweepingSlam = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Sweeping Slam",
+		SpellId:         12887,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       900,
+		MaxDamage:       1100,
+		Cooldown:        12,
+		InitialCooldown: 8,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *AtalalarionAI) Reset(sim *core.Simulation) {
+	ai.SweepingSlam.Reset(sim)
+}
+
+func (ai *AtalalarionAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.SweepingSlam.TryCast(sim)
+}
+
+func addJammalanAndOgom(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     sunkenTempleTarget(218721, "Jammal'an and Ogom", proto.MobType_MobTypeHumanoid, 700_000),
+		AI:         NewJammalanAndOgomAI(),
+	})
+}
+
+type JammalanAndOgomAI struct {
+	Target *core.Target
+
+	Flamestrike *core.ScriptedAbility
+	ShadowBolt  *core.ScriptedAbility
+}
+
+func NewJammalanAndOgomAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &JammalanAndOgomAI{}
+	}
+}
+
+func (ai *JammalanAndOgomAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.Flamestrike = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Flamestrike",
+		SpellId:         12468,
+		School:          proto.SpellSchool_SpellSchoolFire,
+		MinDamage:       500,
+		MaxDamage:       650,
+		CastTime:        2,
+		Cooldown:        15,
+		InitialCooldown: 10,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+
+	ai.ShadowBolt = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Shadow Bolt",
+		SpellId:         12471,
+		School:          proto.SpellSchool_SpellSchoolShadow,
+		MinDamage:       600,
+		MaxDamage:       750,
+		CastTime:        2,
+		Cooldown:        6,
+		InitialCooldown: 3,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+	})
+}
+
+func (ai *JammalanAndOgomAI) Reset(sim *core.Simulation) {
+	ai.Flamestrike.Reset(sim)
+	ai.ShadowBolt.Reset(sim)
+}
+
+func (ai *JammalanAndOgomAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.Flamestrike.TryCast(sim) {
+		return
+	}
+	ai.ShadowBolt.TryCast(sim)
+}
+
+func addDreamscytheAndWeaver(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     sunkenTempleTarget(220833, "Dreamscythe and Weaver", proto.MobType_MobTypeDragonkin, 900_000),
+		AI:         NewDreamscytheAndWeaverAI(),
+	})
+}
+
+type DreamscytheAndWeaverAI struct {
+	Target *core.Target
+
+	AcidBreath *core.ScriptedAbility
+	WingFlap   *core.ScriptedAbility
+}
+
+func NewDreamscytheAndWeaverAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &DreamscytheAndWeaverAI{}
+	}
+}
+
+func (ai *DreamscytheAndWeaverAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	// Frontal cone, only hits the tank.
+	ai.AcidBreath = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Acid Breath",
+		SpellId:         12884,
+		School:          proto.SpellSchool_SpellSchoolNature,
+		MinDamage:       1000,
+		MaxDamage:       1200,
+		Cooldown:        15,
+		InitialCooldown: 10,
+	})
+
+	ai.WingFlap = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Wing Flap",
+		SpellId:         12882,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		MinDamage:       400,
+		MaxDamage:       500,
+		Cooldown:        20,
+		InitialCooldown: 14,
+		Targeting:       proto.ScriptedAbility_TargetingAllPlayers,
+	})
+}
+
+func (ai *DreamscytheAndWeaverAI) Reset(sim *core.Simulation) {
+	ai.AcidBreath.Reset(sim)
+	ai.WingFlap.Reset(sim)
+}
+
+func (ai *DreamscytheAndWeaverAI) ExecuteCustomRotation(sim *core.Simulation) {
+	if ai.WingFlap.TryCast(sim) {
+		return
+	}
+	ai.AcidBreath.TryCast(sim)
+}
+
+func addAvatarOfHakkar(raidPrefix string) {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+		Config:     sunkenTempleTarget(221394, "Avatar of Hakkar", proto.MobType_MobTypeDemon, 1_000_000),
+		AI:         NewAvatarOfHakkarAI(),
+	})
+}
+
+type AvatarOfHakkarAI struct {
+	Target *core.Target
+
+	CorruptedBlood *core.ScriptedAbility
+}
+
+func NewAvatarOfHakkarAI() core.AIFactory {
+	return func() core.TargetAI {
+		return &AvatarOfHakkarAI{}
+	}
+}
+
+func (ai *AvatarOfHakkarAI) Initialize(target *core.Target, _ *proto.Target) {
+	ai.Target = target
+
+	ai.CorruptedBlood = core.NewScriptedAbility(target, &proto.ScriptedAbility{
+		Name:            "Corrupted Blood",
+		SpellId:         24328,
+		School:          proto.SpellSchool_SpellSchoolPhysical,
+		Cooldown:        12,
+		InitialCooldown: 6,
+		Targeting:       proto.ScriptedAbility_TargetingRandomPlayer,
+		Debuff: &proto.ScriptedDebuff{
+			Duration:     10,
+			TickDamage:   250,
+			TickInterval: 2,
+		},
+	})
+}
+
+func (ai *AvatarOfHakkarAI) Reset(sim *core.Simulation) {
+	ai.CorruptedBlood.Reset(sim)
+}
+
+func (ai *AvatarOfHakkarAI) ExecuteCustomRotation(sim *core.Simulation) {
+	ai.CorruptedBlood.TryCast(sim)
+}
@@ -0,0 +1,280 @@
+// Package gym wraps a player's fight in a reinforcement learning environment,
+// with the usual reset/step/observe interface. The player is driven through an
+// interactive session, see core.InteractiveSession.
+package gym
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Default duration of the wait action.
+const defaultWait = time.Millisecond * 100
+
+type Options struct {
+	// Duration of the wait action. Defaults to 100ms.
+	Wait time.Duration
+}
+
+// Env is an environment over the combat of the first player of a request.
+//
+// Action 0 waits, and the other actions cast the spells an APL rotation could
+// cast, see ActionLabels. Observations are built from the same state APL
+// values read, see ObservationLabels. The reward of each step is the damage the
+// player and its pets dealt during it.
+type Env struct {
+	request *proto.RaidSimRequest
+	session *core.InteractiveSession
+	wait    time.Duration
+
+	// Episodes without an explicit seed are seeded from the request seed, like
+	// the iterations of a sim.
+	nextSeed int64
+
+	spells []*core.Spell
+	auras  []*core.Aura // of the player and its current target
+
+	actionLabels      []string
+	observationLabels []string
+	observation       []float64
+
+	damageDone float64
+}
+
+func NewEnv(request *proto.RaidSimRequest, options Options) *Env {
+	env := &Env{
+		request: request,
+		session: core.NewInteractiveSession(request),
+		wait:    options.Wait,
+	}
+	if env.wait <= 0 {
+		env.wait = defaultWait
+	}
+	if request.SimOptions != nil {
+		env.nextSeed = request.SimOptions.RandomSeed
+	}
+
+	player := env.session.Player()
+	metadata := player.GetMetadata()
+
+	env.actionLabels = append(env.actionLabels, "wait")
+	for _, spellStats := range metadata.Spells {
+		if !spellStats.IsCastable || spellStats.PrepullOnly {
+			continue
+		}
+		spell := player.GetSpell(core.ProtoToActionID(spellStats.Id))
+		env.spells = append(env.spells, spell)
+		env.actionLabels = append(env.actionLabels, actionLabel(spell.ActionID))
+	}
+
+	env.observationLabels = append(env.observationLabels, "time_remaining", "gcd_remaining", "cast_remaining")
+	if player.HasHealthBar() {
+		env.observationLabels = append(env.observationLabels, "health_percent")
+	}
+	if player.HasManaBar() {
+		env.observationLabels = append(env.observationLabels, "mana", "mana_percent")
+	}
+	if player.HasRageBar() {
+		env.observationLabels = append(env.observationLabels, "rage")
+	}
+	if player.HasEnergyBar() {
+		env.observationLabels = append(env.observationLabels, "energy", "combo_points")
+	}
+	for _, spell := range env.spells {
+		label := actionLabel(spell.ActionID)
+		env.observationLabels = append(env.observationLabels, label+" cooldown", label+" castable")
+		if targetDot(spell, player.CurrentTarget) != nil {
+			env.observationLabels = append(env.observationLabels, label+" dot_remaining")
+		}
+	}
+	for _, unit := range []*core.Unit{&player.Unit, player.CurrentTarget} {
+		prefix := "player "
+		if unit != &player.Unit {
+			prefix = "target "
+		}
+		for _, aura := range unit.GetAuras() {
+			if aura.ActionID.IsEmptyAction() {
+				continue
+			}
+			env.auras = append(env.auras, aura)
+			label := prefix + actionLabel(aura.ActionID)
+			env.observationLabels = append(env.observationLabels, label+" remaining", label+" stacks")
+		}
+	}
+
+	env.observation = make([]float64, len(env.observationLabels))
+	return env
+}
+
+// Names of the actions, by index. Spells are named like "spell:123", with a
+// "#tag" suffix for spells with tags.
+func (env *Env) ActionLabels() []string {
+	return env.actionLabels
+}
+
+// Names of the entries of observation vectors. Times are in seconds.
+func (env *Env) ObservationLabels() []string {
+	return env.observationLabels
+}
+
+// Reset starts a new episode and returns its first observation. A seed of 0
+// picks the request's seed for the first episode, and the following seeds for
+// later ones.
+func (env *Env) Reset(seed int64) []float64 {
+	if seed == 0 {
+		seed = env.nextSeed
+		env.nextSeed++
+	}
+	env.session.Restart(seed)
+	env.damageDone = 0
+	return env.Observe()
+}
+
+// Step applies action and runs the fight until the player's next decision.
+// Actions which can't be used right now wait instead, and are reported as
+// invalid.
+func (env *Env) Step(action int) (observation []float64, reward float64, done bool, invalid bool) {
+	if env.session.Done() {
+		panic("Stepping a finished episode, call Reset first")
+	}
+	if action < 0 || action > len(env.spells) {
+		panic(fmt.Sprintf("Action %d out of range", action))
+	}
+
+	if action == 0 {
+		env.session.Wait(env.wait)
+	} else if err := env.session.Cast(env.spells[action-1].ActionID); err != nil {
+		invalid = true
+		env.session.Wait(env.wait)
+	}
+
+	damageDone := env.session.DamageDone()
+	reward = damageDone - env.damageDone
+	env.damageDone = damageDone
+	return env.Observe(), reward, env.session.Done(), invalid
+}
+
+// ActionMask returns whether each action can be used right now.
+func (env *Env) ActionMask() []bool {
+	sim := env.session.Sim()
+	target := env.session.Player().CurrentTarget
+
+	mask := make([]bool, len(env.actionLabels))
+	mask[0] = true
+	for i, spell := range env.spells {
+		mask[i+1] = spell.CanCast(sim, target)
+	}
+	return mask
+}
+
+// Observe returns the observation of the current state. The slice is reused by
+// later calls.
+func (env *Env) Observe() []float64 {
+	sim := env.session.Sim()
+	player := env.session.Player()
+	target := player.CurrentTarget
+	remaining := sim.GetRemainingDuration()
+
+	obs := env.observation[:0]
+	obs = append(obs,
+		remaining.Seconds(),
+		max(0, player.GCD.TimeToReady(sim)).Seconds(),
+		max(0, player.Hardcast.Expires-sim.CurrentTime).Seconds(),
+	)
+	if player.HasHealthBar() {
+		obs = append(obs, player.CurrentHealthPercent())
+	}
+	if player.HasManaBar() {
+		obs = append(obs, player.CurrentMana(), player.CurrentManaPercent())
+	}
+	if player.HasRageBar() {
+		obs = append(obs, player.CurrentRage())
+	}
+	if player.HasEnergyBar() {
+		obs = append(obs, player.CurrentEnergy(), float64(player.ComboPoints()))
+	}
+	for _, spell := range env.spells {
+		obs = append(obs, spell.TimeToReady(sim).Seconds(), boolValue(spell.CanCast(sim, target)))
+		if dot := targetDot(spell, target); dot != nil {
+			obs = append(obs, auraRemaining(sim, dot.Aura, remaining))
+		}
+	}
+	for _, aura := range env.auras {
+		obs = append(obs, auraRemaining(sim, aura, remaining), float64(aura.GetStacks()))
+	}
+
+	env.observation = obs
+	return obs
+}
+
+// Baseline runs the request with its own rotation for the given number of
+// iterations, for comparison with trained policies. Returns the average DPS of
+// the player.
+func (env *Env) Baseline(iterations int32) (float64, error) {
+	result := core.RunRaidSim(&proto.RaidSimRequest{
+		Raid:      env.request.Raid,
+		Encounter: env.request.Encounter,
+		SimOptions: &proto.SimOptions{
+			Iterations: iterations,
+			RandomSeed: env.request.GetSimOptions().GetRandomSeed(),
+		},
+	})
+	if result.ErrorResult != "" {
+		return 0, fmt.Errorf("baseline sim failed: %s", result.ErrorResult)
+	}
+	return result.RaidMetrics.Parties[0].Players[0].Dps.Avg, nil
+}
+
+// EpisodeDps returns the DPS of the player and its pets in the current episode
+// so far.
+func (env *Env) EpisodeDps() float64 {
+	elapsed := env.session.Sim().CurrentTime
+	if elapsed <= 0 {
+		return 0
+	}
+	return env.damageDone / elapsed.Seconds()
+}
+
+// The dot the spell applies to target, if any.
+func targetDot(spell *core.Spell, target *core.Unit) *core.Dot {
+	if len(spell.Dots()) == 0 && spell.AOEDot() == nil {
+		return nil
+	}
+	return spell.DotOrAOEDot(target)
+}
+
+// Remaining time of the aura, capped at the remaining fight duration so that
+// auras which don't expire stay in range. 0 if the aura isn't active.
+func auraRemaining(sim *core.Simulation, aura *core.Aura, fightRemaining time.Duration) float64 {
+	if !aura.IsActive() {
+		return 0
+	}
+	return min(aura.RemainingDuration(sim), fightRemaining).Seconds()
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func actionLabel(actionID core.ActionID) string {
+	var label string
+	switch {
+	case actionID.SpellID != 0:
+		label = "spell:" + strconv.Itoa(int(actionID.SpellID))
+	case actionID.ItemID != 0:
+		label = "item:" + strconv.Itoa(int(actionID.ItemID))
+	default:
+		label = "other:" + actionID.OtherID.String()
+	}
+	if actionID.Tag != 0 {
+		label += "#" + strconv.Itoa(int(actionID.Tag))
+	}
+	return label
+}
@@ -0,0 +1,129 @@
+package gym
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/sod/sim"
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func init() {
+	sim.RegisterAll()
+}
+
+func newTestEnv() *Env {
+	player := &proto.Player{
+		Race:      proto.Race_RaceTroll,
+		Class:     proto.Class_ClassShaman,
+		Level:     60,
+		Equipment: core.GetGearSet("../../ui/elemental_shaman/gear_sets", "phase_4").GearSet,
+		Rotation:  core.GetAplRotation("../../ui/elemental_shaman/apls", "phase_4").Rotation,
+		Spec: &proto.Player_ElementalShaman{
+			ElementalShaman: &proto.ElementalShaman{
+				Options: &proto.ElementalShaman_Options{},
+			},
+		},
+	}
+	encounter := core.MakeSingleTargetEncounter(60, 0)
+	encounter.Duration = 30
+
+	return NewEnv(&proto.RaidSimRequest{
+		Raid:       core.SinglePlayerRaidProto(player, &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{}),
+		Encounter:  encounter,
+		SimOptions: &proto.SimOptions{RandomSeed: 7},
+	}, Options{})
+}
+
+// Casts Lightning Bolt whenever possible, and returns the total reward.
+func runEpisode(t *testing.T, env *Env, seed int64) float64 {
+	lightningBolt := slices.Index(env.ActionLabels(), "spell:15208")
+	if lightningBolt < 0 {
+		t.Fatalf("Expected Lightning Bolt in the actions, got %v", env.ActionLabels())
+	}
+
+	observation := env.Reset(seed)
+	if len(observation) != len(env.ObservationLabels()) {
+		t.Fatalf("Expected %d observations, got %d", len(env.ObservationLabels()), len(observation))
+	}
+
+	total := 0.0
+	for steps := 0; ; steps++ {
+		if steps > 10000 {
+			t.Fatalf("Episode didn't finish")
+		}
+
+		action := 0
+		if env.ActionMask()[lightningBolt] {
+			action = lightningBolt
+		}
+
+		_, reward, done, invalid := env.Step(action)
+		if invalid {
+			t.Fatalf("Action %s was castable but invalid", env.ActionLabels()[action])
+		}
+		total += reward
+		if done {
+			return total
+		}
+	}
+}
+
+func TestEnvEpisodes(t *testing.T) {
+	env := newTestEnv()
+	first := runEpisode(t, env, 1)
+	if first <= 0 {
+		t.Fatalf("Expected the episode to deal damage")
+	}
+	if other := runEpisode(t, env, 2); other == first {
+		t.Fatalf("Expected different seeds to give different episodes")
+	}
+	if again := runEpisode(t, env, 1); again != first {
+		t.Fatalf("Expected episodes with the same seed to match, got %0.3f and %0.3f", first, again)
+	}
+}
+
+func TestServe(t *testing.T) {
+	env := newTestEnv()
+	commands := strings.Join([]string{
+		`{"cmd": "spec"}`,
+		`{"cmd": "reset", "seed": 3}`,
+		`{"cmd": "step", "action": 0}`,
+		`{"cmd": "step", "action": -1}`,
+		`{"cmd": "jump"}`,
+	}, "\n")
+
+	out := &bytes.Buffer{}
+	if err := Serve(env, strings.NewReader(commands), out); err != nil {
+		t.Fatalf("Serve failed: %s", err)
+	}
+
+	var responses []Response
+	decoder := json.NewDecoder(out)
+	for decoder.More() {
+		response := Response{}
+		if err := decoder.Decode(&response); err != nil {
+			t.Fatalf("Failed to parse response: %s", err)
+		}
+		responses = append(responses, response)
+	}
+
+	if len(responses) != 5 {
+		t.Fatalf("Expected 5 responses, got %d", len(responses))
+	}
+	if len(responses[0].ActionLabels) != len(env.ActionLabels()) || len(responses[0].ObservationLabels) != len(env.ObservationLabels()) {
+		t.Fatalf("Expected spec response to list labels")
+	}
+	for _, response := range responses[1:3] {
+		if response.Error != "" || len(response.Observation) != len(env.ObservationLabels()) {
+			t.Fatalf("Expected observations, got error %q", response.Error)
+		}
+	}
+	if responses[3].Error == "" || responses[4].Error == "" {
+		t.Fatalf("Expected errors for invalid commands")
+	}
+}
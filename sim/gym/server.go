@@ -0,0 +1,128 @@
+package gym
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+)
+
+// Commands of the line protocol, one JSON object per line:
+//
+//	{"cmd": "spec"}                    action and observation labels
+//	{"cmd": "reset", "seed": 0}        starts an episode, see Env.Reset
+//	{"cmd": "step", "action": 1}       see Env.Step
+//	{"cmd": "baseline", "iterations": 1000}  DPS of the request's own rotation
+//
+// Every command is answered with a single JSON line.
+type Command struct {
+	Cmd        string `json:"cmd"`
+	Seed       int64  `json:"seed,omitempty"`
+	Action     int    `json:"action,omitempty"`
+	Iterations int32  `json:"iterations,omitempty"`
+}
+
+type Response struct {
+	Error string `json:"error,omitempty"`
+
+	ActionLabels      []string `json:"action_labels,omitempty"`
+	ObservationLabels []string `json:"observation_labels,omitempty"`
+
+	Observation []float64 `json:"observation,omitempty"`
+	ActionMask  []bool    `json:"action_mask,omitempty"`
+	Reward      float64   `json:"reward"`
+	Done        bool      `json:"done"`
+	Invalid     bool      `json:"invalid,omitempty"`
+
+	// DPS of the current episode so far, or of the baseline.
+	Dps float64 `json:"dps"`
+}
+
+// Serve answers the commands read from r on w, until r is closed.
+func Serve(env *Env, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	encoder := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		command := Command{}
+		var response *Response
+		if err := json.Unmarshal(scanner.Bytes(), &command); err != nil {
+			response = &Response{Error: "invalid command: " + err.Error()}
+		} else {
+			response = env.handle(command)
+		}
+		if err := encoder.Encode(response); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ListenAndServe serves every connection to addr with its own Env, built by
+// newEnv.
+func ListenAndServe(addr string, newEnv func() *Env) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	log.Printf("Gym environment listening on %s", listener.Addr())
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := Serve(newEnv(), conn, conn); err != nil {
+				log.Printf("Gym connection failed: %s", err)
+			}
+		}()
+	}
+}
+
+func (env *Env) handle(command Command) (response *Response) {
+	defer func() {
+		if err := recover(); err != nil {
+			response = &Response{Error: fmt.Sprint(err)}
+		}
+	}()
+
+	switch command.Cmd {
+	case "spec":
+		return &Response{
+			ActionLabels:      env.ActionLabels(),
+			ObservationLabels: env.ObservationLabels(),
+		}
+	case "reset":
+		return &Response{
+			Observation: env.Reset(command.Seed),
+			ActionMask:  env.ActionMask(),
+		}
+	case "step":
+		observation, reward, done, invalid := env.Step(command.Action)
+		return &Response{
+			Observation: observation,
+			ActionMask:  env.ActionMask(),
+			Reward:      reward,
+			Done:        done,
+			Invalid:     invalid,
+			Dps:         env.EpisodeDps(),
+		}
+	case "baseline":
+		dps, err := env.Baseline(max(command.Iterations, 1))
+		if err != nil {
+			return &Response{Error: err.Error()}
+		}
+		return &Response{Dps: dps}
+	default:
+		return &Response{Error: fmt.Sprintf("unknown command %q", command.Cmd)}
+	}
+}
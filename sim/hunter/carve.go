@@ -39,7 +39,7 @@ func (hunter *Hunter) registerCarveSpell() {
 		},
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			for _, aoeTarget := range sim.Encounter.TargetUnits {
+			for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 				hunter.CarveMH.Cast(sim, aoeTarget)
 				if hunter.AutoAttacks.IsDualWielding {
 					hunter.CarveOH.Cast(sim, aoeTarget)
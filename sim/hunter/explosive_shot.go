@@ -79,6 +79,7 @@ func (hunter *Hunter) registerExplosiveShotSpell(timer *core.Timer) {
 
 				if result.Landed() {
 					curTarget := target
+					numHits := min(numHits, sim.ActiveTargetCount())
 					for hitIndex := int32(0); hitIndex < numHits; hitIndex++ {
 						if curTarget != target {
 							baseDamage = sim.Roll(baseLowDamage, baseHighDamage) + 0.039*spell.RangedAttackPower(curTarget)
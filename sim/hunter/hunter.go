@@ -268,9 +268,7 @@ func NewHunter(character *core.Character, options *proto.Player) *Hunter {
 			hunter.AmmoDamageBonus
 		result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeRangedHitAndCrit)
 
-		spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-			spell.DealDamage(sim, result)
-		})
+		spell.DealDamageAfterTravelTime(sim, result)
 	}
 
 	hunter.pet = hunter.NewHunterPet()
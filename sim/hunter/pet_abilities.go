@@ -217,7 +217,7 @@ func (hp *HunterPet) newLightningBreath() *core.Spell {
 // 		APRatio: 0.07,
 // 		OnSpellHitDealt: func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
 // 			if result.Landed() {
-// 				//for _, aoeTarget := range sim.Encounter.TargetUnits {
+// 				//for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 // 				//debuffs.Get(aoeTarget).Activate(sim)
 // 				//}
 // 			}
@@ -0,0 +1,137 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Phase 4 characters from the class test suites, which should run iterations
+// without allocating.
+var iterationTestPlayers = []*proto.Player{
+	{
+		Name:          "Arcane Mage",
+		Race:          proto.Race_RaceTroll,
+		Class:         proto.Class_ClassMage,
+		Equipment:     core.GetGearSet("../ui/mage/gear_sets", "p4_arcane").GearSet,
+		TalentsString: "0550050210031531-054-203500001",
+		Rotation:      core.GetAplRotation("../ui/mage/apls", "p4_arcane").Rotation,
+		Spec: &proto.Player_Mage{
+			Mage: &proto.Mage{
+				Options: &proto.Mage_Options{
+					Armor: proto.Mage_Options_MoltenArmor,
+				},
+			},
+		},
+	},
+	{
+		Name:          "Fury Warrior",
+		Race:          proto.Race_RaceOrc,
+		Class:         proto.Class_ClassWarrior,
+		Equipment:     core.GetGearSet("../ui/warrior/gear_sets", "phase_4_dw").GearSet,
+		TalentsString: "20305020302-05050005525010051",
+		Rotation:      core.GetAplRotation("../ui/warrior/apls", "phase_4_fury").Rotation,
+		Spec: &proto.Player_Warrior{
+			Warrior: &proto.Warrior{
+				Options: &proto.Warrior_Options{
+					StartingRage:    50,
+					UseRecklessness: true,
+				},
+			},
+		},
+	},
+	{
+		Name:          "Affliction Warlock",
+		Race:          proto.Race_RaceOrc,
+		Class:         proto.Class_ClassWarlock,
+		Equipment:     core.GetGearSet("../ui/warlock/gear_sets/p4", "affliction").GearSet,
+		TalentsString: "4500253012201005--50502051020001",
+		Rotation:      core.GetAplRotation("../ui/warlock/apls/p4", "affliction").Rotation,
+		Spec: &proto.Player_Warlock{
+			Warlock: &proto.Warlock{
+				Options: &proto.WarlockOptions{
+					Armor:  proto.WarlockOptions_FelArmor,
+					Summon: proto.WarlockOptions_Imp,
+				},
+			},
+		},
+	},
+	{
+		Name:          "Elemental Shaman",
+		Race:          proto.Race_RaceTroll,
+		Class:         proto.Class_ClassShaman,
+		Equipment:     core.GetGearSet("../ui/elemental_shaman/gear_sets", "phase_4").GearSet,
+		TalentsString: "550031550000151--50105301005",
+		Rotation:      core.GetAplRotation("../ui/elemental_shaman/apls", "phase_4").Rotation,
+		Spec: &proto.Player_ElementalShaman{
+			ElementalShaman: &proto.ElementalShaman{
+				Options: &proto.ElementalShaman_Options{},
+			},
+		},
+	},
+	{
+		Name:          "Shadow Priest",
+		Race:          proto.Race_RaceTroll,
+		Class:         proto.Class_ClassPriest,
+		Equipment:     core.GetGearSet("../ui/shadow_priest/gear_sets", "phase_4").GearSet,
+		TalentsString: "0512301302--5002504103501251",
+		Rotation:      core.GetAplRotation("../ui/shadow_priest/apls", "phase_4").Rotation,
+		Spec: &proto.Player_ShadowPriest{
+			ShadowPriest: &proto.ShadowPriest{
+				Options: &proto.ShadowPriest_Options{
+					Armor: proto.ShadowPriest_Options_InnerFire,
+				},
+			},
+		},
+	},
+	{
+		Name:          "Feral Druid",
+		Race:          proto.Race_RaceTauren,
+		Class:         proto.Class_ClassDruid,
+		Equipment:     core.GetGearSet("../ui/feral_druid/gear_sets", "phase_4").GearSet,
+		TalentsString: "500005301-5500020323202151-15",
+		Rotation:      core.GetAplRotation("../ui/feral_druid/apls", "phase_4").Rotation,
+		Spec: &proto.Player_FeralDruid{
+			FeralDruid: &proto.FeralDruid{
+				Options: &proto.FeralDruid_Options{
+					InnervateTarget:   &proto.UnitReference{},
+					LatencyMs:         100,
+					AssumeBleedActive: true,
+				},
+			},
+		},
+	},
+}
+
+func iterationTestRequest(player *proto.Player) *proto.RaidSimRequest {
+	player.Level = 60
+	player.Consumes = &proto.Consumes{}
+	player.Buffs = core.FullBuffsPhase4.Player
+
+	return &proto.RaidSimRequest{
+		Raid:      core.SinglePlayerRaidProto(player, core.FullBuffsPhase4.Party, core.FullBuffsPhase4.Raid, core.FullBuffsPhase4.Debuffs),
+		Encounter: core.MakeSingleTargetEncounter(player.Level, 5),
+		// IsTest isn't set because it adds a lot of computation.
+		SimOptions: &proto.SimOptions{
+			Iterations: 1,
+			RandomSeed: 101,
+		},
+	}
+}
+
+func TestIterationAllocs(t *testing.T) {
+	for _, player := range iterationTestPlayers {
+		t.Run(player.Name, func(t *testing.T) {
+			core.IterationAllocsTest(t, iterationTestRequest(player))
+		})
+	}
+}
+
+func BenchmarkIteration(b *testing.B) {
+	for _, player := range iterationTestPlayers {
+		b.Run(player.Name, func(b *testing.B) {
+			core.IterationBenchmark(b, iterationTestRequest(player))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import "C"
 import (
 	"bytes"
 	"compress/zlib"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"log"
@@ -24,6 +25,7 @@ var _default_rsr = proto.RaidSimRequest{
 }
 var _active_sim = core.NewSim(&_default_rsr)
 var _active_seed int64 = 1
+var _snapshots = []*core.Snapshot{}
 var _aura_labels = []string{}
 var _target_aura_labels = []string{}
 
@@ -36,7 +38,7 @@ func runSim(json *C.char) *C.char {
 		log.Fatalf("failed to load input json file: %s", err)
 	}
 	sim.RegisterAll()
-	result := core.RunSim(input, nil)
+	result := core.RunSim(context.Background(), input, nil)
 	out, err := protojson.Marshal(result)
 	if err != nil {
 		panic(err)
@@ -117,6 +119,12 @@ func getDatabase(itemIds *int32, numItems int32, enchantIds *int32, numEnchants
 			WeaponDamageMax:  item.WeaponDamageMax,
 			WeaponSpeed:      item.SwingSpeed,
 			SetName:          item.SetName,
+			Ilvl:             item.Ilvl,
+			Phase:            item.Phase,
+			Quality:          item.Quality,
+			Unique:           item.Unique,
+			Sources:          item.Sources,
+			SourceZoneIds:    item.SourceZoneIDs,
 		}
 	}
 	for i, enchantId := range eids {
@@ -143,6 +151,7 @@ func new(json *C.char) {
 	}
 	sim.RegisterAll()
 	_active_sim = core.NewSim(input)
+	_snapshots = _snapshots[:0]
 	_active_sim.Reseed(_active_seed)
 	_active_seed += 1
 	_active_sim.Reset()
@@ -166,20 +175,52 @@ func trySpell(act int) bool {
 		if aura.IsActive() {
 			return false
 		}
-		aura.Activate(_active_sim)
+		_active_sim.Input(func(sim *core.Simulation) {
+			aura.Activate(sim)
+		})
 		return true
 	}
 	// End of Heroic strike hack
 
 	if spell.CanCast(_active_sim, target) {
-		casted = spell.Cast(_active_sim, target)
-		if casted && spell.CurCast.GCD > 0 {
-			_active_sim.NeedsInput = false
-		}
+		// Casts go through Input, so that snapshots can replay them.
+		_active_sim.Input(func(sim *core.Simulation) {
+			casted = spell.Cast(sim, target)
+			if casted && spell.CurCast.GCD > 0 {
+				sim.NeedsInput = false
+			}
+		})
 	}
 	return casted
 }
 
+//export takeSnapshot
+func takeSnapshot() int {
+	_snapshots = append(_snapshots, _active_sim.Snapshot())
+	return len(_snapshots) - 1
+}
+
+//export restoreSnapshot
+func restoreSnapshot(snapshot int) (restored bool) {
+	if snapshot >= len(_snapshots) || snapshot < 0 {
+		return false
+	}
+	// Restoring panics if the replayed iteration diverged from the snapshot.
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("Failed to restore snapshot: %v", err)
+			restored = false
+		}
+	}()
+	_active_sim.Restore(_snapshots[snapshot])
+	return true
+}
+
+//export reseedContinuation
+func reseedContinuation(seed int64) {
+	_active_sim.ReseedContinuation(seed)
+}
+
 //export doNothing
 func doNothing() bool {
 	return true
@@ -86,9 +86,7 @@ func (mage *Mage) registerBalefireBoltSpell() {
 			balefireAura.Activate(sim)
 			balefireAura.AddStack(sim)
 
-			spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-				spell.DealDamage(sim, result)
-			})
+			spell.DealDamageAfterTravelTime(sim, result)
 		},
 	})
 }
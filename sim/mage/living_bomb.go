@@ -65,7 +65,7 @@ func (mage *Mage) registerLivingBombSpell() {
 			Aura: core.Aura{
 				Label: "Living Bomb (DoT)",
 				OnExpire: func(aura *core.Aura, sim *core.Simulation) {
-					for _, aoeTarget := range sim.Encounter.TargetUnits {
+					for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 						livingBombExplosionSpell.Cast(sim, aoeTarget)
 					}
 				},
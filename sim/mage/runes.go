@@ -295,7 +295,7 @@ func (mage *Mage) applyMissileBarrage() {
 		OnGain: func(aura *core.Aura, sim *core.Simulation) {
 			core.Each(arcaneMissilesSpells, func(spell *core.Spell) {
 				spell.CostMultiplier -= 100
-				for _, target := range sim.Encounter.TargetUnits {
+				for _, target := range sim.Encounter.ActiveTargetUnits {
 					spell.Dot(target).TickLength /= 2
 				}
 			})
@@ -303,7 +303,7 @@ func (mage *Mage) applyMissileBarrage() {
 		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
 			core.Each(arcaneMissilesSpells, func(spell *core.Spell) {
 				spell.CostMultiplier += 100
-				for _, target := range sim.Encounter.TargetUnits {
+				for _, target := range sim.Encounter.ActiveTargetUnits {
 					spell.Dot(target).TickLength *= 2
 				}
 			})
@@ -0,0 +1,86 @@
+package paladin
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func (paladin *Paladin) registerBeaconOfLight() {
+	if !paladin.hasRune(proto.PaladinRune_RuneHandsBeaconOfLight) {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: int32(proto.PaladinRune_RuneHandsBeaconOfLight)}
+
+	// The heal copied to the Beacon, which was already modified by the original heal.
+	var beaconHealing float64
+	beaconHeal := paladin.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID.WithTag(1),
+		SpellSchool: core.SpellSchoolHoly,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       core.SpellFlagHelpful | core.SpellFlagIgnoreModifiers,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			spell.CalcAndDealHealing(sim, target, beaconHealing, spell.OutcomeHealing)
+		},
+	})
+
+	beaconAuras := paladin.NewRaidAuraArray(func(unit *core.Unit) *core.Aura {
+		return unit.RegisterAura(core.Aura{
+			Label:    "Beacon of Light",
+			ActionID: actionID,
+			Duration: time.Minute,
+		})
+	})
+
+	// Only one target can be the Beacon of Light at a time. The paladin keeps
+	// track of it with their own aura, which copies the heals to the Beacon.
+	var beaconAura *core.Aura
+	casterAura := paladin.RegisterAura(core.Aura{
+		Label:    "Beacon of Light (Caster)",
+		ActionID: actionID.WithTag(2),
+		Duration: time.Minute,
+		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
+			beaconAura.Deactivate(sim)
+		},
+		OnHealDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+			if !isHolyLightOrShock(spell) || result.Target == beaconAura.Unit {
+				return
+			}
+			beaconHealing = result.Damage
+			beaconHeal.Cast(sim, beaconAura.Unit)
+		},
+	})
+
+	paladin.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolHoly,
+		ProcMask:    core.ProcMaskEmpty,
+		Flags:       core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCost: 0.35,
+		},
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if beaconAura != nil {
+				beaconAura.Deactivate(sim)
+			}
+			beaconAura = beaconAuras.Get(target)
+			beaconAura.Activate(sim)
+			casterAura.Activate(sim)
+		},
+	})
+}
@@ -83,7 +83,7 @@ func (paladin *Paladin) registerConsecration() {
 				OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
 					// consecration ticks can miss, but those misses aren't logged as "resist"
 					outcomeApplier := core.Ternary(hasWrath, dot.OutcomeMagicHitAndSnapshotCrit, dot.Spell.OutcomeMagicHit)
-					for _, aoeTarget := range sim.Encounter.TargetUnits {
+					for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 						dot.CalcAndDealPeriodicSnapshotDamage(sim, aoeTarget, outcomeApplier)
 					}
 				},
@@ -13,7 +13,7 @@ func (paladin *Paladin) registerDivineFavor() {
 
 	var affectedSpells []*core.Spell
 	paladin.OnSpellRegistered(func(spell *core.Spell) {
-		if spell.SpellCode == SpellCode_PaladinHolyShock {
+		if isHolyLightOrShock(spell) {
 			affectedSpells = append(affectedSpells, spell)
 		}
 	})
@@ -46,6 +46,14 @@ func (paladin *Paladin) registerDivineFavor() {
 			cd.Set(sim.CurrentTime + cd.Duration)
 			paladin.UpdateMajorCooldowns()
 		},
+		OnHealDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+			if !isHolyLightOrShock(spell) {
+				return
+			}
+			aura.Deactivate(sim)
+			cd.Set(sim.CurrentTime + cd.Duration)
+			paladin.UpdateMajorCooldowns()
+		},
 	})
 
 	divineFavor := paladin.RegisterSpell(core.SpellConfig{
@@ -63,3 +71,9 @@ func (paladin *Paladin) registerDivineFavor() {
 		Type:  core.CooldownTypeDPS,
 	})
 }
+
+// Holy Shock, Holy Light and Flash of Light, the spells affected by Divine Favor
+// and Illumination.
+func isHolyLightOrShock(spell *core.Spell) bool {
+	return spell.SpellCode == SpellCode_PaladinHolyShock || spell.SpellCode == SpellCode_PaladinHolyLight || spell.SpellCode == SpellCode_PaladinFlashOfLight
+}
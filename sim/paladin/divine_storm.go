@@ -47,6 +47,7 @@ func (paladin *Paladin) registerDivineStorm() {
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			var totalDamageDealt float64
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				baseDamage := spell.Unit.MHWeaponDamage(sim, spell.MeleeAttackPower())
 				results[idx] = spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
@@ -41,6 +41,7 @@ func (paladin *Paladin) registerHammerOfTheRighteous() {
 			weapon := paladin.AutoAttacks.MH()
 			baseDamage := weapon.CalculateAverageWeaponDamage(spell.MeleeAttackPower()) / weapon.SwingSpeed
 
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMeleeSpecialHitAndCrit)
 				target = sim.Environment.NextTargetUnit(target)
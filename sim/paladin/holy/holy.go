@@ -14,7 +14,7 @@ func RegisterHolyPaladin() {
 			return NewHolyPaladin(character, options)
 		},
 		func(player *proto.Player, spec interface{}) {
-			playerSpec, ok := spec.(*proto.Player_HolyPaladin) // I don't really understand this line
+			playerSpec, ok := spec.(*proto.Player_HolyPaladin)
 			if !ok {
 				panic("Invalid spec value for Holy Paladin!")
 			}
@@ -27,19 +27,17 @@ func NewHolyPaladin(character *core.Character, options *proto.Player) *HolyPalad
 	holyOptions := options.GetHolyPaladin()
 
 	holy := &HolyPaladin{
-		Paladin: paladin.NewPaladin(character, options, holyOptions.Options.Aura),
-		Options: holyOptions.Options,
+		Paladin:     paladin.NewPaladin(character, options, holyOptions.Options.Aura),
+		primarySeal: holyOptions.Options.PrimarySeal,
 	}
 
-	holy.PaladinAura = holyOptions.Options.Aura
-
 	return holy
 }
 
 type HolyPaladin struct {
 	*paladin.Paladin
 
-	Options *proto.HolyPaladin_Options
+	primarySeal proto.PaladinSeal
 }
 
 func (holy *HolyPaladin) GetPaladin() *paladin.Paladin {
@@ -50,6 +48,7 @@ func (holy *HolyPaladin) Initialize() {
 	holy.Paladin.Initialize()
 }
 
-func (holy *HolyPaladin) Reset(sim *core.Simulation) {
-	holy.Paladin.Reset(sim)
+func (holy *HolyPaladin) Reset(_ *core.Simulation) {
+	holy.Paladin.ResetCurrentPaladinAura()
+	holy.Paladin.ResetPrimarySeal(holy.primarySeal)
 }
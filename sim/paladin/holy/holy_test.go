@@ -0,0 +1,78 @@
+package holy
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/sod/sim/common" // imported to get item effects included.
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func init() {
+	RegisterHolyPaladin()
+}
+
+func TestHoly(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:      proto.Class_ClassPaladin,
+			Level:      60,
+			Race:       proto.Race_RaceHuman,
+			OtherRaces: []proto.Race{proto.Race_RaceDwarf},
+
+			Talents:     Phase4Talents,
+			GearSet:     core.GetGearSet("../../../ui/holy_paladin/gear_sets", "phase_4"),
+			Rotation:    core.GetAplRotation("../../../ui/holy_paladin/apls", "phase_4"),
+			Buffs:       core.FullBuffsPhase4,
+			Consumes:    Phase4Consumes,
+			SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
+
+			IsHealer:   true,
+			RaidDamage: RaidDamage,
+
+			ItemFilter: ItemFilters,
+		},
+	}))
+}
+
+var Phase4Talents = "05503100520051--052030512"
+
+var PlayerOptionsStandard = &proto.Player_HolyPaladin{
+	HolyPaladin: &proto.HolyPaladin{
+		Options: &proto.HolyPaladin_Options{
+			Aura: proto.PaladinAura_DevotionAura,
+		},
+	},
+}
+
+var Phase4Consumes = core.ConsumesCombo{
+	Label: "Phase 4 Consumes",
+	Consumes: &proto.Consumes{
+		DefaultPotion: proto.Potions_MajorManaPotion,
+		Flask:         proto.Flask_FlaskOfDistilledWisdom,
+		Food:          proto.Food_FoodNightfinSoup,
+		MainHandImbue: proto.WeaponImbue_BrilliantManaOil,
+	},
+}
+
+var RaidDamage = &proto.RaidDamageModel{
+	DamagePerSecond:  400,
+	CadenceSeconds:   2,
+	CadenceVariation: 1,
+	School:           proto.SpellSchool_SpellSchoolPhysical,
+}
+
+var ItemFilters = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeShield,
+		proto.WeaponType_WeaponTypeSword,
+	},
+	ArmorType: proto.ArmorType_ArmorTypePlate,
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeLibram,
+	},
+}
@@ -27,15 +27,16 @@ func (paladin *Paladin) registerHolyShock() {
 	}
 
 	ranks := []struct {
-		level     int32
-		spellID   int32
-		manaCost  float64
-		minDamage float64
-		maxDamage float64
+		level       int32
+		spellID     int32
+		healSpellID int32
+		manaCost    float64
+		minDamage   float64
+		maxDamage   float64
 	}{
-		{level: 40, spellID: 20473, manaCost: 225, minDamage: 204, maxDamage: 220},
-		{level: 48, spellID: 20929, manaCost: 275, minDamage: 279, maxDamage: 301},
-		{level: 56, spellID: 20930, manaCost: 325, minDamage: 365, maxDamage: 395},
+		{level: 40, spellID: 20473, healSpellID: 25914, manaCost: 225, minDamage: 204, maxDamage: 220},
+		{level: 48, spellID: 20929, healSpellID: 25913, manaCost: 275, minDamage: 279, maxDamage: 301},
+		{level: 56, spellID: 20930, healSpellID: 25903, manaCost: 325, minDamage: 365, maxDamage: 395},
 	}
 
 	damageMultiplier := core.TernaryFloat64(hasInfusionOfLight, 1.5, 1.0)
@@ -99,5 +100,46 @@ func (paladin *Paladin) registerHolyShock() {
 				}
 			},
 		})
+
+		// The healing version shares the cooldown, and heals for the same amount.
+		paladin.RegisterSpell(core.SpellConfig{
+			ActionID:    core.ActionID{SpellID: rank.healSpellID},
+			SpellSchool: core.SpellSchoolHoly,
+			DefenseType: core.DefenseTypeMagic,
+			ProcMask:    core.ProcMaskSpellHealing,
+			Flags:       core.SpellFlagHelpful | core.SpellFlagAPL,
+
+			RequiredLevel: int(rank.level),
+			Rank:          i + 1,
+
+			SpellCode: SpellCode_PaladinHolyShock,
+
+			ManaCost: core.ManaCostOptions{
+				FlatCost:   rank.manaCost,
+				Multiplier: manaCostMultiplier,
+			},
+
+			Cast: core.CastConfig{
+				DefaultCast: core.Cast{
+					GCD: core.GCDDefault,
+				},
+				CD: *paladin.holyShockCooldown,
+			},
+
+			BonusCritRating: paladin.holyPower(),
+
+			DamageMultiplier: damageMultiplier,
+			ThreatMultiplier: 1,
+			BonusCoefficient: 0.429,
+
+			ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+				result := spell.CalcAndDealHealing(sim, target, sim.Roll(rank.minDamage, rank.maxDamage), spell.OutcomeHealingCrit)
+
+				if hasInfusionOfLight && result.Outcome.Matches(core.OutcomeCrit) {
+					paladin.AddMana(sim, rank.manaCost, manaMetrics)
+					paladin.holyShockCooldown.Reset()
+				}
+			},
+		})
 	}
 }
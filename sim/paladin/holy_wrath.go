@@ -79,7 +79,7 @@ func (paladin *Paladin) registerHolyWrath() {
 				spell.BonusCritRating += bonusCrit
 
 				results = results[:0]
-				for _, target := range paladin.Env.Encounter.TargetUnits {
+				for _, target := range sim.Encounter.ActiveTargetUnits {
 					if hasPurifyingPower || (target.MobType == proto.MobType_MobTypeDemon || target.MobType == proto.MobType_MobTypeUndead) {
 						damage := sim.Roll(minDamage, maxDamage)
 						result := spell.CalcDamage(sim, target, damage, spell.OutcomeMagicHitAndCrit)
@@ -16,6 +16,8 @@ const (
 	SpellCode_PaladinNone = iota
 	SpellCode_PaladinHolyShock
 	SpellCode_PaladinJudgementOfCommand
+	SpellCode_PaladinHolyLight
+	SpellCode_PaladinFlashOfLight
 )
 
 type Paladin struct {
@@ -93,6 +95,10 @@ func (paladin *Paladin) Initialize() {
 	paladin.registerAvengingWrath()
 	paladin.registerAuraMastery()
 
+	// Heals
+	paladin.registerHolyLight()
+	paladin.registerFlashOfLight()
+
 	paladin.lingerDuration = time.Millisecond * 400
 }
 
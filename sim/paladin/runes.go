@@ -15,10 +15,12 @@ func (paladin *Paladin) ApplyRunes() {
 	paladin.registerShockAndAwe()
 	paladin.registerRV()
 
+	// "RuneWaistInfusionOfLight" is handled in Holy Shock
 	// "RuneHeadFanaticism" is handled in Exorcism, Holy Shock, SoC, and SoR
 	// "RuneHeadWrath" is handled in Exorcism, Holy Shock, Consecration (and Holy Wrath once implemented)
 
 	paladin.registerHammerOfTheRighteous()
+	paladin.registerBeaconOfLight()
 	// "RuneWristImprovedHammerOfWrath" is handled Hammer of Wrath
 	// "RuneWristPurifyingPower" is handled in Exorcism
 }
@@ -32,6 +32,9 @@ func (paladin *Paladin) ApplyTalents() {
 	if paladin.Talents.Vindication > 0 {
 		paladin.applyVindication()
 	}
+	if paladin.Talents.Illumination > 0 {
+		paladin.applyIllumination()
+	}
 	// paladin.applyRighteousVengeance()
 	// paladin.applyRedoubt()
 	// paladin.applyReckoning()
@@ -42,6 +45,31 @@ func (paladin *Paladin) holyPower() float64 {
 	return core.SpellCritRatingPerCritChance * float64(paladin.Talents.HolyPower)
 }
 
+func (paladin *Paladin) healingLight() float64 {
+	return 1 + 0.04*float64(paladin.Talents.HealingLight)
+}
+
+func (paladin *Paladin) applyIllumination() {
+	procChance := 0.2 * float64(paladin.Talents.Illumination)
+	manaMetrics := paladin.NewManaMetrics(core.ActionID{SpellID: 20237})
+
+	paladin.RegisterAura(core.Aura{
+		Label:    "Illumination",
+		Duration: core.NeverExpires,
+		OnReset: func(aura *core.Aura, sim *core.Simulation) {
+			aura.Activate(sim)
+		},
+		OnHealDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+			if !isHolyLightOrShock(spell) || !result.Outcome.Matches(core.OutcomeCrit) {
+				return
+			}
+			if sim.Proc(procChance, "Illumination") {
+				paladin.AddMana(sim, spell.DefaultCast.Cost, manaMetrics)
+			}
+		},
+	})
+}
+
 func (paladin *Paladin) improvedSoR() float64 {
 	return []float64{1, 1.03, 1.06, 1.09, 1.12, 1.15}[paladin.Talents.ImprovedSealOfRighteousness]
 }
@@ -1,40 +1,56 @@
 package priest
 
-// func (priest *Priest) registerCircleOfHealingSpell() {
-// 	targets := priest.Env.Raid.GetFirstNPlayersOrPets(5)
-
-// 	priest.CircleOfHealing = priest.RegisterSpell(core.SpellConfig{
-// 		ActionID:    core.ActionID{SpellID: 401946},
-// 		SpellSchool: core.SpellSchoolHoly,
-//      DefenseType: core.DefenseTypeMagic,
-// 		ProcMask:    core.ProcMaskSpellHealing,
-// 		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
-
-// 		ManaCost: core.ManaCostOptions{
-// 			BaseCost:   0.21,
-// 			Multiplier: 1,
-// 		},
-// 		Cast: core.CastConfig{
-// 			DefaultCast: core.Cast{
-// 				GCD: core.GCDDefault,
-// 			},
-// 			CD: core.Cooldown{
-// 				Timer:    priest.NewTimer(),
-// 				Duration: time.Second * 6,
-// 			},
-// 		},
-
-// 		BonusCritRating:  float64(priest.Talents.HolySpecialization) * 1 * core.CritRatingPerCritChance,
-
-// 		DamageMultiplier: 1 + .02*float64(priest.Talents.SpiritualHealing),
-// 		ThreatMultiplier: 1 - []float64{0, .07, .14, .20}[priest.Talents.SilentResolve],
-
-// 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-// 			healFromSP := 0.4029 * spell.HealingPower(target)
-// 			for _, aoeTarget := range targets {
-// 				baseHealing := sim.Roll(958, 1058) + healFromSP
-// 				spell.CalcAndDealHealing(sim, aoeTarget, baseHealing, spell.OutcomeHealingCrit)
-// 			}
-// 		},
-// 	})
-// }
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+const CircleOfHealingTargets = 5
+
+func (priest *Priest) registerCircleOfHealingSpell() {
+	if !priest.HasRune(proto.PriestRune_RuneHandsCircleOfHealing) {
+		return
+	}
+
+	baseHealingLow := priest.baseRuneAbilityHealing() * .81
+	baseHealingHigh := priest.baseRuneAbilityHealing() * .94
+	spellCoeff := .15
+	manaCost := .21
+	cooldown := time.Second * 6
+
+	priest.CircleOfHealing = priest.RegisterSpell(core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: int32(proto.PriestRune_RuneHandsCircleOfHealing)},
+		SpellCode:   SpellCode_PriestCircleOfHealing,
+		SpellSchool: core.SpellSchoolHoly,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCost: manaCost,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    priest.NewTimer(),
+				Duration: cooldown,
+			},
+		},
+
+		BonusCoefficient: spellCoeff,
+
+		DamageMultiplier: priest.spiritualHealingModifier(),
+		ThreatMultiplier: 1,
+
+		// Heals the target and the most injured players around them.
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			for _, healTarget := range sim.Raid.SmartHealTargets(target, sim.Raid.AllPlayerUnits, CircleOfHealingTargets) {
+				spell.CalcAndDealHealing(sim, healTarget, sim.Roll(baseHealingLow, baseHealingHigh), spell.OutcomeHealingCrit)
+			}
+		},
+	})
+}
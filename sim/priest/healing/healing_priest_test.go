@@ -1,7 +1,10 @@
 package healing
 
 import (
+	"testing"
+
 	_ "github.com/wowsims/sod/sim/common" // imported to get caster sets included.
+	"github.com/wowsims/sod/sim/core"
 	"github.com/wowsims/sod/sim/core/proto"
 )
 
@@ -9,92 +12,87 @@ func init() {
 	RegisterHealingPriest()
 }
 
-// TODO: Classic
-// func TestDisc(t *testing.T) {
-// 	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
-// 		Class:    proto.Class_ClassPriest,
-// 		Race:     proto.Race_RaceUndead,
-// 		IsHealer: true,
-
-// 		GearSet:     core.GetGearSet("../../../ui/healing_priest/gear_sets", "p1_disc"),
-// 		Talents:     DiscTalents,
-// 		Consumes:    FullConsumes,
-// 		SpecOptions: core.SpecOptionsCombo{Label: "Disc", SpecOptions: PlayerOptionsDisc},
-// 		Rotation:    core.GetAplRotation("../../../ui/healing_priest/apls", "disc"),
-
-// 		ItemFilter: core.ItemFilter{
-// 			WeaponTypes: []proto.WeaponType{
-// 				proto.WeaponType_WeaponTypeDagger,
-// 				proto.WeaponType_WeaponTypeMace,
-// 				proto.WeaponType_WeaponTypeOffHand,
-// 				proto.WeaponType_WeaponTypeStaff,
-// 			},
-// 			ArmorType: proto.ArmorType_ArmorTypeCloth,
-// 			RangedWeaponTypes: []proto.RangedWeaponType{
-// 				proto.RangedWeaponType_RangedWeaponTypeWand,
-// 			},
-// 		},
-
-// 		EPReferenceStat: proto.Stat_StatSpellPower,
-// 		StatsToWeigh: []proto.Stat{
-// 			proto.Stat_StatIntellect,
-// 			proto.Stat_StatSpellPower,
-// 			proto.Stat_StatSpellHaste,
-// 			proto.Stat_StatSpellCrit,
-// 		},
-// 	}))
-// }
-
-// func TestHoly(t *testing.T) {
-// 	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
-// 		Class:    proto.Class_ClassPriest,
-// 		Race:     proto.Race_RaceUndead,
-// 		IsHealer: true,
-
-// 		GearSet:     core.GetGearSet("../../../ui/healing_priest/gear_sets", "p1_holy"),
-// 		Talents:     HolyTalents,
-// 		Consumes:    FullConsumes,
-// 		SpecOptions: core.SpecOptionsCombo{Label: "Holy", SpecOptions: PlayerOptionsHoly},
-// 		Rotation:    core.GetAplRotation("../../../ui/healing_priest/apls", "holy"),
-
-// 		ItemFilter: core.ItemFilter{
-// 			WeaponTypes: []proto.WeaponType{
-// 				proto.WeaponType_WeaponTypeDagger,
-// 				proto.WeaponType_WeaponTypeMace,
-// 				proto.WeaponType_WeaponTypeOffHand,
-// 				proto.WeaponType_WeaponTypeStaff,
-// 			},
-// 			ArmorType: proto.ArmorType_ArmorTypeCloth,
-// 			RangedWeaponTypes: []proto.RangedWeaponType{
-// 				proto.RangedWeaponType_RangedWeaponTypeWand,
-// 			},
-// 		},
-// 	}))
-// }
-
-var DiscTalents = "0503203130300512301313231251-2351010303"
-var HolyTalents = "05032031103-234051032002152530004311051"
-
-var FullConsumes = &proto.Consumes{
-	Flask: proto.Flask_FlaskUnknown,
-	Food:  proto.Food_FoodUnknown,
+func TestDisc(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:      proto.Class_ClassPriest,
+			Level:      60,
+			Race:       proto.Race_RaceUndead,
+			OtherRaces: []proto.Race{proto.Race_RaceDwarf},
+
+			Talents:     Phase4DiscTalents,
+			GearSet:     core.GetGearSet("../../../ui/healing_priest/gear_sets", "phase_4_disc"),
+			Rotation:    core.GetAplRotation("../../../ui/healing_priest/apls", "disc"),
+			Buffs:       core.FullBuffsPhase4,
+			Consumes:    Phase4Consumes,
+			SpecOptions: core.SpecOptionsCombo{Label: "Disc", SpecOptions: PlayerOptionsBasic},
+
+			IsHealer:   true,
+			RaidDamage: RaidDamage,
+
+			ItemFilter: ItemFilters,
+		},
+	}))
 }
 
-var PlayerOptionsDisc = &proto.Player_HealingPriest{
-	HealingPriest: &proto.HealingPriest{
-		Options: &proto.HealingPriest_Options{
-			UseInnerFire:      true,
-			UseShadowfiend:    true,
-			RapturesPerMinute: 5,
+func TestHoly(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:      proto.Class_ClassPriest,
+			Level:      60,
+			Race:       proto.Race_RaceUndead,
+			OtherRaces: []proto.Race{proto.Race_RaceDwarf},
+
+			Talents:     Phase4HolyTalents,
+			GearSet:     core.GetGearSet("../../../ui/healing_priest/gear_sets", "phase_4_holy"),
+			Rotation:    core.GetAplRotation("../../../ui/healing_priest/apls", "holy"),
+			Buffs:       core.FullBuffsPhase4,
+			Consumes:    Phase4Consumes,
+			SpecOptions: core.SpecOptionsCombo{Label: "Holy", SpecOptions: PlayerOptionsBasic},
+
+			IsHealer:   true,
+			RaidDamage: RaidDamage,
+
+			ItemFilter: ItemFilters,
 		},
-	},
+	}))
 }
 
-var PlayerOptionsHoly = &proto.Player_HealingPriest{
+var Phase4DiscTalents = "000030135050515-03505003030215"
+var Phase4HolyTalents = "0000001305051-035050030301155"
+
+var PlayerOptionsBasic = &proto.Player_HealingPriest{
 	HealingPriest: &proto.HealingPriest{
-		Options: &proto.HealingPriest_Options{
-			UseInnerFire:   true,
-			UseShadowfiend: true,
-		},
+		Options: &proto.HealingPriest_Options{},
+	},
+}
+
+var Phase4Consumes = core.ConsumesCombo{
+	Label: "Phase 4 Consumes",
+	Consumes: &proto.Consumes{
+		DefaultPotion: proto.Potions_MajorManaPotion,
+		Flask:         proto.Flask_FlaskOfDistilledWisdom,
+		Food:          proto.Food_FoodNightfinSoup,
+		MainHandImbue: proto.WeaponImbue_BrilliantManaOil,
+	},
+}
+
+var RaidDamage = &proto.RaidDamageModel{
+	DamagePerSecond:  400,
+	CadenceSeconds:   2,
+	CadenceVariation: 1,
+	School:           proto.SpellSchool_SpellSchoolPhysical,
+}
+
+var ItemFilters = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeWand,
 	},
 }
@@ -13,6 +13,9 @@ type Homunculus struct {
 	npcID        int32
 	Priest       *Priest
 	PrimarySpell *core.Spell
+
+	// The debuff applied by PrimarySpell, for each target.
+	debuffAuras core.AuraArray
 }
 
 func (priest *Priest) NewHomunculus(idx int32, npcID int32) *Homunculus {
@@ -98,7 +101,7 @@ func (homunculus *Homunculus) newHomunculusCrippleSpell() core.SpellConfig {
 		},
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			core.HomunculiAttackSpeedAura(target, homunculus.Priest.Level).Activate(sim)
+			homunculus.debuffAuras.Get(target).Activate(sim)
 		},
 	}
 }
@@ -117,7 +120,7 @@ func (homunculus *Homunculus) newHomunculusDegradeSpell() core.SpellConfig {
 		},
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			core.HomunculiArmorAura(target, homunculus.Priest.Level).Activate(sim)
+			homunculus.debuffAuras.Get(target).Activate(sim)
 		},
 	}
 }
@@ -136,12 +139,24 @@ func (homunculus *Homunculus) newHomunculusDemoralizeSpell() core.SpellConfig {
 		},
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			core.HomunculiAttackPowerAura(target, homunculus.Priest.Level).Activate(sim)
+			homunculus.debuffAuras.Get(target).Activate(sim)
 		},
 	}
 }
 
 func (homunculus *Homunculus) Initialize() {
+	var makeDebuffAura func(target *core.Unit, playerLevel int32) *core.Aura
+	switch homunculus.npcID {
+	case 202390:
+		makeDebuffAura = core.HomunculiAttackSpeedAura
+	case 202392:
+		makeDebuffAura = core.HomunculiArmorAura
+	case 202391:
+		makeDebuffAura = core.HomunculiAttackPowerAura
+	}
+	homunculus.debuffAuras = homunculus.NewEnemyAuraArray(func(target *core.Unit, _ int32) *core.Aura {
+		return makeDebuffAura(target, homunculus.Priest.Level)
+	})
 }
 
 func (homunculus *Homunculus) ExecuteCustomRotation(sim *core.Simulation) {
@@ -96,7 +96,12 @@ var ItemSetDawnProphecy = core.NewItemSet(core.ItemSet{
 	Bonuses: map[int32]core.ApplyEffect{
 		// -0.1 sec to the casting time of Flash Heal and -0.1 sec to the casting time of Greater Heal.
 		2: func(agent core.Agent) {
-			// Nothing to do
+			c := agent.GetCharacter()
+			c.OnSpellRegistered(func(spell *core.Spell) {
+				if spell.SpellCode == SpellCode_PriestFlashHeal || spell.SpellCode == SpellCode_PriestGreaterHeal {
+					spell.DefaultCast.CastTime -= time.Millisecond * 100
+				}
+			})
 		},
 		// Increases your critical strike chance with spells and attacks by 2%.
 		4: func(agent core.Agent) {
@@ -108,7 +113,12 @@ var ItemSetDawnProphecy = core.NewItemSet(core.ItemSet{
 		},
 		// Increases your critical strike chance with Prayer of Healing and Circle of Healing by 25%.
 		6: func(agent core.Agent) {
-			// Nothing to do
+			c := agent.GetCharacter()
+			c.OnSpellRegistered(func(spell *core.Spell) {
+				if spell.SpellCode == SpellCode_PriestPrayerOfHealing || spell.SpellCode == SpellCode_PriestCircleOfHealing {
+					spell.BonusCritRating += 25 * core.CritRatingPerCritChance
+				}
+			})
 		},
 	},
 })
@@ -61,7 +61,7 @@ func (priest *Priest) newMindSearSpellConfig(tickIdx int32) core.SpellConfig {
 			NumberOfTicks: numTicks,
 			TickLength:    tickLength,
 			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
-				for _, aoeTarget := range sim.Encounter.TargetUnits {
+				for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 					mindSearTickSpell.Cast(sim, aoeTarget)
 					mindSearTickSpell.SpellMetrics[target.UnitIndex].Casts -= 1
 				}
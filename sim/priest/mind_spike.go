@@ -27,6 +27,16 @@ func (priest *Priest) newMindSpikeSpellConfig() core.SpellConfig {
 		return priest.newMindSpikeAura(unit)
 	})
 
+	onLanding := func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+		spell.DealDamage(sim, result)
+
+		if result.Landed() {
+			priest.AddShadowWeavingStack(sim, result.Target)
+			priest.MindSpikeAuras.Get(result.Target).Activate(sim)
+			priest.MindSpikeAuras.Get(result.Target).AddStack(sim)
+		}
+	}
+
 	return core.SpellConfig{
 		SpellCode:   SpellCode_PriestMindSpike,
 		ActionID:    core.ActionID{SpellID: int32(proto.PriestRune_RuneWaistMindSpike)},
@@ -62,15 +72,7 @@ func (priest *Priest) newMindSpikeSpellConfig() core.SpellConfig {
 			baseDamage := sim.Roll(baseDamageLow, baseDamageHigh)
 			result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMagicHitAndCrit)
 
-			spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-				spell.DealDamage(sim, result)
-
-				if result.Landed() {
-					priest.AddShadowWeavingStack(sim, target)
-					priest.MindSpikeAuras.Get(target).Activate(sim)
-					priest.MindSpikeAuras.Get(target).AddStack(sim)
-				}
-			})
+			spell.WaitTravelTimeWithResult(sim, result, onLanding)
 		},
 	}
 }
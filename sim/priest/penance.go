@@ -11,22 +11,27 @@ func (priest *Priest) RegisterPenanceSpell() {
 	if !priest.HasRune(proto.PriestRune_RuneHandsPenance) {
 		return
 	}
-	priest.Penance = priest.makePenanceSpell(false)
-	priest.PenanceHeal = priest.makePenanceSpell(true)
+
+	// The damage and healing versions share a cooldown.
+	cdTimer := priest.NewTimer()
+	priest.Penance = priest.makePenanceSpell(false, cdTimer)
+	priest.PenanceHeal = priest.makePenanceSpell(true, cdTimer)
 }
 
 // https://www.wowhead.com/classic/spell=402284/penance
 // https://www.wowhead.com/classic/news/patch-1-15-build-52124-ptr-datamining-season-of-discovery-runes-336044
-func (priest *Priest) makePenanceSpell(isHeal bool) *core.Spell {
+func (priest *Priest) makePenanceSpell(isHeal bool, cdTimer *core.Timer) *core.Spell {
 	baseDamage := priest.baseRuneAbilityDamage() * 1.28
 	baseHealing := priest.baseRuneAbilityHealing() * .85
 	spellCoeff := 0.285
 	manaCost := .16
 	cooldown := time.Second * 12
 
+	actionID := core.ActionID{SpellID: 402284}
 	var procMask core.ProcMask
 	flags := SpellFlagPriest | core.SpellFlagChanneled | core.SpellFlagAPL
 	if isHeal {
+		actionID = actionID.WithTag(1)
 		flags |= core.SpellFlagHelpful
 		procMask = core.ProcMaskSpellHealing
 	} else {
@@ -34,7 +39,7 @@ func (priest *Priest) makePenanceSpell(isHeal bool) *core.Spell {
 	}
 
 	return priest.RegisterSpell(core.SpellConfig{
-		ActionID:      core.ActionID{SpellID: 402284},
+		ActionID:      actionID,
 		SpellSchool:   core.SpellSchoolHoly,
 		DefenseType:   core.DefenseTypeMagic,
 		ProcMask:      procMask,
@@ -49,12 +54,12 @@ func (priest *Priest) makePenanceSpell(isHeal bool) *core.Spell {
 				GCD: core.GCDDefault,
 			},
 			CD: core.Cooldown{
-				Timer:    priest.NewTimer(),
+				Timer:    cdTimer,
 				Duration: cooldown,
 			},
 		},
 
-		DamageMultiplier: 1,
+		DamageMultiplier: core.TernaryFloat64(isHeal, priest.spiritualHealingModifier(), 1),
 		ThreatMultiplier: 0,
 
 		Dot: core.Ternary(!isHeal, core.DotConfig{
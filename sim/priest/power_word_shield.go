@@ -1,60 +1,91 @@
 package priest
 
-// func (priest *Priest) registerPowerWordShieldSpell() {
-// 	coeff := 0.8057
-
-// 	wsDuration := time.Second * 15
-
-// 	cd := core.Cooldown{}
-
-// 	priest.PowerWordShield = priest.RegisterSpell(core.SpellConfig{
-// 		ActionID:    core.ActionID{SpellID: 48066},
-// 		SpellSchool: core.SpellSchoolHoly,
-// 		ProcMask:    core.ProcMaskSpellHealing,
-// 		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
-
-// 		ManaCost: core.ManaCostOptions{
-// 			BaseCost:   0.23,
-// 			Multiplier: 1,
-// 		},
-// 		Cast: core.CastConfig{
-// 			DefaultCast: core.Cast{
-// 				GCD: core.GCDDefault,
-// 			},
-// 			CD: cd,
-// 		},
-// 		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
-// 			return !priest.WeakenedSouls.Get(target).IsActive()
-// 		},
-
-// 		DamageMultiplier: 1 *
-// 			(1 + .05*float64(priest.Talents.ImprovedPowerWordShield)) *
-// 			(1 + .02*float64(priest.Talents.SpiritualHealing)),
-// 		ThreatMultiplier: 1 - []float64{0, .07, .14, .20}[priest.Talents.SilentResolve],
-
-// 		Shield: core.ShieldConfig{
-// 			Aura: core.Aura{
-// 				Label:    "Power Word Shield",
-// 				Duration: time.Second * 30,
-// 			},
-// 		},
-
-// 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-// 			shieldAmount := 2230.0 + coeff*spell.HealingPower(target)
-// 			shield := spell.Shield(target)
-// 			shield.Apply(sim, shieldAmount)
-
-// 			weakenedSoul := priest.WeakenedSouls.Get(target)
-// 			weakenedSoul.Duration = wsDuration
-// 			weakenedSoul.Activate(sim)
-// 		},
-// 	})
-
-// 	priest.WeakenedSouls = priest.NewRaidAuraArray(func(target *core.Unit) *core.Aura {
-// 		return target.GetOrRegisterAura(core.Aura{
-// 			Label:    "Weakened Soul",
-// 			ActionID: core.ActionID{SpellID: 6788},
-// 			Duration: time.Second * 15,
-// 		})
-// 	})
-// }
+import (
+	"fmt"
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+)
+
+const PowerWordShieldRanks = 10
+
+var PowerWordShieldSpellId = [PowerWordShieldRanks + 1]int32{0, 17, 592, 600, 3747, 6065, 6066, 10898, 10899, 10900, 10901}
+var PowerWordShieldBaseAbsorb = [PowerWordShieldRanks + 1]float64{0, 44, 88, 158, 234, 301, 381, 484, 605, 763, 942}
+var PowerWordShieldSpellCoef = [PowerWordShieldRanks + 1]float64{0, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}
+var PowerWordShieldManaCost = [PowerWordShieldRanks + 1]float64{0, 45, 80, 130, 175, 210, 250, 300, 355, 425, 500}
+var PowerWordShieldLevel = [PowerWordShieldRanks + 1]int{0, 6, 12, 18, 24, 30, 36, 42, 48, 54, 60}
+
+func (priest *Priest) registerPowerWordShieldSpell() {
+	priest.WeakenedSouls = priest.NewRaidAuraArray(func(target *core.Unit) *core.Aura {
+		return target.GetOrRegisterAura(core.Aura{
+			Label:    "Weakened Soul",
+			ActionID: core.ActionID{SpellID: 6788},
+			Duration: time.Second * 15,
+		})
+	})
+
+	// All ranks share the cooldown
+	cdTimer := priest.NewTimer()
+
+	priest.PowerWordShield = make([]*core.Spell, PowerWordShieldRanks+1)
+
+	for rank := 1; rank <= PowerWordShieldRanks; rank++ {
+		config := priest.getPowerWordShieldConfig(rank, cdTimer)
+
+		if config.RequiredLevel <= int(priest.Level) {
+			priest.PowerWordShield[rank] = priest.GetOrRegisterSpell(config)
+		}
+	}
+}
+
+func (priest *Priest) getPowerWordShieldConfig(rank int, cdTimer *core.Timer) core.SpellConfig {
+	spellId := PowerWordShieldSpellId[rank]
+	baseAbsorb := PowerWordShieldBaseAbsorb[rank]
+	spellCoeff := PowerWordShieldSpellCoef[rank]
+	manaCost := PowerWordShieldManaCost[rank]
+	level := PowerWordShieldLevel[rank]
+
+	return core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: spellId},
+		SpellCode:   SpellCode_PriestPowerWordShield,
+		SpellSchool: core.SpellSchoolHoly,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		RequiredLevel: level,
+		Rank:          rank,
+
+		ManaCost: core.ManaCostOptions{
+			FlatCost: manaCost,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    cdTimer,
+				Duration: time.Second * 4,
+			},
+		},
+		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
+			return !priest.WeakenedSouls.Get(target).IsActive()
+		},
+
+		DamageMultiplier: 1 + .05*float64(priest.Talents.ImprovedPowerWordShield),
+		ThreatMultiplier: 1,
+
+		Shield: core.ShieldConfig{
+			Aura: core.Aura{
+				Label:    fmt.Sprintf("Power Word: Shield (Rank %d)", rank),
+				Duration: time.Second * 30,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			shieldAmount := baseAbsorb + spellCoeff*spell.HealingPower(target)
+			spell.Shield(target).Apply(sim, shieldAmount)
+			priest.WeakenedSouls.Get(target).Activate(sim)
+		},
+	}
+}
@@ -1,38 +1,77 @@
 package priest
 
-// func (priest *Priest) registerPrayerOfHealingSpell() {
-// 	priest.PrayerOfHealing = priest.RegisterSpell(core.SpellConfig{
-// 		ActionID:    core.ActionID{SpellID: 48072},
-// 		SpellSchool: core.SpellSchoolHoly,
-//      DefenseType: core.DefenseTypeMagic,
-// 		ProcMask:    core.ProcMaskSpellHealing,
-// 		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
-
-// 		ManaCost: core.ManaCostOptions{
-// 			BaseCost:   0.48,
-// 			Multiplier: 1,
-// 		},
-// 		Cast: core.CastConfig{
-// 			DefaultCast: core.Cast{
-// 				GCD:      core.GCDDefault,
-// 				CastTime: time.Second * 3,
-// 			},
-// 		},
-
-// 		BonusCritRating:  float64(priest.Talents.HolySpecialization),
-
-// 		DamageMultiplier: 1 + .02*float64(priest.Talents.SpiritualHealing),
-// 		ThreatMultiplier: 1 - []float64{0, .07, .14, .20}[priest.Talents.SilentResolve],
-
-// 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-// 			targetAgent := target.Env.Raid.GetPlayerFromUnitIndex(target.UnitIndex)
-// 			party := targetAgent.GetCharacter().Party
-
-// 			for _, partyAgent := range party.PlayersAndPets {
-// 				partyTarget := &partyAgent.GetCharacter().Unit
-// 				baseHealing := sim.Roll(2109, 2228) + 0.526*spell.HealingPower(partyTarget)
-// 				spell.CalcAndDealHealing(sim, partyTarget, baseHealing, spell.OutcomeHealingCrit)
-// 			}
-// 		},
-// 	})
-// }
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+)
+
+const PrayerOfHealingRanks = 5
+
+var PrayerOfHealingSpellId = [PrayerOfHealingRanks + 1]int32{0, 596, 996, 10960, 10961, 25316}
+var PrayerOfHealingBaseHealing = [PrayerOfHealingRanks + 1][]float64{{0}, {312, 333}, {458, 487}, {675, 713}, {939, 991}, {1041, 1099}}
+var PrayerOfHealingSpellCoef = [PrayerOfHealingRanks + 1]float64{0, 0.286, 0.286, 0.286, 0.286, 0.286}
+var PrayerOfHealingManaCost = [PrayerOfHealingRanks + 1]float64{0, 410, 560, 770, 1030, 1070}
+var PrayerOfHealingLevel = [PrayerOfHealingRanks + 1]int{0, 30, 40, 50, 60, 60}
+
+func (priest *Priest) registerPrayerOfHealingSpell() {
+	priest.PrayerOfHealing = make([]*core.Spell, PrayerOfHealingRanks+1)
+
+	for rank := 1; rank <= PrayerOfHealingRanks; rank++ {
+		config := priest.getPrayerOfHealingConfig(rank)
+
+		if config.RequiredLevel <= int(priest.Level) {
+			priest.PrayerOfHealing[rank] = priest.GetOrRegisterSpell(config)
+		}
+	}
+}
+
+func (priest *Priest) getPrayerOfHealingConfig(rank int) core.SpellConfig {
+	spellId := PrayerOfHealingSpellId[rank]
+	baseHealingLow := PrayerOfHealingBaseHealing[rank][0]
+	baseHealingHigh := PrayerOfHealingBaseHealing[rank][1]
+	spellCoeff := PrayerOfHealingSpellCoef[rank]
+	manaCost := PrayerOfHealingManaCost[rank]
+	level := PrayerOfHealingLevel[rank]
+
+	return core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: spellId},
+		SpellCode:   SpellCode_PriestPrayerOfHealing,
+		SpellSchool: core.SpellSchoolHoly,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		RequiredLevel: level,
+		Rank:          rank,
+
+		ManaCost: core.ManaCostOptions{
+			FlatCost:   manaCost,
+			Multiplier: 1 - .10*float64(priest.Talents.ImprovedPrayerOfHealing),
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD:      core.GCDDefault,
+				CastTime: time.Second * 3,
+			},
+		},
+
+		BonusCoefficient: spellCoeff,
+
+		DamageMultiplier: priest.spiritualHealingModifier(),
+		ThreatMultiplier: 1,
+
+		// Heals every member of the target's party.
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			targetAgent := sim.Raid.GetPlayerFromUnitIndex(target.UnitIndex)
+			if targetAgent == nil {
+				return
+			}
+
+			for _, partyAgent := range targetAgent.GetCharacter().Party.Players {
+				baseHealing := sim.Roll(baseHealingLow, baseHealingHigh)
+				spell.CalcAndDealHealing(sim, &partyAgent.GetCharacter().Unit, baseHealing, spell.OutcomeHealingCrit)
+			}
+		},
+	}
+}
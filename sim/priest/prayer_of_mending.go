@@ -1,108 +1,105 @@
 package priest
 
-// func (priest *Priest) registerPrayerOfMendingSpell() {
-// 	actionID := core.ActionID{SpellID: 48113}
-
-// 	pomAuras := make([]*core.Aura, len(priest.Env.AllUnits))
-// 	for _, unit := range priest.Env.AllUnits {
-// 		if !priest.IsOpponent(unit) {
-// 			pomAuras[unit.UnitIndex] = priest.makePrayerOfMendingAura(unit)
-// 		}
-// 	}
-
-// 	maxJumps := 5
-
-// 	var curTarget *core.Unit
-// 	var remainingJumps int
-// 	priest.ProcPrayerOfMending = func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-// 		baseHealing := 1043 + 0.8057*spell.HealingPower(target)
-// 		priest.PrayerOfMending.CalcAndDealHealing(sim, target, baseHealing, spell.OutcomeHealingCrit)
-
-// 		pomAuras[target.UnitIndex].Deactivate(sim)
-// 		curTarget = nil
-
-// 		// Bounce to new ally.
-// 		if remainingJumps == 0 {
-// 			return
-// 		}
-
-// 		// Find ally with lowest % HP and is not the current mending target.
-// 		var newTarget *core.Unit
-// 		for _, raidUnit := range priest.Env.Raid.AllUnits {
-// 			if raidUnit == target {
-// 				continue
-// 			}
-
-// 			if newTarget == nil || (raidUnit.HasHealthBar() && newTarget.HasHealthBar() && raidUnit.CurrentHealthPercent() < newTarget.CurrentHealthPercent()) {
-// 				newTarget = raidUnit
-// 			}
-// 		}
-
-// 		if newTarget != nil {
-// 			pomAuras[newTarget.UnitIndex].Activate(sim)
-// 			curTarget = newTarget
-// 			remainingJumps--
-// 		}
-// 	}
-
-// 	priest.PrayerOfMending = priest.RegisterSpell(core.SpellConfig{
-// 		ActionID:    actionID,
-// 		SpellSchool: core.SpellSchoolHoly,
-//      DefenseType: core.DefenseTypeMagic,
-// 		ProcMask:    core.ProcMaskSpellHealing,
-// 		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
-
-// 		ManaCost: core.ManaCostOptions{
-// 			BaseCost:   0.15,
-// 			Multiplier: 1,
-// 		},
-// 		Cast: core.CastConfig{
-// 			DefaultCast: core.Cast{
-// 				GCD: core.GCDDefault,
-// 			},
-// 			CD: core.Cooldown{
-// 				Timer:    priest.NewTimer(),
-// 				Duration: time.Second * 10,
-// 			},
-// 		},
-
-// 		BonusCritRating:  float64(priest.Talents.HolySpecialization) * 1 * core.CritRatingPerCritChance,
-
-// 		DamageMultiplier: 1 + .02*float64(priest.Talents.SpiritualHealing),
-// 		ThreatMultiplier: 1,
-
-// 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-// 			if curTarget != nil {
-// 				pomAuras[curTarget.UnitIndex].Deactivate(sim)
-// 			}
-
-// 			pomAuras[target.UnitIndex].Activate(sim)
-// 			curTarget = target
-// 			remainingJumps = maxJumps
-// 		},
-// 	})
-// }
-
-// func (priest *Priest) makePrayerOfMendingAura(target *core.Unit) *core.Aura {
-// 	autoProc := true
-
-// 	return target.RegisterAura(core.Aura{
-// 		Label:    "PrayerOfMending" + strconv.Itoa(int(priest.Index)),
-// 		Duration: time.Second * 30,
-// 		OnGain: func(aura *core.Aura, sim *core.Simulation) {
-// 			if autoProc {
-// 				core.StartDelayedAction(sim, core.DelayedActionOptions{
-// 					DoAt: sim.CurrentTime + time.Second*5,
-// 					OnAction: func(sim *core.Simulation) {
-// 						priest.ProcPrayerOfMending(sim, aura.Unit, priest.PrayerOfMending)
-// 					},
-// 				})
-// 			}
-// 		},
-// 		OnSpellHitTaken: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
-// 			if !autoProc && result.Damage > 0 {
-// 				priest.ProcPrayerOfMending(sim, aura.Unit, priest.PrayerOfMending)
-// 			}
-// 		},
-// 	})
-// }
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+const PrayerOfMendingCharges = 5
+
+// https://www.wowhead.com/classic/spell=401859/prayer-of-mending
+func (priest *Priest) registerPrayerOfMendingSpell() {
+	if !priest.HasRune(proto.PriestRune_RuneLegsPrayerOfMending) {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: int32(proto.PriestRune_RuneLegsPrayerOfMending)}
+	healAmount := priest.baseRuneAbilityHealing() * .82
+
+	healSpell := priest.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID.WithTag(1),
+		SpellSchool: core.SpellSchoolHoly,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagPriest | core.SpellFlagHelpful,
+
+		DamageMultiplier: priest.spiritualHealingModifier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: .429,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			spell.CalcAndDealHealing(sim, target, healAmount, spell.OutcomeHealingCrit)
+		},
+	})
+
+	var activeAura *core.Aura
+	var auras core.AuraArray
+
+	auras = priest.NewRaidAuraArray(func(unit *core.Unit) *core.Aura {
+		return unit.RegisterAura(core.Aura{
+			Label:     "Prayer of Mending",
+			ActionID:  actionID,
+			Duration:  time.Second * 30,
+			MaxStacks: PrayerOfMendingCharges,
+			OnSpellHitTaken: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+				if !result.Landed() || result.Damage <= 0 {
+					return
+				}
+				healSpell.Cast(sim, aura.Unit)
+
+				charges := aura.GetStacks() - 1
+				aura.Deactivate(sim)
+				activeAura = nil
+				if charges == 0 {
+					return
+				}
+
+				// Jumps to the most injured other raid member.
+				var jumpTarget *core.Unit
+				for _, unit := range sim.Raid.AllPlayerUnits {
+					if unit != aura.Unit && (jumpTarget == nil || unit.CurrentHealthPercent() < jumpTarget.CurrentHealthPercent()) {
+						jumpTarget = unit
+					}
+				}
+				if jumpTarget == nil {
+					return
+				}
+				activeAura = auras.Get(jumpTarget)
+				activeAura.Activate(sim)
+				activeAura.SetStacks(sim, charges)
+			},
+		})
+	})
+
+	priest.PrayerOfMending = priest.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolHoly,
+		ProcMask:    core.ProcMaskEmpty,
+		Flags:       SpellFlagPriest | core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCost: .15,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    priest.NewTimer(),
+				Duration: time.Second * 10,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			// Prayer of Mending can only be active on one target at a time.
+			if activeAura != nil {
+				activeAura.Deactivate(sim)
+			}
+			activeAura = auras.Get(target)
+			activeAura.Activate(sim)
+			activeAura.SetStacks(sim, PrayerOfMendingCharges)
+		},
+	})
+}
@@ -15,6 +15,7 @@ const (
 
 const (
 	SpellCode_PriestNone int32 = iota
+	SpellCode_PriestCircleOfHealing
 	SpellCode_PriestFlashHeal
 	SpellCode_PriestGreaterHeal
 	SpellCode_PriestHeal
@@ -22,6 +23,8 @@ const (
 	SpellCode_PriestMindBlast
 	SpellCode_PriestMindFlay
 	SpellCode_PriestMindSpike
+	SpellCode_PriestPowerWordShield
+	SpellCode_PriestPrayerOfHealing
 	SpellCode_PriestSmite
 	SpellCode_PriestVampiricTouch
 )
@@ -113,11 +116,11 @@ func (priest *Priest) Initialize() {
 }
 
 func (priest *Priest) RegisterHealingSpells() {
-	// priest.registerFlashHealSpell()
-	// priest.registerGreaterHealSpell()
-	// priest.registerPowerWordShieldSpell()
-	// priest.registerPrayerOfHealingSpell()
-	// priest.registerRenewSpell()
+	priest.registerFlashHealSpell()
+	priest.registerGreaterHealSpell()
+	priest.registerPowerWordShieldSpell()
+	priest.registerPrayerOfHealingSpell()
+	priest.registerRenewSpell()
 }
 
 func (priest *Priest) Reset(_ *core.Simulation) {
@@ -10,6 +10,7 @@ import (
 
 func (priest *Priest) ApplyRunes() {
 	// Head
+	priest.applyDivineAegis()
 	priest.registerEyeOfTheVoidCD()
 	priest.applyPainAndSuffering()
 
@@ -24,6 +25,7 @@ func (priest *Priest) ApplyRunes() {
 	priest.registerVoidZoneSpell()
 
 	// Hands
+	priest.registerCircleOfHealingSpell()
 	priest.registerMindSearSpell()
 	priest.RegisterPenanceSpell()
 	priest.registerShadowWordDeathSpell()
@@ -33,6 +35,7 @@ func (priest *Priest) ApplyRunes() {
 
 	// Legs
 	priest.registerHomunculiSpell()
+	priest.registerPrayerOfMendingSpell()
 
 	// Feet
 	priest.registerDispersionSpell()
@@ -41,6 +44,48 @@ func (priest *Priest) ApplyRunes() {
 	priest.registerShadowfiendSpell()
 }
 
+// Critical heals and all Penance heals shield the target for 30% of the amount healed.
+func (priest *Priest) applyDivineAegis() {
+	if !priest.HasRune(proto.PriestRune_RuneHelmDivineAegis) {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: int32(proto.PriestRune_RuneHelmDivineAegis)}
+
+	shieldSpell := priest.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolHoly,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagPriest | core.SpellFlagNoOnCastComplete | core.SpellFlagHelpful,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		Shield: core.ShieldConfig{
+			Aura: core.Aura{
+				Label:    "Divine Aegis",
+				Duration: time.Second * 15,
+			},
+		},
+	})
+
+	priest.RegisterAura(core.Aura{
+		Label:    "Divine Aegis Trigger",
+		Duration: core.NeverExpires,
+		OnReset: func(aura *core.Aura, sim *core.Simulation) {
+			aura.Activate(sim)
+		},
+		OnHealDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+			if spell == shieldSpell || result.Damage <= 0 {
+				return
+			}
+			if result.Outcome.Matches(core.OutcomeCrit) || spell == priest.PenanceHeal {
+				shieldSpell.Shield(result.Target).Apply(sim, result.Damage*0.3)
+			}
+		},
+	})
+}
+
 func (priest *Priest) applyPainAndSuffering() {
 	if !priest.HasRune(proto.PriestRune_RuneHelmPainAndSuffering) {
 		return
@@ -58,22 +103,21 @@ func (priest *Priest) applyPainAndSuffering() {
 				target := result.Target
 
 				var dotToRollover *core.Dot
-				dotSpells := core.FilterSlice(
-					core.Flatten([][]*core.Spell{priest.ShadowWordPain, {priest.VoidPlague, priest.VampiricTouch}}),
-					func(spell *core.Spell) bool {
-						return spell != nil && spell.Dot(target).IsActive()
-					},
-				)
-
-				if len(dotSpells) > 0 {
-					dotToRollover = dotSpells[0].Dot(target)
-					for _, spell := range dotSpells {
-						dot := spell.Dot(target)
-						if dot.RemainingDuration(sim) < dotToRollover.RemainingDuration(sim) {
-							dotToRollover = dot
-						}
+				considerDot := func(spell *core.Spell) {
+					if spell == nil {
+						return
+					}
+					if dot := spell.Dot(target); dot.IsActive() && (dotToRollover == nil || dot.RemainingDuration(sim) < dotToRollover.RemainingDuration(sim)) {
+						dotToRollover = dot
 					}
+				}
+				for _, spell := range priest.ShadowWordPain {
+					considerDot(spell)
+				}
+				considerDot(priest.VoidPlague)
+				considerDot(priest.VampiricTouch)
 
+				if dotToRollover != nil {
 					dotToRollover.NumberOfTicks = dotToRollover.OriginalNumberOfTicks
 					dotToRollover.Rollover(sim)
 				}
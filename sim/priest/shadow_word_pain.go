@@ -97,6 +97,7 @@ func (priest *Priest) getShadowWordPainConfig(rank int) core.SpellConfig {
 		},
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcOutcome(sim, target, spell.OutcomeMagicHit)
 				target = sim.Environment.NextTargetUnit(target)
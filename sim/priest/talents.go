@@ -56,6 +56,10 @@ func (priest *Priest) darknessDamageModifier() float64 {
 	return 1 + .02*float64(priest.Talents.Darkness)
 }
 
+func (priest *Priest) spiritualHealingModifier() float64 {
+	return 1 + .02*float64(priest.Talents.SpiritualHealing)
+}
+
 func (priest *Priest) applyMentalAgility() {
 	if priest.Talents.MentalAgility == 0 {
 		return
@@ -74,7 +78,7 @@ func (priest *Priest) applyForceOfWill() {
 	}
 
 	priest.OnSpellRegistered(func(spell *core.Spell) {
-		if spell.Flags.Matches(SpellFlagPriest) {
+		if spell.Flags.Matches(SpellFlagPriest) && !spell.Flags.Matches(core.SpellFlagHelpful) {
 			spell.DamageMultiplier *= .01 * float64(priest.Talents.ForceOfWill)
 			spell.BonusCritRating += 1 * float64(priest.Talents.ForceOfWill) * core.CritRatingPerCritChance
 		}
@@ -98,6 +102,8 @@ func (priest *Priest) applyInspiration() {
 		return
 	}
 
+	affectedSpellCodes := []int32{SpellCode_PriestFlashHeal, SpellCode_PriestHeal, SpellCode_PriestGreaterHeal, SpellCode_PriestPrayerOfHealing}
+
 	auras := make([]*core.Aura, len(priest.Env.AllUnits))
 	for _, unit := range priest.Env.AllUnits {
 		if !priest.IsOpponent(unit) {
@@ -113,7 +119,7 @@ func (priest *Priest) applyInspiration() {
 			aura.Activate(sim)
 		},
 		OnHealDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
-			if slices.Contains([]int32{SpellCode_PriestFlashHeal, SpellCode_PriestHeal, SpellCode_PriestGreaterHeal}, spell.SpellCode) {
+			if result.Outcome.Matches(core.OutcomeCrit) && slices.Contains(affectedSpellCodes, spell.SpellCode) {
 				auras[result.Target.UnitIndex].Activate(sim)
 			}
 		},
@@ -60,7 +60,7 @@ func (priest *Priest) registerVoidZoneSpell() {
 				dot.Snapshot(target, baseTickDamage, isRollover)
 			},
 			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
-				for _, aoeTarget := range sim.Encounter.TargetUnits {
+				for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 					if hasDespairRune {
 						dot.CalcAndDealPeriodicSnapshotDamage(sim, aoeTarget, dot.OutcomeTickSnapshotCritCounted)
 					} else {
@@ -11,22 +11,22 @@ import (
 
 	"github.com/wowsims/sod/sim/druid/feral"
 	// restoDruid "github.com/wowsims/sod/sim/druid/restoration"
-	// feralTank "github.com/wowsims/sod/sim/druid/tank"
+	feralTank "github.com/wowsims/sod/sim/druid/tank"
 	_ "github.com/wowsims/sod/sim/encounters"
 	"github.com/wowsims/sod/sim/hunter"
 	"github.com/wowsims/sod/sim/mage"
 
-	// holyPaladin "github.com/wowsims/sod/sim/paladin/holy"
+	holyPaladin "github.com/wowsims/sod/sim/paladin/holy"
 	// protectionPaladin "github.com/wowsims/sod/sim/paladin/protection"
 	// "github.com/wowsims/sod/sim/paladin/retribution"
-	// healingPriest "github.com/wowsims/sod/sim/priest/healing"
+	healingPriest "github.com/wowsims/sod/sim/priest/healing"
 	"github.com/wowsims/sod/sim/priest/shadow"
 
-	// restoShaman "github.com/wowsims/sod/sim/shaman/restoration"
+	restoShaman "github.com/wowsims/sod/sim/shaman/restoration"
 	dpsWarlock "github.com/wowsims/sod/sim/warlock/dps"
 	tankWarlock "github.com/wowsims/sod/sim/warlock/tank"
 	dpsWarrior "github.com/wowsims/sod/sim/warrior/dps"
-	// protectionWarrior "github.com/wowsims/sod/sim/warrior/protection"
+	protectionWarrior "github.com/wowsims/sod/sim/warrior/protection"
 )
 
 var registered = false
@@ -39,20 +39,20 @@ func RegisterAll() {
 
 	balance.RegisterBalanceDruid()
 	feral.RegisterFeralDruid()
-	// feralTank.RegisterFeralTankDruid()
+	feralTank.RegisterFeralTankDruid()
 	// restoDruid.RegisterRestorationDruid()
 	elemental.RegisterElementalShaman()
 	enhancement.RegisterEnhancementShaman()
-	// restoShaman.RegisterRestorationShaman()
+	restoShaman.RegisterRestorationShaman()
 	hunter.RegisterHunter()
 	mage.RegisterMage()
-	// healingPriest.RegisterHealingPriest()
+	healingPriest.RegisterHealingPriest()
 	shadow.RegisterShadowPriest()
 	dpsrogue.RegisterDpsRogue()
 	tankrogue.RegisterTankRogue()
 	dpsWarrior.RegisterDpsWarrior()
-	// protectionWarrior.RegisterProtectionWarrior()
-	// holyPaladin.RegisterHolyPaladin()
+	protectionWarrior.RegisterProtectionWarrior()
+	holyPaladin.RegisterHolyPaladin()
 	// protectionPaladin.RegisterProtectionPaladin()
 	retribution.RegisterRetributionPaladin()
 	dpsWarlock.RegisterDpsWarlock()
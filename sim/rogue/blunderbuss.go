@@ -44,6 +44,7 @@ func (rogue *Rogue) registerBlunderbussSpell() {
 			rogue.BreakStealth(sim)
 			baseApDamage := spell.MeleeAttackPower() * 0.48
 
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcDamage(sim, target, rogue.rollBlunderbussDamage(sim)+baseApDamage, spell.OutcomeRangedHitAndCrit)
 				target = sim.Environment.NextTargetUnit(target)
@@ -82,7 +82,7 @@ func (rogue *Rogue) registerCrimsonTempestSpell() {
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			rogue.BreakStealth(sim)
 
-			for _, aoeTarget := range sim.Encounter.TargetUnits {
+			for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 				rogue.CrimsonTempestBleed.Cast(sim, aoeTarget)
 			}
 
@@ -35,7 +35,7 @@ func init() {
 			DamageMultiplier: 1,
 
 			ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-				for _, aoeTarget := range sim.Encounter.TargetUnits {
+				for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 					//Confirmed always hits through logs
 					spell.CalcAndDealDamage(sim, aoeTarget, 140, spell.OutcomeAlwaysHit)
 				}
@@ -63,12 +63,12 @@ func init() {
 			ActionID: core.ActionID{SpellID: 461252},
 			Label:    "Shadowflame Fury",
 			OnGain: func(aura *core.Aura, sim *core.Simulation) {
-				for _, target := range sim.Encounter.TargetUnits {
+				for _, target := range sim.Encounter.ActiveTargetUnits {
 					target.AddStatDynamic(sim, stats.Armor, -2000)
 				}
 			},
 			OnExpire: func(aura *core.Aura, sim *core.Simulation) {
-				for _, target := range sim.Encounter.TargetUnits {
+				for _, target := range sim.Encounter.ActiveTargetUnits {
 					target.AddStatDynamic(sim, stats.Armor, 2000)
 				}
 			},
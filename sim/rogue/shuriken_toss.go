@@ -46,6 +46,7 @@ func (rogue *Rogue) registerShurikenTossSpell() {
 			baseDamage := spell.MeleeAttackPower() * 0.50
 			var combopoints int32 = 0
 
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcDamage(sim, target, baseDamage, spell.OutcomeRangedHitAndCrit)
 				target = sim.Environment.NextTargetUnit(target)
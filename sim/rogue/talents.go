@@ -313,7 +313,7 @@ func (rogue *Rogue) registerBladeFlurryCD() {
 			rogue.MultiplyMeleeSpeed(sim, 1/1.2)
 		},
 		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
-			if sim.GetNumTargets() < 2 {
+			if sim.ActiveTargetCount() < 2 {
 				return
 			}
 			if result.Damage == 0 || !spell.ProcMask.Matches(core.ProcMaskMelee) {
@@ -73,6 +73,14 @@ func (shaman *Shaman) newGraceOfAirTotemSpellConfig(rank int) core.SpellConfig {
 
 	hasFeralSpirit := shaman.HasRune(proto.ShamanRune_RuneCloakFeralSpirit)
 
+	auras := []*core.Aura{core.GraceOfAirTotemAura(&shaman.Unit, shaman.Level, multiplier)}
+	if hasFeralSpirit {
+		auras = append(auras,
+			core.StrengthOfEarthTotemAura(&shaman.SpiritWolves.SpiritWolf1.Unit, shaman.Level, multiplier),
+			core.StrengthOfEarthTotemAura(&shaman.SpiritWolves.SpiritWolf2.Unit, shaman.Level, multiplier),
+		)
+	}
+
 	spell := shaman.newTotemSpellConfig(manaCost, spellId)
 	spell.RequiredLevel = level
 	spell.Rank = rank
@@ -80,10 +88,8 @@ func (shaman *Shaman) newGraceOfAirTotemSpellConfig(rank int) core.SpellConfig {
 		shaman.TotemExpirations[AirTotem] = sim.CurrentTime + duration
 		shaman.ActiveTotems[AirTotem] = spell
 
-		core.GraceOfAirTotemAura(&shaman.Unit, shaman.Level, multiplier).Activate(sim)
-		if hasFeralSpirit {
-			core.StrengthOfEarthTotemAura(&shaman.SpiritWolves.SpiritWolf1.Unit, shaman.Level, multiplier).Activate(sim)
-			core.StrengthOfEarthTotemAura(&shaman.SpiritWolves.SpiritWolf2.Unit, shaman.Level, multiplier).Activate(sim)
+		for _, aura := range auras {
+			aura.Activate(sim)
 		}
 	}
 	return spell
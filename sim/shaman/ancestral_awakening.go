@@ -27,3 +27,13 @@ func (shaman *Shaman) applyAncestralAwakening() {
 		},
 	})
 }
+
+// Heals the lowest health raid member for a portion of a critical heal.
+func (shaman *Shaman) procAncestralAwakening(sim *core.Simulation, result *core.SpellResult) {
+	if shaman.AncestralAwakening == nil {
+		return
+	}
+
+	shaman.ancestralHealingAmount = result.Damage * AncestralAwakeningHealMultiplier
+	shaman.AncestralAwakening.Cast(sim, sim.Raid.LowestHealthPlayer())
+}
@@ -100,23 +100,30 @@ func (shaman *Shaman) newChainHealSpellConfig(rank int, isOverload bool) core.Sp
 		BonusCoefficient: spellCoeff,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			targets := sim.Environment.Raid.GetFirstNPlayersOrPets(targetCount)
-			curTarget := targets[0]
 			origMult := spell.DamageMultiplier
-			// TODO: This bounces to most hurt friendly...
-			for hitIndex := 0; hitIndex < len(targets); hitIndex++ {
+			if !isOverload {
+				spell.DamageMultiplier *= shaman.consumeRiptide(sim, target)
+			}
+
+			// Bounces to the most injured raid members. Overloads are cast after
+			// the bounces, as they reuse the raid's smart heal target buffer.
+			numOverloads := 0
+			for _, curTarget := range sim.Raid.SmartHealTargets(target, sim.Raid.AllPlayerUnits, int(targetCount)) {
 				baseHealing := sim.Roll(baseHealingLow, baseHealingHigh)
 
 				result := spell.CalcAndDealHealing(sim, curTarget, baseHealing, spell.OutcomeHealingCrit)
 
 				if canOverload && result.Landed() && sim.RandomFloat("CH Overload") < ShamanOverloadChance {
-					shaman.ChainHealOverload[rank].Cast(sim, target)
+					numOverloads++
 				}
 
 				spell.DamageMultiplier *= bounceCoef
-				curTarget = targets[hitIndex]
 			}
 			spell.DamageMultiplier = origMult
+
+			for i := 0; i < numOverloads; i++ {
+				shaman.ChainHealOverload[rank].Cast(sim, target)
+			}
 		},
 	}
 
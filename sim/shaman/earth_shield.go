@@ -0,0 +1,97 @@
+package shaman
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+const EarthShieldCharges = 9
+
+func (shaman *Shaman) registerEarthShieldSpell() {
+	if !shaman.HasRune(proto.ShamanRune_RuneLegsEarthShield) {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: int32(proto.ShamanRune_RuneLegsEarthShield)}
+	healAmount := shaman.baseRuneAbilityDamage() * 1.5 * (1 + shaman.purificationHealingModifier())
+
+	healSpell := shaman.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID.WithTag(1),
+		SpellSchool: core.SpellSchoolNature,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagShaman | core.SpellFlagHelpful,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1 - (float64(shaman.Talents.HealingGrace) * 0.05),
+		BonusCoefficient: .286,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			spell.CalcAndDealHealing(sim, target, healAmount, spell.OutcomeHealing)
+		},
+	})
+
+	auras := shaman.NewRaidAuraArray(func(unit *core.Unit) *core.Aura {
+		// The heal can only occur once every few seconds.
+		icd := core.Cooldown{
+			Timer:    shaman.NewTimer(),
+			Duration: time.Millisecond * 3500,
+		}
+
+		return unit.RegisterAura(core.Aura{
+			Label:     "Earth Shield",
+			ActionID:  actionID,
+			Duration:  time.Minute * 10,
+			MaxStacks: EarthShieldCharges,
+			OnSpellHitTaken: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+				if !result.Landed() || result.Damage <= 0 || !icd.IsReady(sim) {
+					return
+				}
+				icd.Use(sim)
+				healSpell.Cast(sim, aura.Unit)
+				aura.RemoveStack(sim)
+			},
+		})
+	})
+
+	var activeAura *core.Aura
+
+	shaman.EarthShield = shaman.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolNature,
+		ProcMask:    core.ProcMaskEmpty,
+		Flags:       SpellFlagShaman | core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCost: .15,
+		},
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			// Earth Shield can only be active on one target at a time.
+			if activeAura != nil {
+				activeAura.Deactivate(sim)
+			}
+			activeAura = auras.Get(target)
+
+			// On the shaman it replaces the other elemental shields.
+			if target == &shaman.Unit {
+				if shaman.ActiveShieldAura != nil {
+					shaman.ActiveShieldAura.Deactivate(sim)
+				}
+				shaman.ActiveShield = spell
+				shaman.ActiveShieldAura = activeAura
+			}
+
+			activeAura.Activate(sim)
+			activeAura.SetStacks(sim, EarthShieldCharges)
+		},
+	})
+}
@@ -101,13 +101,7 @@ func (shaman *Shaman) newHealingWaveSpellConfig(rank int, isOverload bool) core.
 			}
 
 			if result.Outcome.Matches(core.OutcomeCrit) {
-				if shaman.HasRune(proto.ShamanRune_RuneFeetAncestralAwakening) {
-					shaman.ancestralHealingAmount = result.Damage * AncestralAwakeningHealMultiplier
-
-					// TODO: this should actually target the lowest health target in the raid.
-					//  does it matter in a sim? We currently only simulate tanks taking damage (multiple tanks could be handled here though.)
-					shaman.AncestralAwakening.Cast(sim, target)
-				}
+				shaman.procAncestralAwakening(sim, result)
 			}
 		},
 	}
@@ -34,6 +34,14 @@ func (shaman *Shaman) newLavaBurstSpellConfig(isOverload bool) core.SpellConfig
 
 	canOverload := !isOverload && shaman.HasRune(proto.ShamanRune_RuneChestOverload)
 
+	onLanding := func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+		spell.DealDamage(sim, result)
+
+		if canOverload && result.Landed() && sim.RandomFloat("LvB Overload") < ShamanOverloadChance {
+			shaman.LavaBurstOverload.Cast(sim, result.Target)
+		}
+	}
+
 	spell := core.SpellConfig{
 		SpellCode:    SpellCode_ShamanLavaBurst,
 		ActionID:     core.ActionID{SpellID: int32(proto.ShamanRune_RuneHandsLavaBurst)},
@@ -76,13 +84,7 @@ func (shaman *Shaman) newLavaBurstSpellConfig(isOverload bool) core.SpellConfig
 			baseDamage := sim.Roll(baseDamageLow, baseDamageHigh)
 			result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMagicHitAndCrit)
 
-			spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-				spell.DealDamage(sim, result)
-
-				if canOverload && result.Landed() && sim.RandomFloat("LvB Overload") < ShamanOverloadChance {
-					shaman.LavaBurstOverload.Cast(sim, target)
-				}
-			})
+			spell.WaitTravelTimeWithResult(sim, result, onLanding)
 		},
 	}
 
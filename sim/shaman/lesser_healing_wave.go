@@ -4,7 +4,6 @@ import (
 	"time"
 
 	"github.com/wowsims/sod/sim/core"
-	"github.com/wowsims/sod/sim/core/proto"
 )
 
 const LesserHealingWaveRanks = 6
@@ -82,13 +81,7 @@ func (shaman *Shaman) newLesserHealingWaveSpellConfig(rank int) core.SpellConfig
 			result := spell.CalcAndDealHealing(sim, target, baseHealing, spell.OutcomeHealingCrit)
 
 			if result.Outcome.Matches(core.OutcomeCrit) {
-				if shaman.HasRune(proto.ShamanRune_RuneFeetAncestralAwakening) {
-					shaman.ancestralHealingAmount = result.Damage * AncestralAwakeningHealMultiplier
-
-					// TODO: this should actually target the lowest health target in the raid.
-					//  does it matter in a sim? We currently only simulate tanks taking damage (multiple tanks could be handled here though.)
-					shaman.AncestralAwakening.Cast(sim, target)
-				}
+				shaman.procAncestralAwakening(sim, result)
 			}
 		},
 	}
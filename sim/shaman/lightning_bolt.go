@@ -74,6 +74,14 @@ func (shaman *Shaman) newLightningBoltSpellConfig(rank int, isOverload bool) cor
 	spell.Rank = rank
 	spell.BonusCoefficient = spellCoeff
 
+	onLanding := func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+		spell.DealDamage(sim, result)
+
+		if canOverload && result.Landed() && sim.Proc(ShamanOverloadChance, "LB Overload") {
+			shaman.LightningBoltOverload[rank].Cast(sim, result.Target)
+		}
+	}
+
 	spell.ApplyEffects = func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 		baseDamage := sim.Roll(baseDamageLow, baseDamageHigh)
 		result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMagicHitAndCrit)
@@ -82,13 +90,7 @@ func (shaman *Shaman) newLightningBoltSpellConfig(rank int, isOverload bool) cor
 			shaman.rollRollingThunderCharge(sim)
 		}
 
-		spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-			spell.DealDamage(sim, result)
-
-			if canOverload && result.Landed() && sim.Proc(ShamanOverloadChance, "LB Overload") {
-				shaman.LightningBoltOverload[rank].Cast(sim, target)
-			}
-		})
+		spell.WaitTravelTimeWithResult(sim, result, onLanding)
 	}
 
 	if isOverload {
@@ -117,7 +117,7 @@ func (shaman *Shaman) registerNewLightningShieldSpell(rank int) {
 
 			if hasOverchargedRune {
 				// Deals damage to all targets within 8 yards and does not lose stacks
-				for _, aoeTarget := range sim.Encounter.TargetUnits {
+				for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 					if aoeTarget.DistanceFromTarget <= 8 {
 						shaman.LightningShieldProcs[rank].Cast(sim, aoeTarget)
 					}
@@ -0,0 +1,77 @@
+package restoration
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/sod/sim/common"
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func init() {
+	RegisterRestorationShaman()
+}
+
+func TestRestoration(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:      proto.Class_ClassShaman,
+			Level:      60,
+			Race:       proto.Race_RaceTroll,
+			OtherRaces: []proto.Race{proto.Race_RaceOrc},
+
+			Talents:     Phase4Talents,
+			GearSet:     core.GetGearSet("../../../ui/restoration_shaman/gear_sets", "phase_4"),
+			Rotation:    core.GetAplRotation("../../../ui/restoration_shaman/apls", "phase_4"),
+			Buffs:       core.FullBuffsPhase4,
+			Consumes:    Phase4Consumes,
+			SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
+
+			IsHealer:   true,
+			RaidDamage: RaidDamage,
+
+			ItemFilter: ItemFilters,
+		},
+	}))
+}
+
+var Phase4Talents = "-1-550355135553151"
+
+var PlayerOptionsStandard = &proto.Player_RestorationShaman{
+	RestorationShaman: &proto.RestorationShaman{
+		Options: &proto.RestorationShaman_Options{},
+	},
+}
+
+var Phase4Consumes = core.ConsumesCombo{
+	Label: "Phase 4 Consumes",
+	Consumes: &proto.Consumes{
+		DefaultPotion: proto.Potions_MajorManaPotion,
+		Flask:         proto.Flask_FlaskOfDistilledWisdom,
+		Food:          proto.Food_FoodNightfinSoup,
+		MainHandImbue: proto.WeaponImbue_BrilliantManaOil,
+	},
+}
+
+var RaidDamage = &proto.RaidDamageModel{
+	DamagePerSecond:  400,
+	CadenceSeconds:   2,
+	CadenceVariation: 1,
+	School:           proto.SpellSchool_SpellSchoolPhysical,
+}
+
+var ItemFilters = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeFist,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeShield,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeMail,
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeTotem,
+	},
+}
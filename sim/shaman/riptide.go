@@ -0,0 +1,90 @@
+package shaman
+
+import (
+	"time"
+
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+const RiptideChainHealBonus = 0.25
+
+func (shaman *Shaman) registerRiptideSpell() {
+	if !shaman.HasRune(proto.ShamanRune_RuneBracersRiptide) {
+		return
+	}
+
+	baseHealing := shaman.baseRuneAbilityDamage()
+	baseHealingLow := baseHealing * 2.32 * (1 + shaman.purificationHealingModifier())
+	baseHealingHigh := baseHealing * 2.51 * (1 + shaman.purificationHealingModifier())
+	hotHealing := baseHealing * 1.76 * (1 + shaman.purificationHealingModifier())
+	numTicks := int32(5)
+
+	shaman.Riptide = shaman.RegisterSpell(core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: int32(proto.ShamanRune_RuneBracersRiptide)},
+		SpellCode:   SpellCode_ShamanRiptide,
+		SpellSchool: core.SpellSchoolNature,
+		DefenseType: core.DefenseTypeMagic,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       SpellFlagShaman | core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCost: .18,
+		},
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    shaman.NewTimer(),
+				Duration: time.Second * 6,
+			},
+		},
+
+		BonusCritRating: float64(shaman.Talents.TidalMastery) * core.CritRatingPerCritChance,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1 - (float64(shaman.Talents.HealingGrace) * 0.05),
+		BonusCoefficient: .4,
+
+		Hot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Riptide",
+			},
+			NumberOfTicks:    numTicks,
+			TickLength:       time.Second * 3,
+			BonusCoefficient: .1,
+			OnSnapshot: func(sim *core.Simulation, target *core.Unit, dot *core.Dot, isRollover bool) {
+				dot.SnapshotHeal(target, hotHealing/float64(numTicks), isRollover)
+				dot.SnapshotAttackerMultiplier = dot.Spell.CasterHealingMultiplier()
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				dot.CalcAndDealPeriodicSnapshotHealing(sim, target, dot.OutcomeTick)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			result := spell.CalcAndDealHealing(sim, target, sim.Roll(baseHealingLow, baseHealingHigh), spell.OutcomeHealingCrit)
+			spell.Hot(target).Apply(sim)
+
+			if result.Outcome.Matches(core.OutcomeCrit) {
+				shaman.procAncestralAwakening(sim, result)
+			}
+		},
+	})
+}
+
+// Consumes the Riptide on the primary target of a Chain Heal, returning the
+// healing multiplier for that Chain Heal.
+func (shaman *Shaman) consumeRiptide(sim *core.Simulation, target *core.Unit) float64 {
+	if shaman.Riptide == nil {
+		return 1
+	}
+
+	if hot := shaman.Riptide.Hot(target); hot.IsActive() {
+		hot.Deactivate(sim)
+		return 1 + RiptideChainHealBonus
+	}
+	return 1
+}
@@ -13,6 +13,7 @@ func (shaman *Shaman) ApplyRunes() {
 	// Helm
 	shaman.applyBurn()
 	shaman.applyMentalDexterity()
+	shaman.applyTidalWaves()
 
 	// Cloak
 	shaman.registerFeralSpiritCD()
@@ -24,6 +25,7 @@ func (shaman *Shaman) ApplyRunes() {
 
 	// Bracers
 	shaman.applyRollingThunder()
+	shaman.registerRiptideSpell()
 
 	// Hands
 	shaman.registerWaterShieldSpell()
@@ -39,6 +41,7 @@ func (shaman *Shaman) ApplyRunes() {
 	// Legs
 	shaman.applyAncestralGuidance()
 	shaman.applyWayOfEarth()
+	shaman.registerEarthShieldSpell()
 
 	// Feet
 	shaman.applyAncestralAwakening()
@@ -103,6 +106,66 @@ func (shaman *Shaman) applyMentalDexterity() {
 	})
 }
 
+var TidalWavesCastTimeReduction = .30
+var TidalWavesCritBonus = 25.0
+
+func (shaman *Shaman) applyTidalWaves() {
+	if !shaman.HasRune(proto.ShamanRune_RuneHelmTidalWaves) {
+		return
+	}
+
+	var healingWaves []*core.Spell
+	var lesserHealingWaves []*core.Spell
+	shaman.OnSpellRegistered(func(spell *core.Spell) {
+		if !spell.Flags.Matches(core.SpellFlagAPL) {
+			return
+		}
+		switch spell.SpellCode {
+		case SpellCode_ShamanHealingWave:
+			healingWaves = append(healingWaves, spell)
+		case SpellCode_ShamanLesserHealingWave:
+			lesserHealingWaves = append(lesserHealingWaves, spell)
+		}
+	})
+
+	critBonus := TidalWavesCritBonus * core.SpellCritRatingPerCritChance
+
+	shaman.TidalWavesAura = shaman.RegisterAura(core.Aura{
+		Label:     "Tidal Waves",
+		ActionID:  core.ActionID{SpellID: int32(proto.ShamanRune_RuneHelmTidalWaves)},
+		Duration:  time.Second * 15,
+		MaxStacks: 2,
+		OnGain: func(aura *core.Aura, sim *core.Simulation) {
+			core.Each(healingWaves, func(spell *core.Spell) { spell.CastTimeMultiplier -= TidalWavesCastTimeReduction })
+			core.Each(lesserHealingWaves, func(spell *core.Spell) { spell.BonusCritRating += critBonus })
+		},
+		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
+			core.Each(healingWaves, func(spell *core.Spell) { spell.CastTimeMultiplier += TidalWavesCastTimeReduction })
+			core.Each(lesserHealingWaves, func(spell *core.Spell) { spell.BonusCritRating -= critBonus })
+		},
+		OnCastComplete: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell) {
+			if spell.Flags.Matches(core.SpellFlagAPL) && (spell.SpellCode == SpellCode_ShamanHealingWave || spell.SpellCode == SpellCode_ShamanLesserHealingWave) {
+				aura.RemoveStack(sim)
+			}
+		},
+	})
+
+	// Hidden Aura
+	shaman.RegisterAura(core.Aura{
+		Label:    "Tidal Waves Trigger",
+		Duration: core.NeverExpires,
+		OnReset: func(aura *core.Aura, sim *core.Simulation) {
+			aura.Activate(sim)
+		},
+		OnCastComplete: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell) {
+			if spell.Flags.Matches(core.SpellFlagAPL) && (spell.SpellCode == SpellCode_ShamanChainHeal || spell.SpellCode == SpellCode_ShamanRiptide) {
+				shaman.TidalWavesAura.Activate(sim)
+				shaman.TidalWavesAura.SetStacks(sim, shaman.TidalWavesAura.MaxStacks)
+			}
+		},
+	})
+}
+
 func (shaman *Shaman) applyDualWieldSpec() {
 	if !shaman.HasRune(proto.ShamanRune_RuneChestDualWieldSpec) || !shaman.HasMHWeapon() || !shaman.HasOHWeapon() {
 		return
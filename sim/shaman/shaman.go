@@ -86,6 +86,7 @@ const (
 	SpellCode_ShamanLavaBurst
 	SpellCode_ShamanMagmaTotem
 	SpellCode_ShamanMoltenBlast
+	SpellCode_ShamanRiptide
 	SpellCode_ShamanSearingTotem
 	SpellCode_ShamanStormstrike
 )
@@ -123,6 +124,7 @@ type Shaman struct {
 	MagmaTotem             []*core.Spell
 	ManaSpringTotem        []*core.Spell
 	MoltenBlast            *core.Spell
+	Riptide                *core.Spell
 	RollingThunder         *core.Spell
 	SearingTotem           []*core.Spell
 	StoneskinTotem         []*core.Spell
@@ -141,6 +143,7 @@ type Shaman struct {
 	FlurryConsumptionAura *core.Aura // Trigger aura for consuming Flurry stacks on hit
 	MaelstromWeaponAura   *core.Aura
 	PowerSurgeAura        *core.Aura
+	TidalWavesAura        *core.Aura
 
 	// Totems
 	ActiveTotems     [4]*core.Spell
@@ -14,6 +14,17 @@ func (shaman *Shaman) registerShamanisticRageCD() {
 
 	actionID := core.ActionID{SpellID: int32(proto.ShamanRune_RuneUtilityShamnisticRage)}
 	manaMetrics := shaman.NewManaMetrics(actionID)
+
+	var manaPerTick float64
+	manaTicksOptions := core.PeriodicActionOptions{
+		NumTicks: 15,
+		Period:   time.Second * 1,
+		OnAction: func(sim *core.Simulation) {
+			shaman.AddMana(sim, manaPerTick, manaMetrics)
+		},
+	}
+	var manaTicks *core.PendingAction
+
 	srAura := shaman.GetOrRegisterAura(core.Aura{
 		Label:    "Shamanistic Rage",
 		ActionID: actionID,
@@ -23,15 +34,10 @@ func (shaman *Shaman) registerShamanisticRageCD() {
 
 			// Sham rage mana gain is snapshotted on cast
 			// TODO: Raid mana regain
-			var manaPerTick = shaman.GetCharacter().MaxMana() * .05
-
-			core.StartPeriodicAction(sim, core.PeriodicActionOptions{
-				NumTicks: 15,
-				Period:   time.Second * 1,
-				OnAction: func(sim *core.Simulation) {
-					shaman.AddMana(sim, manaPerTick, manaMetrics)
-				},
-			})
+			manaPerTick = shaman.GetCharacter().MaxMana() * .05
+
+			manaTicks = core.ReusePeriodicAction(sim, manaTicks, manaTicksOptions)
+			sim.AddPendingAction(manaTicks)
 		},
 		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
 			aura.Unit.PseudoStats.DamageTakenMultiplier /= damageTakenMultiplier
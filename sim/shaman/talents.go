@@ -166,7 +166,17 @@ func (shaman *Shaman) registerElementalMasteryCD() {
 
 	var affectedSpells []*core.Spell
 
-	emAura := shaman.RegisterAura(core.Aura{
+	var emAura *core.Aura
+	consumeAura := func(sim *core.Simulation) {
+		if emAura.IsActive() {
+			// Remove the buff and put skill on CD
+			emAura.Deactivate(sim)
+			cdTimer.Set(sim.CurrentTime + cd)
+			shaman.UpdateMajorCooldowns()
+		}
+	}
+
+	emAura = shaman.RegisterAura(core.Aura{
 		Label:    "Elemental Mastery",
 		ActionID: actionID,
 		Duration: core.NeverExpires,
@@ -191,17 +201,7 @@ func (shaman *Shaman) registerElementalMasteryCD() {
 		OnCastComplete: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell) {
 			if spell.Flags.Matches(SpellFlagFocusable) && spell.ActionID.Tag != CastTagOverload {
 				// Elemental mastery can be batched
-				core.StartDelayedAction(sim, core.DelayedActionOptions{
-					DoAt: sim.CurrentTime + time.Millisecond*1,
-					OnAction: func(sim *core.Simulation) {
-						if aura.IsActive() {
-							// Remove the buff and put skill on CD
-							aura.Deactivate(sim)
-							cdTimer.Set(sim.CurrentTime + cd)
-							shaman.UpdateMajorCooldowns()
-						}
-					},
-				})
+				core.ScheduleDelayedCallback(sim, sim.CurrentTime+time.Millisecond*1, consumeAura)
 			}
 		},
 	})
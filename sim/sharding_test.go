@@ -0,0 +1,17 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/wowsims/sod/sim/core"
+)
+
+func TestShardedResults(t *testing.T) {
+	for _, player := range iterationTestPlayers {
+		t.Run(player.Name, func(t *testing.T) {
+			rsr := iterationTestRequest(player)
+			rsr.SimOptions.Iterations = 2001
+			core.ShardedResultsTest(t, rsr)
+		})
+	}
+}
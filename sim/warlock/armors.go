@@ -51,19 +51,23 @@ func (warlock *Warlock) applyFelArmor() {
 	warlock.AddStatDependency(stats.Spirit, stats.SpellPower, .50)
 
 	healthMetrics := warlock.NewHealthMetrics(actionID)
+	healthTicksOptions := core.PeriodicActionOptions{
+		Period:   time.Second * 5,
+		Priority: core.ActionPriorityAuto,
+		OnAction: func(sim *core.Simulation) {
+			warlock.GainHealth(sim, warlock.MaxHealth()*.02, healthMetrics)
+		},
+	}
+	var healthTicks *core.PendingAction
+
 	warlock.GetOrRegisterAura(core.Aura{
 		Label:    "Fel Armor",
 		ActionID: actionID,
 		Duration: core.NeverExpires,
 		OnReset: func(aura *core.Aura, sim *core.Simulation) {
 			aura.Activate(sim)
-			core.StartPeriodicAction(sim, core.PeriodicActionOptions{
-				Period:   time.Second * 5,
-				Priority: core.ActionPriorityAuto,
-				OnAction: func(sim *core.Simulation) {
-					warlock.GainHealth(sim, warlock.MaxHealth()*.02, healthMetrics)
-				},
-			})
+			healthTicks = core.ReusePeriodicAction(sim, healthTicks, healthTicksOptions)
+			sim.AddPendingAction(healthTicks)
 		},
 	})
 }
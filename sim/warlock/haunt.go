@@ -36,6 +36,13 @@ func (warlock *Warlock) registerHauntSpell() {
 		})
 	})
 
+	onLanding := func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+		spell.DealDamage(sim, result)
+		if result.Landed() {
+			warlock.HauntDebuffAuras.Get(result.Target).Activate(sim)
+		}
+	}
+
 	warlock.Haunt = warlock.RegisterSpell(core.SpellConfig{
 		SpellCode:    SpellCode_WarlockHaunt,
 		ActionID:     actionID,
@@ -65,12 +72,7 @@ func (warlock *Warlock) registerHauntSpell() {
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			baseDamage := sim.Roll(baseLowDamage, baseHighDamage)
 			result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMagicHitAndCrit)
-			spell.WaitTravelTime(sim, func(sim *core.Simulation) {
-				spell.DealDamage(sim, result)
-				if result.Landed() {
-					warlock.HauntDebuffAuras.Get(result.Target).Activate(sim)
-				}
-			})
+			spell.WaitTravelTimeWithResult(sim, result, onLanding)
 		},
 	})
 }
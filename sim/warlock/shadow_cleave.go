@@ -48,6 +48,7 @@ func (warlock *Warlock) getShadowCleaveBaseConfig(rank int) core.SpellConfig {
 		BonusCoefficient: spellCoeff,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				damage := sim.Roll(baseDamage[0], baseDamage[1])
 				results[idx] = spell.CalcDamage(sim, target, damage, spell.OutcomeMagicHitAndCrit)
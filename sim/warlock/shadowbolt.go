@@ -50,6 +50,7 @@ func (warlock *Warlock) getShadowBoltBaseConfig(rank int) core.SpellConfig {
 		BonusCoefficient: spellCoeff,
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				damage := sim.Roll(baseDamage[0], baseDamage[1])
 				results[idx] = spell.CalcDamage(sim, target, damage, spell.OutcomeMagicHitAndCrit)
@@ -1,102 +0,0 @@
-package protection
-
-import (
-	"testing"
-
-	_ "github.com/wowsims/sod/sim/common" // imported to get item effects included.
-	"github.com/wowsims/sod/sim/core"
-	"github.com/wowsims/sod/sim/core/proto"
-)
-
-func init() {
-	RegisterProtectionWarrior()
-}
-
-func TestProtectionWarrior(t *testing.T) {
-	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
-		Class:      proto.Class_ClassWarrior,
-		Race:       proto.Race_RaceOrc,
-		OtherRaces: []proto.Race{proto.Race_RaceHuman},
-
-		GearSet:     core.GetGearSet("../../../ui/protection_warrior/gear_sets", "blank"),
-		Talents:     DefaultTalents,
-		Consumes:    FullConsumes,
-		SpecOptions: core.SpecOptionsCombo{Label: "Basic", SpecOptions: PlayerOptionsBasic},
-		Rotation:    core.GetAplRotation("../../../ui/protection_warrior/apls", "default"),
-
-		IsTank:          false, // TODO: Fix crash when this is true
-		InFrontOfTarget: true,
-
-		ItemFilter: core.ItemFilter{
-			ArmorType: proto.ArmorType_ArmorTypePlate,
-
-			WeaponTypes: []proto.WeaponType{
-				proto.WeaponType_WeaponTypeAxe,
-				proto.WeaponType_WeaponTypeSword,
-				proto.WeaponType_WeaponTypeMace,
-				proto.WeaponType_WeaponTypeDagger,
-				proto.WeaponType_WeaponTypeFist,
-				proto.WeaponType_WeaponTypeShield,
-			},
-		},
-
-		EPReferenceStat: proto.Stat_StatAttackPower,
-		StatsToWeigh: []proto.Stat{
-			proto.Stat_StatStrength,
-			proto.Stat_StatAttackPower,
-			proto.Stat_StatArmor,
-			proto.Stat_StatDodge,
-			proto.Stat_StatBlockValue,
-		},
-	}))
-}
-
-func BenchmarkSimulate(b *testing.B) {
-	rsr := &proto.RaidSimRequest{
-		Raid: core.SinglePlayerRaidProto(
-			&proto.Player{
-				Race:          proto.Race_RaceOrc,
-				Class:         proto.Class_ClassWarrior,
-				Equipment:     core.GetGearSet("../../../ui/protection_warrior/gear_sets", "blank").GearSet,
-				Consumes:      FullConsumes.Consumes,
-				Spec:          PlayerOptionsBasic,
-				Buffs:         core.FullIndividualBuffs,
-				TalentsString: DefaultTalents,
-
-				InFrontOfTarget: true,
-			},
-			core.FullPartyBuffs,
-			core.FullRaidBuffs,
-			core.FullDebuffs),
-		Encounter: &proto.Encounter{
-			Duration: 300,
-			Targets: []*proto.Target{
-				core.NewDefaultTarget(),
-			},
-		},
-		SimOptions: core.AverageDefaultSimTestOptions,
-	}
-
-	core.RaidBenchmark(b, rsr)
-}
-
-var DefaultTalents = "053020003-03"
-
-var PlayerOptionsBasic = &proto.Player_ProtectionWarrior{
-	ProtectionWarrior: &proto.ProtectionWarrior{
-		Options: warriorOptions,
-	},
-}
-
-var warriorOptions = &proto.ProtectionWarrior_Options{
-	Shout:        proto.WarriorShout_WarriorShoutCommanding,
-	StartingRage: 0,
-}
-
-var FullConsumes = core.ConsumesCombo{
-	Label:    "Full Consumes",
-	Consumes: &proto.Consumes{
-		// BattleElixir:   proto.BattleElixir_ElixirOfMastery,
-		// GuardianElixir: proto.GuardianElixir_GiftOfArthas,
-	},
-}
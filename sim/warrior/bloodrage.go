@@ -19,6 +19,15 @@ func (warrior *Warrior) registerBloodrageCD() {
 		Duration: time.Second * 10,
 	})
 
+	rageTicksOptions := core.PeriodicActionOptions{
+		NumTicks: 10,
+		Period:   time.Second * 1,
+		OnAction: func(sim *core.Simulation) {
+			warrior.AddRage(sim, ragePerSec, rageMetrics)
+		},
+	}
+	var rageTicks *core.PendingAction
+
 	warrior.Bloodrage = warrior.RegisterSpell(AnyStance, core.SpellConfig{
 		ActionID: actionID,
 		Cast: core.CastConfig{
@@ -32,13 +41,8 @@ func (warrior *Warrior) registerBloodrageCD() {
 			warrior.BloodrageAura.Activate(sim)
 			warrior.AddRage(sim, instantRage, rageMetrics)
 
-			core.StartPeriodicAction(sim, core.PeriodicActionOptions{
-				NumTicks: 10,
-				Period:   time.Second * 1,
-				OnAction: func(sim *core.Simulation) {
-					warrior.AddRage(sim, ragePerSec, rageMetrics)
-				},
-			})
+			rageTicks = core.ReusePeriodicAction(sim, rageTicks, rageTicksOptions)
+			sim.AddPendingAction(rageTicks)
 		},
 	})
 
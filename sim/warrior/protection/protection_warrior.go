@@ -32,8 +32,16 @@ type ProtectionWarrior struct {
 func NewProtectionWarrior(character *core.Character, options *proto.Player) *ProtectionWarrior {
 	warOptions := options.GetProtectionWarrior()
 
+	// Tanks start in Defensive Stance unless told otherwise.
+	stance := warOptions.Options.Stance
+	if stance == proto.WarriorStance_WarriorStanceNone {
+		stance = proto.WarriorStance_WarriorStanceDefensive
+	}
+
 	war := &ProtectionWarrior{
-		Warrior: warrior.NewWarrior(character, options.TalentsString, warrior.WarriorInputs{}),
+		Warrior: warrior.NewWarrior(character, options.TalentsString, warrior.WarriorInputs{
+			Stance: stance,
+		}),
 		Options: warOptions.Options,
 	}
 
@@ -68,13 +76,8 @@ func (war *ProtectionWarrior) Initialize() {
 	war.Warrior.Initialize()
 
 	war.RegisterShieldWallCD()
-	war.RegisterShieldBlockCD()
-	war.DefensiveStanceAura.BuildPhase = core.CharacterBuildPhaseTalents
 }
 
 func (war *ProtectionWarrior) Reset(sim *core.Simulation) {
 	war.Warrior.Reset(sim)
-	war.DefensiveStanceAura.Activate(sim)
-	war.Stance = warrior.DefensiveStance
-	war.Warrior.PseudoStats.Stunned = false
 }
@@ -0,0 +1,128 @@
+package protection
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/sod/sim/common" // imported to get item effects included.
+	"github.com/wowsims/sod/sim/core"
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+func init() {
+	RegisterProtectionWarrior()
+}
+
+func TestProtectionWarrior(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:      proto.Class_ClassWarrior,
+			Level:      60,
+			Race:       proto.Race_RaceOrc,
+			OtherRaces: []proto.Race{proto.Race_RaceHuman},
+
+			Talents:     P4ProtectionTalents,
+			GearSet:     core.GetGearSet("../../../ui/protection_warrior/gear_sets", "phase_4_tank"),
+			Rotation:    core.GetAplRotation("../../../ui/protection_warrior/apls", "phase_4_tank"),
+			Buffs:       core.FullBuffsPhase4,
+			Consumes:    Phase4Consumes,
+			SpecOptions: core.SpecOptionsCombo{Label: "Protection", SpecOptions: PlayerOptionsProtection},
+
+			IsTank:          true,
+			InFrontOfTarget: true,
+			HealingModel:    TankHealingModel,
+
+			ItemFilter:      ItemFilters,
+			EPReferenceStat: proto.Stat_StatAttackPower,
+			StatsToWeigh:    Stats,
+		},
+	}))
+}
+
+func BenchmarkSimulate(b *testing.B) {
+	rsr := &proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(
+			&proto.Player{
+				Race:          proto.Race_RaceOrc,
+				Class:         proto.Class_ClassWarrior,
+				Level:         60,
+				Equipment:     core.GetGearSet("../../../ui/protection_warrior/gear_sets", "phase_4_tank").GearSet,
+				Rotation:      core.GetAplRotation("../../../ui/protection_warrior/apls", "phase_4_tank").Rotation,
+				Consumes:      Phase4Consumes.Consumes,
+				Spec:          PlayerOptionsProtection,
+				Buffs:         core.FullIndividualBuffsPhase4,
+				TalentsString: P4ProtectionTalents,
+				HealingModel:  TankHealingModel,
+
+				InFrontOfTarget: true,
+			},
+			core.FullPartyBuffs,
+			core.FullRaidBuffsPhase4,
+			core.FullDebuffsPhase4),
+		Encounter: &proto.Encounter{
+			Duration: 180,
+			Targets: []*proto.Target{
+				core.NewDefaultTarget(60),
+			},
+		},
+		SimOptions: core.AverageDefaultSimTestOptions,
+	}
+	rsr.Raid.Tanks = append(rsr.Raid.Tanks, &proto.UnitReference{Type: proto.UnitReference_Player, Index: 0})
+
+	core.RaidBenchmark(b, rsr)
+}
+
+var P4ProtectionTalents = "-0505-55250133530021051"
+
+var PlayerOptionsProtection = &proto.Player_ProtectionWarrior{
+	ProtectionWarrior: &proto.ProtectionWarrior{
+		Options: warriorOptions,
+	},
+}
+
+var warriorOptions = &proto.ProtectionWarrior_Options{
+	Shout:        proto.WarriorShout_WarriorShoutBattle,
+	Stance:       proto.WarriorStance_WarriorStanceDefensive,
+	StartingRage: 0,
+}
+
+var TankHealingModel = &proto.HealingModel{
+	Hps:            650,
+	CadenceSeconds: 2,
+	BurstWindow:    6,
+}
+
+var Phase4Consumes = core.ConsumesCombo{
+	Label: "Phase 4 Consumes",
+	Consumes: &proto.Consumes{
+		AgilityElixir:     proto.AgilityElixir_ElixirOfTheMongoose,
+		ArmorElixir:       proto.ArmorElixir_ElixirOfSuperiorDefense,
+		DragonBreathChili: true,
+		Flask:             proto.Flask_FlaskOfTheTitans,
+		Food:              proto.Food_FoodSmokedDesertDumpling,
+		MainHandImbue:     proto.WeaponImbue_ElementalSharpeningStone,
+		StrengthBuff:      proto.StrengthBuff_JujuPower,
+	},
+}
+
+var ItemFilters = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypePlate,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeFist,
+		proto.WeaponType_WeaponTypeShield,
+	},
+}
+
+var Stats = []proto.Stat{
+	proto.Stat_StatStrength,
+	proto.Stat_StatAgility,
+	proto.Stat_StatAttackPower,
+	proto.Stat_StatArmor,
+	proto.Stat_StatDefense,
+	proto.Stat_StatDodge,
+	proto.Stat_StatBlockValue,
+}
@@ -336,7 +336,7 @@ func (warrior *Warrior) applyFreshMeat() {
 		Label:    "Fresh Meat Trigger",
 		Duration: core.NeverExpires,
 		OnReset: func(aura *core.Aura, sim *core.Simulation) {
-			damagedUnits = make(map[int32]bool)
+			clear(damagedUnits)
 			aura.Activate(sim)
 		},
 		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
@@ -463,6 +463,7 @@ func (warrior *Warrior) applySingleMindedFury() {
 		Label:    "Single-Minded Fury Trigger",
 		Duration: core.NeverExpires,
 		OnReset: func(aura *core.Aura, sim *core.Simulation) {
+			warrior.lastMeleeAutoTarget = nil
 			aura.Activate(sim)
 		},
 		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
@@ -101,7 +101,7 @@ func (warrior *Warrior) registerSweepingStrikesCD() {
 		Spell: SweepingStrikes.Spell,
 		Type:  core.CooldownTypeDPS,
 		ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
-			return sim.GetNumTargets() >= 2
+			return sim.ActiveTargetCount() >= 2
 		},
 	})
 }
@@ -62,6 +62,7 @@ func (warrior *Warrior) registerThunderClapSpell() {
 		ThreatMultiplier: 2.5 * core.TernaryFloat64(hasFuriousThunder, 1.5, 1),
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			results := results[:min(int32(len(results)), sim.ActiveTargetCount())]
 			for idx := range results {
 				results[idx] = spell.CalcDamage(sim, target, info.baseDamage+apCoef*spell.MeleeAttackPower(), spell.OutcomeMagicHitAndCrit)
 				target = sim.Environment.NextTargetUnit(target)
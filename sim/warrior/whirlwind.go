@@ -42,7 +42,7 @@ func (warrior *Warrior) registerWhirlwindSpell() {
 		},
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, _ *core.Spell) {
-			for _, aoeTarget := range sim.Encounter.TargetUnits {
+			for _, aoeTarget := range sim.Encounter.ActiveTargetUnits {
 				warrior.WhirlwindMH.Cast(sim, aoeTarget)
 				if warrior.AutoAttacks.IsDualWielding && warrior.WhirlwindOH != nil && warrior.IsEnraged() {
 					warrior.WhirlwindOH.Cast(sim, aoeTarget)
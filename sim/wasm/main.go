@@ -5,8 +5,10 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"runtime/debug"
+	"sync"
 	"syscall/js"
 
 	"github.com/wowsims/sod/sim"
@@ -31,6 +33,10 @@ func main() {
 	js.Global().Set("statWeights", js.FuncOf(statWeights))
 	js.Global().Set("statWeightsAsync", js.FuncOf(statWeightsAsync))
 	js.Global().Set("bulkSimAsync", js.FuncOf(bulkSimAsync))
+	js.Global().Set("gearOptimizerAsync", js.FuncOf(gearOptimizerAsync))
+	js.Global().Set("cancel", js.FuncOf(cancel))
+	js.Global().Set("startInteractiveSession", js.FuncOf(startInteractiveSession))
+	js.Global().Set("stepInteractiveSession", js.FuncOf(stepInteractiveSession))
 	js.Global().Call("wasmready")
 	<-c
 }
@@ -164,9 +170,10 @@ func raidSimAsync(this js.Value, args []js.Value) interface{} {
 		return nil
 	}
 	reporter := make(chan *proto.ProgressMetrics, 100)
+	ctx, done := newCancellableContext(args)
 
-	go core.RunRaidSimAsync(rsr, reporter)
-	return processAsyncProgress(args[1], reporter)
+	core.RunRaidSimAsync(ctx, rsr, reporter)
+	return newAsyncPromise(args[1], reporter, done)
 }
 
 func statWeights(this js.Value, args []js.Value) interface{} {
@@ -196,10 +203,10 @@ func statWeightsAsync(this js.Value, args []js.Value) interface{} {
 		return nil
 	}
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	core.StatWeightsAsync(rsr, reporter)
+	ctx, done := newCancellableContext(args)
 
-	result := processAsyncProgress(args[1], reporter)
-	return result
+	core.StatWeightsAsync(ctx, rsr, reporter)
+	return newAsyncPromise(args[1], reporter, done)
 }
 
 func bulkSimAsync(this js.Value, args []js.Value) interface{} {
@@ -209,12 +216,104 @@ func bulkSimAsync(this js.Value, args []js.Value) interface{} {
 		return nil
 	}
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	// for now just use context.Background() until we can figure out the best way to handle
-	// allowing front end to cancel.
-	core.RunBulkSimAsync(context.Background(), rsr, reporter)
+	ctx, done := newCancellableContext(args)
 
-	result := processAsyncProgress(args[1], reporter)
-	return result
+	core.RunBulkSimAsync(ctx, rsr, reporter)
+	return newAsyncPromise(args[1], reporter, done)
+}
+
+func gearOptimizerAsync(this js.Value, args []js.Value) interface{} {
+	rsr := &proto.GearOptimizerRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), rsr); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	reporter := make(chan *proto.ProgressMetrics, 100)
+	ctx, done := newCancellableContext(args)
+
+	core.RunGearOptimizerAsync(ctx, rsr, reporter)
+	return newAsyncPromise(args[1], reporter, done)
+}
+
+func startInteractiveSession(this js.Value, args []js.Value) interface{} {
+	isr := &proto.InteractiveStartRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), isr); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.StartInteractiveSession(isr)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
+func stepInteractiveSession(this js.Value, args []js.Value) interface{} {
+	isr := &proto.InteractiveStepRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), isr); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.StepInteractiveSession(isr)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
+// Cancel functions of running async sims, keyed by the progress ID passed in by the caller.
+var (
+	cancelFuncs   = map[string]context.CancelFunc{}
+	cancelFuncsMu sync.Mutex
+)
+
+// Creates the context for an async sim. If the caller passed a progress ID as
+// args[2], the sim can be stopped by calling cancel() with that ID.
+//
+// The returned function must be called once the sim is done.
+func newCancellableContext(args []js.Value) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if len(args) < 3 || args[2].Type() != js.TypeString {
+		return ctx, cancel
+	}
+
+	progressID := args[2].String()
+	cancelFuncsMu.Lock()
+	cancelFuncs[progressID] = cancel
+	cancelFuncsMu.Unlock()
+	return ctx, func() {
+		cancelFuncsMu.Lock()
+		delete(cancelFuncs, progressID)
+		cancelFuncsMu.Unlock()
+		cancel()
+	}
+}
+
+// Stops the async sim with the progress ID in args[0]. The sim still reports its
+// partial results as final, through its progress callback.
+func cancel(this js.Value, args []js.Value) interface{} {
+	cancelFuncsMu.Lock()
+	cancelFunc, ok := cancelFuncs[args[0].String()]
+	cancelFuncsMu.Unlock()
+	if ok {
+		cancelFunc()
+		return true
+	}
+	return false
 }
 
 // Assumes args[0] is a Uint8Array
@@ -229,6 +328,31 @@ func getArgsJson(value js.Value) []byte {
 	return []byte(str)
 }
 
+// Returns a Promise for the final progress message of an async sim. Progress is
+// pumped from a goroutine, so the JS event loop stays free to deliver progress
+// callbacks and cancel() calls while the sim runs. done is called once the sim
+// is finished.
+func newAsyncPromise(progFunc js.Value, reporter chan *proto.ProgressMetrics, done func()) js.Value {
+	var executor js.Func
+	executor = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		resolve, reject := args[0], args[1]
+		executor.Release()
+
+		go func() {
+			defer done()
+			defer func() {
+				if err := recover(); err != nil {
+					reject.Invoke(js.Global().Get("Error").New(fmt.Sprintf("%v\nStack Trace:\n%s", err, debug.Stack())))
+				}
+			}()
+
+			resolve.Invoke(processAsyncProgress(progFunc, reporter))
+		}()
+		return nil
+	})
+	return js.Global().Get("Promise").New(executor)
+}
+
 func processAsyncProgress(progFunc js.Value, reporter chan *proto.ProgressMetrics) js.Value {
 reader:
 	for {
@@ -248,7 +372,7 @@ reader:
 			js.CopyBytesToJS(outArray, outbytes)
 			progFunc.Invoke(outArray)
 
-			if progMetric.FinalWeightResult != nil || progMetric.FinalRaidResult != nil || progMetric.FinalBulkResult != nil {
+			if progMetric.FinalWeightResult != nil || progMetric.FinalRaidResult != nil || progMetric.FinalBulkResult != nil || progMetric.FinalGearOptimizerResult != nil {
 				return outArray
 			}
 		}
@@ -26,6 +26,7 @@ import (
 	"github.com/wowsims/sod/sim/core"
 	proto "github.com/wowsims/sod/sim/core/proto"
 
+	"google.golang.org/protobuf/encoding/protojson"
 	googleProto "google.golang.org/protobuf/proto"
 )
 
@@ -100,19 +101,26 @@ var handlers = map[string]apiHandler{
 	"/computeStats": {msg: func() googleProto.Message { return &proto.ComputeStatsRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.ComputeStats(msg.(*proto.ComputeStatsRequest))
 	}},
+	"/startInteractiveSession": {msg: func() googleProto.Message { return &proto.InteractiveStartRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.StartInteractiveSession(msg.(*proto.InteractiveStartRequest))
+	}},
+	"/stepInteractiveSession": {msg: func() googleProto.Message { return &proto.InteractiveStepRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.StepInteractiveSession(msg.(*proto.InteractiveStepRequest))
+	}},
 }
 
 var asyncAPIHandlers = map[string]asyncAPIHandler{
-	"/raidSimAsync": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
-		core.RunRaidSimAsync(msg.(*proto.RaidSimRequest), reporter)
+	"/raidSimAsync": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.RunRaidSimAsync(ctx, msg.(*proto.RaidSimRequest), reporter)
 	}},
-	"/statWeightsAsync": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
-		core.StatWeightsAsync(msg.(*proto.StatWeightsRequest), reporter)
+	"/statWeightsAsync": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.StatWeightsAsync(ctx, msg.(*proto.StatWeightsRequest), reporter)
 	}},
-	"/bulkSimAsync": {msg: func() googleProto.Message { return &proto.BulkSimRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
-		// TODO: we can use context's to cancel stuff.
-		// We should have all the async APIs take in context and let it be cancelled via its async ID.
-		core.RunBulkSimAsync(context.Background(), msg.(*proto.BulkSimRequest), reporter)
+	"/bulkSimAsync": {msg: func() googleProto.Message { return &proto.BulkSimRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.RunBulkSimAsync(ctx, msg.(*proto.BulkSimRequest), reporter)
+	}},
+	"/gearOptimizerAsync": {msg: func() googleProto.Message { return &proto.GearOptimizerRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.RunGearOptimizerAsync(ctx, msg.(*proto.GearOptimizerRequest), reporter)
 	}},
 }
 
@@ -127,18 +135,73 @@ type apiHandler struct {
 }
 type asyncAPIHandler struct {
 	msg    func() googleProto.Message
-	handle func(googleProto.Message, chan *proto.ProgressMetrics)
+	handle func(context.Context, googleProto.Message, chan *proto.ProgressMetrics)
 }
 
 type asyncProgress struct {
 	id             string
 	latestProgress atomic.Value
+	cancel         context.CancelFunc // stops the sim, which then reports its partial results as final.
+
+	// Progress messages that haven't been streamed yet, at most
+	// maxBufferedProgress of them. Pollers only need latestProgress. updated is
+	// closed and replaced whenever a message is added, to wake up streams
+	// waiting for it.
+	mut      sync.Mutex
+	messages []*proto.ProgressMetrics
+	updated  chan struct{}
+	finished bool
+}
+
+// Number of progress messages kept for a stream that hasn't connected yet. Older
+// messages are dropped first, so the final one is always kept.
+const maxBufferedProgress = 1000
+
+func isFinalProgress(progMetric *proto.ProgressMetrics) bool {
+	return progMetric.FinalRaidResult != nil || progMetric.FinalWeightResult != nil || progMetric.FinalBulkResult != nil || progMetric.FinalGearOptimizerResult != nil
+}
+
+func (p *asyncProgress) add(progMetric *proto.ProgressMetrics) {
+	p.latestProgress.Store(progMetric)
+
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if len(p.messages) == maxBufferedProgress {
+		p.messages = append(p.messages[:0], p.messages[1:]...)
+	}
+	p.messages = append(p.messages, progMetric)
+	p.finished = p.finished || isFinalProgress(progMetric)
+	close(p.updated)
+	p.updated = make(chan struct{})
+}
+
+// Marks the sim as finished without a final message, e.g. when it timed out.
+func (p *asyncProgress) finish() {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	if !p.finished {
+		p.finished = true
+		close(p.updated)
+		p.updated = make(chan struct{})
+	}
+}
+
+// Removes and returns the buffered messages, along with whether the sim is
+// finished and a channel which is closed once there are new messages.
+func (p *asyncProgress) take() ([]*proto.ProgressMetrics, bool, chan struct{}) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	messages := p.messages
+	p.messages = nil
+	return messages, p.finished, p.updated
 }
 
-func (s *server) addNewSim() *asyncProgress {
+func (s *server) addNewSim(cancel context.CancelFunc) *asyncProgress {
 	newID := uuid.NewString()
 	simProgress := &asyncProgress{
-		id: newID,
+		id:      newID,
+		cancel:  cancel,
+		updated: make(chan struct{}),
 	}
 	simProgress.latestProgress.Store(&proto.ProgressMetrics{})
 
@@ -173,18 +236,22 @@ func (s *server) handleAsyncAPI(w http.ResponseWriter, r *http.Request) {
 	//  as the simulation advances it will push changes to the channel
 	//  these changes will be consumed by the goroutine below so the asyncProgress endpoint can fetch the results.
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	handler.handle(msg, reporter)
+	ctx, cancel := context.WithCancel(context.Background())
+	handler.handle(ctx, msg, reporter)
 
 	// Generate a new async simulation
-	simProgress := s.addNewSim()
+	simProgress := s.addNewSim(cancel)
 
 	// Now launch a background process that pulls progress reports off the reporter channel
 	// and pushes it into the async progress cache.
 	go func() {
+		defer cancel()
+		defer simProgress.finish()
 		for {
 			select {
 			case <-time.After(time.Minute * 10):
 				// if we get no progress after 10 minutes, delete the pending sim and exit.
+				// Exiting also cancels the sim, in case it is still running.
 				s.progMut.Lock()
 				delete(s.asyncProgresses, simProgress.id)
 				s.progMut.Unlock()
@@ -193,8 +260,8 @@ func (s *server) handleAsyncAPI(w http.ResponseWriter, r *http.Request) {
 				if progMetric == nil {
 					return
 				}
-				simProgress.latestProgress.Store(progMetric)
-				if progMetric.FinalRaidResult != nil || progMetric.FinalWeightResult != nil || progMetric.FinalBulkResult != nil {
+				simProgress.add(progMetric)
+				if isFinalProgress(progMetric) {
 					return
 				}
 			}
@@ -252,7 +319,7 @@ func (s *server) setupAsyncServer() {
 		}
 
 		// If this was the last result, delete the cache for this simulation.
-		if latest.FinalRaidResult != nil || latest.FinalWeightResult != nil || latest.FinalBulkResult != nil {
+		if isFinalProgress(latest) {
 			s.progMut.Lock()
 			delete(s.asyncProgresses, msg.ProgressId)
 			s.progMut.Unlock()
@@ -260,7 +327,97 @@ func (s *server) setupAsyncServer() {
 		w.Header().Add("Content-Type", "application/x-protobuf")
 		w.Write(outbytes)
 	})))
+
+	// asyncProgressStream streams every progress message of a simulation as
+	// server-sent events, see handleAsyncProgressStream.
+	http.Handle("/asyncProgressStream", corsMiddleware(http.HandlerFunc(s.handleAsyncProgressStream)))
+
+	// cancelAsync stops a running simulation by its UUID. The simulation still
+	// reports its partial results as final, which can be fetched from asyncProgress.
+	http.Handle("/cancelAsync", corsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return
+		}
+		msg := &proto.AsyncAPIResult{}
+		if err := googleProto.Unmarshal(body, msg); err != nil {
+			log.Printf("Failed to parse request: %s", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		s.progMut.RLock()
+		progress, ok := s.asyncProgresses[msg.ProgressId]
+		s.progMut.RUnlock()
+		if !ok {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		progress.cancel()
+		w.WriteHeader(http.StatusOK)
+	})))
+}
+
+// handleAsyncProgressStream streams the progress of the simulation with the UUID
+// in the id query parameter, as server-sent events with the protojson encoded
+// ProgressMetrics. Messages sent before the stream was opened are replayed first,
+// up to maxBufferedProgress of them.
+//
+// The stream closes once the simulation is finished, including when it's
+// cancelled. If the client disconnects first, the simulation is cancelled.
+func (s *server) handleAsyncProgressStream(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	s.progMut.RLock()
+	progress, ok := s.asyncProgresses[id]
+	s.progMut.RUnlock()
+	if !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	forget := func() {
+		s.progMut.Lock()
+		delete(s.asyncProgresses, id)
+		s.progMut.Unlock()
+	}
+
+	for {
+		messages, finished, updated := progress.take()
+		for _, progMetric := range messages {
+			data, err := protojson.Marshal(progMetric)
+			if err != nil {
+				log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+				return
+			}
+			fmt.Fprintf(w, "event: progress\ndata: %s\n\n", data)
+		}
+		flusher.Flush()
+
+		if finished {
+			forget()
+			return
+		}
+
+		select {
+		case <-updated:
+		case <-r.Context().Done():
+			progress.cancel()
+			forget()
+			return
+		}
+	}
 }
+
 func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
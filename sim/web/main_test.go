@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	_ "github.com/wowsims/sod/sim/common"
 	"github.com/wowsims/sod/sim/core"
 	"github.com/wowsims/sod/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
 	googleProto "google.golang.org/protobuf/proto"
 )
 
@@ -108,3 +110,185 @@ func TestIndividualSim(t *testing.T) {
 
 	log.Printf("RESULT: %#v", rsr)
 }
+
+func postProto(t *testing.T, endpoint string, msg googleProto.Message, result googleProto.Message) {
+	msgBytes, err := googleProto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to encode request: %s", err.Error())
+	}
+
+	r, err := http.Post("http://localhost:3339"+endpoint, "application/x-protobuf", bytes.NewReader(msgBytes))
+	if err != nil {
+		t.Fatalf("Failed to POST request: %s", err.Error())
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("Failed to read result body: %s", err.Error())
+	}
+	if err := googleProto.Unmarshal(body, result); err != nil {
+		t.Fatalf("Failed to parse result: %s", err.Error())
+	}
+}
+
+// Plays a whole fight through the interactive session API, casting Lightning
+// Bolt whenever possible.
+func TestInteractiveSession(t *testing.T) {
+	req := &proto.InteractiveStartRequest{
+		Request: &proto.RaidSimRequest{
+			Raid: core.SinglePlayerRaidProto(
+				&proto.Player{
+					Race:      proto.Race_RaceTroll,
+					Class:     proto.Class_ClassShaman,
+					Level:     60,
+					Equipment: core.GetGearSet("../../ui/elemental_shaman/gear_sets", "phase_4").GearSet,
+					Spec:      basicSpec,
+				},
+				&proto.PartyBuffs{},
+				&proto.RaidBuffs{},
+				&proto.Debuffs{}),
+			Encounter: core.MakeSingleTargetEncounter(60, 0),
+			SimOptions: &proto.SimOptions{
+				RandomSeed: 1,
+			},
+		},
+	}
+	req.Request.Encounter.Duration = 30
+
+	state := &proto.InteractiveState{}
+	postProto(t, "/startInteractiveSession", req, state)
+	if state.ErrorResult != "" || state.SessionId == "" || state.Done {
+		t.Fatalf("Failed to start session: %s", state.ErrorResult)
+	}
+
+	lightningBolt := &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: 15208}}
+	for steps := 0; !state.Done; steps++ {
+		if steps > 1000 {
+			t.Fatalf("Session didn't finish")
+		}
+
+		step := &proto.InteractiveStepRequest{SessionId: state.SessionId, Wait: 0.5}
+		for _, spell := range state.Player.Spells {
+			if googleProto.Equal(spell.Id, lightningBolt) && spell.Castable {
+				step = &proto.InteractiveStepRequest{SessionId: state.SessionId, Action: lightningBolt}
+			}
+		}
+
+		previousTime := state.CurrentTime
+		state = &proto.InteractiveState{}
+		postProto(t, "/stepInteractiveSession", step, state)
+		if state.ErrorResult != "" {
+			t.Fatalf("Failed to step session: %s", state.ErrorResult)
+		}
+		if !state.Done && state.CurrentTime <= previousTime {
+			t.Fatalf("Expected step to advance past %0.2fs, got %0.2fs", previousTime, state.CurrentTime)
+		}
+	}
+
+	casts := int32(0)
+	for _, action := range state.FinalResult.RaidMetrics.Parties[0].Players[0].Actions {
+		if googleProto.Equal(action.Id, lightningBolt) {
+			for _, target := range action.Targets {
+				casts += target.Casts
+			}
+		}
+	}
+	if casts == 0 || state.FinalResult.RaidMetrics.Dps.Avg <= 0 {
+		t.Fatalf("Expected the session to cast Lightning Bolt, got %d casts and %0.2f DPS", casts, state.FinalResult.RaidMetrics.Dps.Avg)
+	}
+
+	closed := &proto.InteractiveState{}
+	postProto(t, "/stepInteractiveSession", &proto.InteractiveStepRequest{SessionId: state.SessionId}, closed)
+	if closed.ErrorResult == "" {
+		t.Fatalf("Expected finished session to be discarded")
+	}
+}
+
+func TestAsyncProgressStream(t *testing.T) {
+	req := &proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(
+			&proto.Player{
+				Race:      proto.Race_RaceTroll,
+				Class:     proto.Class_ClassShaman,
+				Equipment: p1Equip,
+				Spec:      basicSpec,
+			},
+			&proto.PartyBuffs{},
+			&proto.RaidBuffs{},
+			&proto.Debuffs{}),
+		Encounter: &proto.Encounter{
+			Duration: 120,
+			Targets: []*proto.Target{
+				{},
+			},
+		},
+		SimOptions: &proto.SimOptions{
+			Iterations: 1000,
+			RandomSeed: 1,
+		},
+	}
+
+	asyncResult := &proto.AsyncAPIResult{}
+	postProto(t, "/raidSimAsync", req, asyncResult)
+
+	r, err := http.Get("http://localhost:3339/asyncProgressStream?id=" + asyncResult.ProgressId)
+	if err != nil {
+		t.Fatalf("Failed to GET stream: %s", err.Error())
+	}
+	defer r.Body.Close()
+	if contentType := r.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Fatalf("Expected an event stream, got %q", contentType)
+	}
+
+	// The stream closes itself after the final message.
+	var messages []*proto.ProgressMetrics
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		progMetric := &proto.ProgressMetrics{}
+		if err := protojson.Unmarshal([]byte(data), progMetric); err != nil {
+			t.Fatalf("Failed to parse progress: %s", err.Error())
+		}
+		messages = append(messages, progMetric)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("Failed to read stream: %s", err.Error())
+	}
+
+	if len(messages) == 0 || messages[len(messages)-1].FinalRaidResult == nil {
+		t.Fatalf("Expected the stream to end with the final result, got %d messages", len(messages))
+	}
+
+	// Finished sims are forgotten.
+	r, err = http.Get("http://localhost:3339/asyncProgressStream?id=" + asyncResult.ProgressId)
+	if err != nil {
+		t.Fatalf("Failed to GET stream: %s", err.Error())
+	}
+	r.Body.Close()
+	if r.StatusCode != http.StatusNoContent {
+		t.Fatalf("Expected no content for a finished sim, got status %d", r.StatusCode)
+	}
+}
+
+func TestAsyncProgressBuffer(t *testing.T) {
+	progress := &asyncProgress{updated: make(chan struct{})}
+	for i := 0; i < maxBufferedProgress+10; i++ {
+		progress.add(&proto.ProgressMetrics{CompletedIterations: int32(i)})
+	}
+	progress.add(&proto.ProgressMetrics{FinalRaidResult: &proto.RaidSimResult{}})
+
+	messages, finished, _ := progress.take()
+	if len(messages) != maxBufferedProgress || !finished || messages[len(messages)-1].FinalRaidResult == nil {
+		t.Fatalf("Expected the latest %d messages ending with the final one, got %d", maxBufferedProgress, len(messages))
+	}
+	if messages, _, _ := progress.take(); len(messages) != 0 {
+		t.Fatalf("Expected streamed messages to be dropped, got %d", len(messages))
+	}
+	if latest := progress.latestProgress.Load().(*proto.ProgressMetrics); latest.FinalRaidResult == nil {
+		t.Fatalf("Expected pollers to still get the final message")
+	}
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"github.com/wowsims/sod/sim/core/proto"
+)
+
+// Armor and weapon proficiencies for each class, used by the sim to decide which items a class can equip.
+// Keep in sync with classToMaxArmorType / classToEligibleWeaponTypes / classToEligibleRangedWeaponTypes in the UI.
+var ClassProficiencies = []*proto.ClassProficiency{
+	{
+		Class:        proto.Class_ClassDruid,
+		MaxArmorType: proto.ArmorType_ArmorTypeLeather,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeFist},
+			{WeaponType: proto.WeaponType_WeaponTypeMace, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypePolearm, TwoHand: true},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{proto.RangedWeaponType_RangedWeaponTypeIdol},
+	},
+	{
+		Class:        proto.Class_ClassHunter,
+		MaxArmorType: proto.ArmorType_ArmorTypeMail,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeAxe, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeFist},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypePolearm, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeSword, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{
+			proto.RangedWeaponType_RangedWeaponTypeBow,
+			proto.RangedWeaponType_RangedWeaponTypeCrossbow,
+			proto.RangedWeaponType_RangedWeaponTypeGun,
+		},
+		CanDualWield: true,
+	},
+	{
+		Class:        proto.Class_ClassMage,
+		MaxArmorType: proto.ArmorType_ArmorTypeCloth,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeSword},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{proto.RangedWeaponType_RangedWeaponTypeWand},
+	},
+	{
+		Class:        proto.Class_ClassPaladin,
+		MaxArmorType: proto.ArmorType_ArmorTypePlate,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeAxe, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeMace, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypePolearm, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeShield},
+			{WeaponType: proto.WeaponType_WeaponTypeSword, TwoHand: true},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{proto.RangedWeaponType_RangedWeaponTypeLibram},
+	},
+	{
+		Class:        proto.Class_ClassPriest,
+		MaxArmorType: proto.ArmorType_ArmorTypeCloth,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeMace},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{proto.RangedWeaponType_RangedWeaponTypeWand},
+	},
+	{
+		Class:        proto.Class_ClassRogue,
+		MaxArmorType: proto.ArmorType_ArmorTypeLeather,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeFist},
+			{WeaponType: proto.WeaponType_WeaponTypeMace},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypeSword},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{
+			proto.RangedWeaponType_RangedWeaponTypeBow,
+			proto.RangedWeaponType_RangedWeaponTypeCrossbow,
+			proto.RangedWeaponType_RangedWeaponTypeGun,
+			proto.RangedWeaponType_RangedWeaponTypeThrown,
+		},
+		CanDualWield: true,
+	},
+	{
+		Class:        proto.Class_ClassShaman,
+		MaxArmorType: proto.ArmorType_ArmorTypeMail,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeAxe, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeFist},
+			{WeaponType: proto.WeaponType_WeaponTypeMace, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypeShield},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{proto.RangedWeaponType_RangedWeaponTypeTotem},
+		CanDualWield:      true,
+	},
+	{
+		Class:        proto.Class_ClassWarlock,
+		MaxArmorType: proto.ArmorType_ArmorTypeCloth,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeSword},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{proto.RangedWeaponType_RangedWeaponTypeWand},
+	},
+	{
+		Class:        proto.Class_ClassWarrior,
+		MaxArmorType: proto.ArmorType_ArmorTypePlate,
+		Weapons: []*proto.WeaponProficiency{
+			{WeaponType: proto.WeaponType_WeaponTypeAxe, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeDagger},
+			{WeaponType: proto.WeaponType_WeaponTypeFist},
+			{WeaponType: proto.WeaponType_WeaponTypeMace, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeOffHand},
+			{WeaponType: proto.WeaponType_WeaponTypePolearm, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeShield},
+			{WeaponType: proto.WeaponType_WeaponTypeStaff, TwoHand: true},
+			{WeaponType: proto.WeaponType_WeaponTypeSword, TwoHand: true},
+		},
+		RangedWeaponTypes: []proto.RangedWeaponType{
+			proto.RangedWeaponType_RangedWeaponTypeBow,
+			proto.RangedWeaponType_RangedWeaponTypeCrossbow,
+			proto.RangedWeaponType_RangedWeaponTypeGun,
+			proto.RangedWeaponType_RangedWeaponTypeThrown,
+		},
+		CanDualWield: true,
+	},
+}
@@ -41,7 +41,8 @@ type WowDatabase struct {
 	ItemIcons  map[int32]*proto.IconData
 	SpellIcons map[int32]*proto.IconData
 
-	Encounters []*proto.PresetEncounter
+	Encounters         []*proto.PresetEncounter
+	ClassProficiencies []*proto.ClassProficiency
 }
 
 var runeOverrideNames = make(map[string]*proto.UIRune, len(RuneOverrides))
@@ -274,16 +275,17 @@ func (db *WowDatabase) ToUIProto() *proto.UIDatabase {
 	})
 
 	return &proto.UIDatabase{
-		Items:          mapToSlice(db.Items),
-		RandomSuffixes: mapToSlice(db.RandomSuffixes),
-		Enchants:       enchants,
-		Runes:          mapToSlice(db.Runes),
-		Encounters:     db.Encounters,
-		Zones:          mapToSlice(db.Zones),
-		Npcs:           mapToSlice(db.Npcs),
-		Factions:       mapToSlice(db.Factions),
-		ItemIcons:      mapToSlice(db.ItemIcons),
-		SpellIcons:     mapToSlice(db.SpellIcons),
+		Items:              mapToSlice(db.Items),
+		RandomSuffixes:     mapToSlice(db.RandomSuffixes),
+		Enchants:           enchants,
+		Runes:              mapToSlice(db.Runes),
+		Encounters:         db.Encounters,
+		ClassProficiencies: db.ClassProficiencies,
+		Zones:              mapToSlice(db.Zones),
+		Npcs:               mapToSlice(db.Npcs),
+		Factions:           mapToSlice(db.Factions),
+		ItemIcons:          mapToSlice(db.ItemIcons),
+		SpellIcons:         mapToSlice(db.SpellIcons),
 	}
 }
 
@@ -362,6 +364,8 @@ func (db *WowDatabase) WriteJson(jsonFilePath string) {
 	tools.WriteProtoArrayToBuffer(uidb.SpellIcons, buffer, "spellIcons")
 	buffer.WriteString(",\n")
 	tools.WriteProtoArrayToBuffer(uidb.Encounters, buffer, "encounters")
+	buffer.WriteString(",\n")
+	tools.WriteProtoArrayToBuffer(uidb.ClassProficiencies, buffer, "classProficiencies")
 	buffer.WriteString("\n")
 
 	buffer.WriteString("}")
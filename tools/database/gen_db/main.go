@@ -77,6 +77,7 @@ func main() {
 
 	db := database.NewWowDatabase()
 	db.Encounters = core.PresetEncounters
+	db.ClassProficiencies = database.ClassProficiencies
 
 	// Try to filter out items reworked in SoD. We do this by storing the max ID for each item name in the map.
 	// This works in most cases because items typically don't share names, however one example of items where this fails is:
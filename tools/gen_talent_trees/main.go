@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Generates the talent trees used by talent searches from the UI's talent configs,
+// so the sim doesn't depend on the UI:
+// go run ./tools/gen_talent_trees
+
+var inDir = flag.String("inDir", "ui/core/talents/trees", "Directory with the talent config JSON of each class.")
+var outFile = flag.String("outFile", "sim/core/talent_trees.go", "Path of the generated Go file.")
+
+type talentLocation struct {
+	RowIdx int `json:"rowIdx"`
+	ColIdx int `json:"colIdx"`
+}
+
+type talentConfig struct {
+	FieldName      string          `json:"fieldName"`
+	Location       talentLocation  `json:"location"`
+	MaxPoints      int             `json:"maxPoints"`
+	PrereqLocation *talentLocation `json:"prereqLocation"`
+}
+
+type talentTreeConfig struct {
+	Name    string         `json:"name"`
+	Talents []talentConfig `json:"talents"`
+}
+
+func main() {
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*inDir, "*.json"))
+	if err != nil || len(files) == 0 {
+		log.Fatalf("No talent configs found in %s", *inDir)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by tools/gen_talent_trees from %s. DO NOT EDIT.\n\n", filepath.ToSlash(*inDir))
+	buf.WriteString("package core\n\n")
+	buf.WriteString("import \"github.com/wowsims/sod/sim/core/proto\"\n\n")
+	buf.WriteString("var talentTreesByClass = map[proto.Class][]talentTreeConfig{\n")
+
+	// Glob sorts the files, so the output doesn't change unless the configs do.
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %s", file, err)
+		}
+		var trees []talentTreeConfig
+		if err := json.Unmarshal(data, &trees); err != nil {
+			log.Fatalf("Failed to parse %s: %s", file, err)
+		}
+
+		className := strings.TrimSuffix(filepath.Base(file), ".json")
+		fmt.Fprintf(&buf, "proto.Class_Class%s: {\n", strings.ToUpper(className[:1])+className[1:])
+		for _, tree := range trees {
+			fmt.Fprintf(&buf, "{\nName: %q,\nTalents: []talentConfig{\n", tree.Name)
+			for _, talent := range tree.Talents {
+				fmt.Fprintf(&buf, "{FieldName: %q, Location: talentLocation{RowIdx: %d, ColIdx: %d}, MaxPoints: %d",
+					talent.FieldName, talent.Location.RowIdx, talent.Location.ColIdx, talent.MaxPoints)
+				if prereq := talent.PrereqLocation; prereq != nil {
+					fmt.Fprintf(&buf, ", PrereqLocation: &talentLocation{RowIdx: %d, ColIdx: %d}", prereq.RowIdx, prereq.ColIdx)
+				}
+				buf.WriteString("},\n")
+			}
+			buf.WriteString("},\n},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format generated code: %s", err)
+	}
+	if err := os.WriteFile(*outFile, source, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %s", *outFile, err)
+	}
+}
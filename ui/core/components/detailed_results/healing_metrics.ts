@@ -56,6 +56,12 @@ export class HealingMetricsTable extends MetricsTable<ActionMetrics> {
 				getValue: (metric: ActionMetrics) => metric.avgCastHealing,
 				getDisplayString: (metric: ActionMetrics) => metric.avgCastHealing.toFixed(1),
 			},
+			{
+				name: 'Overheal %',
+				tooltip: 'Overhealing / Healing',
+				getValue: (metric: ActionMetrics) => metric.overhealPercent,
+				getDisplayString: (metric: ActionMetrics) => metric.overhealPercent.toFixed(1) + '%',
+			},
 			{
 				name: 'TPS',
 				tooltip: 'Threat / Encounter Duration',
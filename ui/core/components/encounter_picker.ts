@@ -104,6 +104,39 @@ export class EncounterPicker extends Component {
 						raid.setTargetDummies(eventID, newValue);
 					},
 				});
+
+				new NumberPicker(this.rootElem, modEncounter, {
+					id: 'encounter-raid-dps',
+					label: 'Raid Damage (DPS)',
+					labelTooltip: 'Damage per second taken by the raid other than the tanks, split between the allies hit by each pulse.',
+					changedEvent: (encounter: Encounter) => encounter.raidDamageChangeEmitter,
+					getValue: (encounter: Encounter) => encounter.getRaidDamage().damagePerSecond,
+					setValue: (eventID: EventID, encounter: Encounter, newValue: number) => {
+						encounter.setRaidDamage(eventID, { ...encounter.getRaidDamage(), damagePerSecond: newValue });
+					},
+				});
+				new NumberPicker(this.rootElem, modEncounter, {
+					id: 'encounter-raid-damage-cadence',
+					label: 'Raid Damage Cadence',
+					labelTooltip: 'Average time between pulses of raid damage, in seconds. Defaults to 2.',
+					float: true,
+					positive: true,
+					changedEvent: (encounter: Encounter) => encounter.raidDamageChangeEmitter,
+					getValue: (encounter: Encounter) => encounter.getRaidDamage().cadenceSeconds,
+					setValue: (eventID: EventID, encounter: Encounter, newValue: number) => {
+						encounter.setRaidDamage(eventID, { ...encounter.getRaidDamage(), cadenceSeconds: newValue });
+					},
+				});
+				new NumberPicker(this.rootElem, modEncounter, {
+					id: 'encounter-raid-damage-targets',
+					label: 'Allies Hit per Pulse',
+					labelTooltip: 'Number of random allies hit by each pulse of raid damage. 0 means all of them.',
+					changedEvent: (encounter: Encounter) => encounter.raidDamageChangeEmitter,
+					getValue: (encounter: Encounter) => encounter.getRaidDamage().targetsPerPulse,
+					setValue: (eventID: EventID, encounter: Encounter, newValue: number) => {
+						encounter.setRaidDamage(eventID, { ...encounter.getRaidDamage(), targetsPerPulse: newValue });
+					},
+				});
 			}
 
 			if (simUI.isIndividualSim() && isTankSpec((simUI as IndividualSimUI<any>).player.spec)) {
@@ -181,6 +214,10 @@ class AdvancedEncounterModal extends BaseModal {
 			changedEvent: (encounter: Encounter) => encounter.targetsChangeEmitter,
 			getValue: (encounter: Encounter) => encounter.targets,
 			setValue: (eventID: EventID, encounter: Encounter, newValue: Array<TargetProto>) => {
+				// Phases refer to targets by index, so they no longer apply once targets are added or removed.
+				if (newValue.length != encounter.targets.length) {
+					encounter.phases = [];
+				}
 				encounter.targets = newValue;
 				encounter.targetsChangeEmitter.emit(eventID);
 			},
@@ -497,6 +534,7 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 	getInputValue(): TargetProto {
 		return TargetProto.create({
 			id: this.aiPicker.getInputValue(),
+			name: this.getTarget().name,
 			level: this.levelPicker.getInputValue(),
 			mobType: this.mobTypePicker.getInputValue(),
 			tankIndex: this.tankIndexPicker.getInputValue(),
@@ -513,6 +551,8 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 				.reduce((totalStats, curStats) => totalStats.add(curStats))
 				.asArray(),
 			targetInputs: this.targetInputPickers.getInputValue(),
+			// Scripts are authored as JSON, there is no picker for them.
+			script: this.getTarget().script,
 		});
 	}
 	setInputValue(newValue: TargetProto) {
@@ -361,6 +361,13 @@ export class APLRunePicker extends DropdownPicker<Player<any>, ActionID, Rune> {
 
 export type UNIT_SET = 'aura_sources' | 'aura_sources_targets_first' | 'targets';
 
+// Dynamic heal targets, which are picked from the raid by the sim when used.
+const healTargetUnits = (): Array<UnitReference> => [
+	UnitReference.create({ type: UnitType.LowestHealthPlayer }),
+	UnitReference.create({ type: UnitType.LowestHealthTank }),
+	UnitReference.create({ type: UnitType.SmartHeal }),
+];
+
 const unitSets: Record<
 	UNIT_SET,
 	{
@@ -379,6 +386,7 @@ const unitSets: Record<
 					.map((petMetadata, i) => UnitReference.create({ type: UnitType.Pet, index: i, owner: UnitReference.create({ type: UnitType.Self }) })),
 				UnitReference.create({ type: UnitType.CurrentTarget }),
 				player.sim.encounter.targetsMetadata.asList().map((targetMetadata, i) => UnitReference.create({ type: UnitType.Target, index: i })),
+				healTargetUnits(),
 			].flat();
 		},
 	},
@@ -402,6 +410,7 @@ const unitSets: Record<
 			return [
 				undefined,
 				player.sim.encounter.targetsMetadata.asList().map((_targetMetadata, i) => UnitReference.create({ type: UnitType.Target, index: i })),
+				healTargetUnits(),
 			].flat();
 		},
 	},
@@ -470,6 +479,24 @@ export class APLUnitPicker extends UnitPicker<Player<any>> {
 					text: `Target ${ref.index + 1}`,
 				};
 			}
+		} else if (ref.type == UnitType.LowestHealthPlayer) {
+			return {
+				value: ref,
+				iconUrl: 'fa-heart',
+				text: 'Lowest Health Player',
+			};
+		} else if (ref.type == UnitType.LowestHealthTank) {
+			return {
+				value: ref,
+				iconUrl: 'fa-shield-halved',
+				text: 'Lowest Health Tank',
+			};
+		} else if (ref.type == UnitType.SmartHeal) {
+			return {
+				value: ref,
+				iconUrl: 'fa-users',
+				text: 'Smart Heal (Party)',
+			};
 		} else if (ref.type == UnitType.Pet) {
 			const petMetadata = thisPlayer.sim.getUnitMetadata(ref, thisPlayer, UnitReference.create({ type: UnitType.Self }));
 			let name = `Pet ${ref.index + 1}`;
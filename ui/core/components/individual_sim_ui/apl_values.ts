@@ -26,6 +26,7 @@ import {
 	APLValueCurrentHealthPercent,
 	APLValueCurrentMana,
 	APLValueCurrentManaPercent,
+	APLValueCurrentPhase,
 	APLValueCurrentRage,
 	APLValueCurrentSealRemainingTime,
 	APLValueCurrentTime,
@@ -61,7 +62,9 @@ import {
 	APLValueSpellIsReady,
 	APLValueSpellTimeToReady,
 	APLValueSpellTravelTime,
+	APLValueTargetIsActive,
 	APLValueTimeToEnergyTick,
+	APLValueTimeUntilNextPhase,
 	APLValueTotemRemainingTime,
 	APLValueWarlockCurrentPetMana,
 	APLValueWarlockCurrentPetManaPercent,
@@ -584,10 +587,32 @@ const valueKindFactories: { [f in NonNullable<APLValueKind>]: ValueKindConfig<AP
 	numberTargets: inputBuilder({
 		label: 'Number of Targets',
 		submenu: ['Encounter'],
-		shortDescription: 'Count of targets in the current encounter',
+		shortDescription: 'Count of active targets in the current encounter',
 		newValue: APLValueNumberTargets.create,
 		fields: [],
 	}),
+	targetIsActive: inputBuilder({
+		label: 'Target Is Active',
+		submenu: ['Encounter'],
+		shortDescription: '<b>True</b> if the target is currently active, i.e. spawned and targetable in the current phase.',
+		newValue: APLValueTargetIsActive.create,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets')],
+	}),
+	currentPhase: inputBuilder({
+		label: 'Current Phase',
+		submenu: ['Encounter'],
+		shortDescription: 'Number of the current encounter phase, starting at 1. Always 1 for encounters without phases.',
+		newValue: APLValueCurrentPhase.create,
+		fields: [],
+	}),
+	timeUntilNextPhase: inputBuilder({
+		label: 'Time Until Next Phase',
+		submenu: ['Encounter'],
+		shortDescription:
+			'Estimated time until the next encounter phase starts. Phases triggered by health are estimated from the damage done so far. Returns the remaining time if there are no more phases.',
+		newValue: APLValueTimeUntilNextPhase.create,
+		fields: [],
+	}),
 	frontOfTarget: inputBuilder({
 		label: 'Front of Target',
 		submenu: ['Encounter'],
@@ -34,6 +34,8 @@ export class BulkTab extends SimTab {
 
 	private pendingResults: ResultsViewer;
 	private pendingDiv: HTMLDivElement;
+	// Set while a batch sim is running, to cancel it.
+	private abortController: AbortController | null = null;
 
 	// TODO: Make a real options probably
 	private doCombos: boolean;
@@ -50,9 +52,14 @@ export class BulkTab extends SimTab {
 		this.leftPanel = (<div className="bulk-tab-left tab-panel-left">{this.column1}</div>) as HTMLDivElement;
 		this.rightPanel = (<div className="bulk-tab-right tab-panel-right" />) as HTMLDivElement;
 
-		this.pendingDiv = (<div className="results-pending-overlay d-flex hide" />) as HTMLDivElement;
+		this.pendingDiv = (<div className="results-pending-overlay d-flex flex-column hide" />) as HTMLDivElement;
 		this.pendingResults = new ResultsViewer(this.pendingDiv);
 		this.pendingResults.hideAll();
+
+		// Cancelled sims still report the results of the iterations run so far.
+		const cancelButton = (<button className="btn btn-outline-primary mt-3">Cancel</button>) as HTMLButtonElement;
+		cancelButton.addEventListener('click', () => this.abortController?.abort());
+		this.pendingDiv.appendChild(cancelButton);
 		this.selectorModal = new SelectorModal(this.simUI.rootElem, this.simUI, this.simUI.player, undefined, {
 			id: 'bulk-selector-modal',
 			disabledTabs: [SelectorModalTabs.Items],
@@ -196,11 +203,14 @@ export class BulkTab extends SimTab {
 
 	protected async runBulkSim(onProgress: WorkerProgressCallback) {
 		this.pendingResults.setPending();
+		this.abortController = new AbortController();
 
 		try {
-			await this.simUI.sim.runBulkSim(this.createBulkSettings(), this.createBulkItemsDatabase(), onProgress);
+			await this.simUI.sim.runBulkSim(this.createBulkSettings(), this.createBulkItemsDatabase(), onProgress, this.abortController.signal);
 		} catch (e) {
 			this.simUI.handleCrash(e);
+		} finally {
+			this.abortController = null;
 		}
 	}
 
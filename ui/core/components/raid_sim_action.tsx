@@ -37,6 +37,7 @@ export interface ResultMetrics {
 	tmi: string;
 	dur: string;
 	hps: string;
+	ehps: string;
 	tps: string;
 	tto: string;
 }
@@ -64,6 +65,7 @@ export class RaidSimResultsManager {
 		cod: 'threat',
 		tto: 'healing',
 		hps: 'healing',
+		ehps: 'healing',
 	};
 
 	static resultMetricClasses: { [ResultMetrics: string]: string } = {
@@ -74,6 +76,7 @@ export class RaidSimResultsManager {
 		tmi: 'results-sim-tmi',
 		dur: 'results-sim-dur',
 		hps: 'results-sim-hps',
+		ehps: 'results-sim-ehps',
 		tps: 'results-sim-tps',
 		tto: 'results-sim-tto',
 	};
@@ -162,6 +165,7 @@ export class RaidSimResultsManager {
 		setResultTooltip('results-sim-dpasp', 'Demonic Pact Average Spell Power');
 		setResultTooltip('results-sim-tto', 'Time To OOM');
 		setResultTooltip('results-sim-hps', 'Healing+Shielding Per Second, including overhealing.');
+		setResultTooltip('results-sim-ehps', 'Effective Healing+Shielding Per Second, excluding overhealing.');
 		setResultTooltip('results-sim-tps', 'Threat Per Second');
 		setResultTooltip('results-sim-dtps', 'Damage Taken Per Second');
 		setResultTooltip(
@@ -262,6 +266,7 @@ export class RaidSimResultsManager {
 		this.formatToplineResult(`.${RaidSimResultsManager.resultMetricClasses['dps']} .results-reference-diff`, res => res.raidMetrics.dps, 2);
 		if (this.simUI.isIndividualSim()) {
 			this.formatToplineResult(`.${RaidSimResultsManager.resultMetricClasses['hps']} .results-reference-diff`, res => res.raidMetrics.hps, 2);
+			this.formatToplineResult(`.${RaidSimResultsManager.resultMetricClasses['ehps']} .results-reference-diff`, res => res.getPlayers()[0]!.ehps, 2);
 			this.formatToplineResult(`.${RaidSimResultsManager.resultMetricClasses['dpasp']} .results-reference-diff`, res => res.getPlayers()[0]!.dpasp, 2);
 			this.formatToplineResult(`.${RaidSimResultsManager.resultMetricClasses['tto']} .results-reference-diff`, res => res.getPlayers()[0]!.tto, 2);
 			this.formatToplineResult(`.${RaidSimResultsManager.resultMetricClasses['tps']} .results-reference-diff`, res => res.getPlayers()[0]!.tps, 2);
@@ -400,6 +405,31 @@ export class RaidSimResultsManager {
 				}
 				content.appendChild(dpaspContent);
 
+				// Only healers need the healing metrics.
+				const hpsMetrics = playerMetrics.hps;
+				if (hpsMetrics.avg !== 0) {
+					content.appendChild(
+						this.buildResultsLine({
+							average: hpsMetrics.avg,
+							stdev: hpsMetrics.stdev,
+							classes: this.getResultsLineClasses('hps'),
+						}),
+					);
+					content.appendChild(
+						this.buildResultsLine({
+							average: playerMetrics.ehps.avg,
+							stdev: playerMetrics.ehps.stdev,
+							classes: this.getResultsLineClasses('ehps'),
+						}),
+					);
+					content.appendChild(
+						this.buildResultsLine({
+							average: playerMetrics.tto.avg,
+							classes: this.getResultsLineClasses('tto'),
+						}),
+					);
+				}
+
 				content.appendChild(
 					this.buildResultsLine({
 						average: tpsMetrics.avg,
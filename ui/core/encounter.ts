@@ -1,6 +1,6 @@
 import * as Mechanics from './constants/mechanics.js';
 import { UnitMetadataList } from './player.js';
-import { Encounter as EncounterProto, PresetEncounter, PresetTarget, Target as TargetProto } from './proto/common.js';
+import { Encounter as EncounterProto, EncounterPhase, PresetEncounter, PresetTarget, RaidDamageModel, Target as TargetProto } from './proto/common.js';
 import { Sim } from './sim.js';
 import { EventID, TypedEvent } from './typed_event.js';
 
@@ -22,12 +22,16 @@ export class Encounter {
 	private useHealth = false;
 
 	targets!: Array<TargetProto>;
+	phases: Array<EncounterPhase> = [];
+	// Incoming damage on the whole raid, used by healing sims.
+	raidDamage: RaidDamageModel = RaidDamageModel.create();
 	targetsMetadata: UnitMetadataList;
 	presetTargets!: Array<PresetTarget>;
 
 	readonly targetsChangeEmitter = new TypedEvent<void>();
 	readonly durationChangeEmitter = new TypedEvent<void>();
 	readonly executeProportionChangeEmitter = new TypedEvent<void>();
+	readonly raidDamageChangeEmitter = new TypedEvent<void>();
 
 	// Emits when any of the above emitters emit.
 	readonly changeEmitter = new TypedEvent<void>();
@@ -42,7 +46,7 @@ export class Encounter {
 
 			this.targets = [presetTarget.target!];
 
-			[this.targetsChangeEmitter, this.durationChangeEmitter, this.executeProportionChangeEmitter].forEach(emitter =>
+			[this.targetsChangeEmitter, this.durationChangeEmitter, this.executeProportionChangeEmitter, this.raidDamageChangeEmitter].forEach(emitter =>
 				emitter.on(eventID => this.changeEmitter.emit(eventID)),
 			);
 		});
@@ -111,12 +115,28 @@ export class Encounter {
 		this.executeProportionChangeEmitter.emit(eventID);
 	}
 
+	getRaidDamage(): RaidDamageModel {
+		return RaidDamageModel.clone(this.raidDamage);
+	}
+	setRaidDamage(eventID: EventID, newRaidDamage: RaidDamageModel) {
+		if (RaidDamageModel.equals(newRaidDamage, this.raidDamage)) return;
+
+		this.raidDamage = RaidDamageModel.clone(newRaidDamage);
+		this.raidDamageChangeEmitter.emit(eventID);
+	}
+
 	matchesPreset(preset: PresetEncounter): boolean {
-		return preset.targets.length == this.targets.length && this.targets.every((t, i) => TargetProto.equals(t, preset.targets[i].target));
+		return (
+			preset.targets.length == this.targets.length &&
+			this.targets.every((t, i) => TargetProto.equals(t, preset.targets[i].target)) &&
+			preset.phases.length == this.phases.length &&
+			this.phases.every((p, i) => EncounterPhase.equals(p, preset.phases[i]))
+		);
 	}
 
 	applyPreset(eventID: EventID, preset: PresetEncounter) {
 		this.targets = preset.targets.map(presetTarget => presetTarget.target || TargetProto.create());
+		this.phases = preset.phases;
 		this.targetsChangeEmitter.emit(eventID);
 	}
 
@@ -134,6 +154,8 @@ export class Encounter {
 			executeProportion35: this.executeProportion35,
 			useHealth: this.useHealth,
 			targets: this.targets,
+			phases: this.phases,
+			raidDamage: this.raidDamage.damagePerSecond > 0 ? this.raidDamage : undefined,
 		});
 	}
 
@@ -145,7 +167,9 @@ export class Encounter {
 			this.setExecuteProportion25(eventID, proto.executeProportion25);
 			this.setExecuteProportion35(eventID, proto.executeProportion35);
 			this.setUseHealth(eventID, proto.useHealth);
+			this.setRaidDamage(eventID, proto.raidDamage || RaidDamageModel.create());
 			this.targets = proto.targets;
+			this.phases = proto.phases;
 			this.targetsChangeEmitter.emit(eventID);
 		});
 	}
@@ -115,6 +115,10 @@ export class ActionId {
 				baseName = 'Incoming HPS';
 				iconUrl = 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_renew.jpg';
 				break;
+			case OtherAction.OtherActionRaidDamage:
+				baseName = 'Raid Damage';
+				iconUrl = 'https://wow.zamimg.com/images/wow/icons/large/spell_shadow_rainoffire.jpg';
+				break;
 			case OtherAction.OtherActionPotion:
 				baseName = 'Potion';
 				iconUrl = 'https://wow.zamimg.com/images/wow/icons/large/inv_alchemy_elixir_04.jpg';
@@ -307,6 +307,7 @@ export class UnitMetrics {
 	readonly dps: DistributionMetricsProto;
 	readonly dpasp: DistributionMetricsProto;
 	readonly hps: DistributionMetricsProto;
+	readonly ehps: DistributionMetricsProto;
 	readonly tps: DistributionMetricsProto;
 	readonly dtps: DistributionMetricsProto;
 	readonly tmi: DistributionMetricsProto;
@@ -358,6 +359,7 @@ export class UnitMetrics {
 		this.dps = this.metrics.dps!;
 		this.dpasp = this.metrics.dpasp!;
 		this.hps = this.metrics.hps!;
+		this.ehps = this.metrics.ehps!;
 		this.tps = this.metrics.threat!;
 		this.dtps = this.metrics.dtps!;
 		this.tmi = this.metrics.tmi!;
@@ -809,6 +811,10 @@ export class ActionMetrics {
 		return this.combinedMetrics.avgCastHealing;
 	}
 
+	get overhealPercent() {
+		return this.combinedMetrics.overhealPercent;
+	}
+
 	get avgCastThreat() {
 		return this.combinedMetrics.avgCastThreat;
 	}
@@ -1000,6 +1006,10 @@ export class TargetedActionMetrics {
 		return (this.data.healing + this.data.shielding) / this.iterations / (this.casts || 1);
 	}
 
+	get overhealPercent() {
+		return (this.data.overhealing / (this.data.healing || 1)) * 100;
+	}
+
 	get avgCastThreat() {
 		return this.data.threat / this.iterations / (this.casts || 1);
 	}
@@ -1080,6 +1090,7 @@ export class TargetedActionMetrics {
 				threat: sum(actions.map(a => a.data.threat)),
 				healing: sum(actions.map(a => a.data.healing)),
 				shielding: sum(actions.map(a => a.data.shielding)),
+				overhealing: sum(actions.map(a => a.data.overhealing)),
 				castTimeMs: sum(actions.map(a => a.data.castTimeMs)),
 			}),
 		);
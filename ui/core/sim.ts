@@ -191,7 +191,7 @@ export class Sim {
 		});
 	}
 
-	async runBulkSim(bulkSettings: BulkSettings, bulkItemsDb: SimDatabase, onProgress: (_?: any) => void): Promise<BulkSimResult> {
+	async runBulkSim(bulkSettings: BulkSettings, bulkItemsDb: SimDatabase, onProgress: (_?: any) => void, signal?: AbortSignal): Promise<BulkSimResult> {
 		if (this.raid.isEmpty()) {
 			throw new Error('Raid is empty! Try adding some players first.');
 		} else if (this.encounter.targets.length < 1) {
@@ -220,7 +220,7 @@ export class Sim {
 
 		this.bulkSimStartEmitter.emit(TypedEvent.nextEventID(), request);
 
-		const result = await this.workerPool.bulkSimAsync(request, onProgress);
+		const result = await this.workerPool.bulkSimAsync(request, onProgress, signal);
 		if (result.errorResult != '') {
 			throw new SimError(result.errorResult);
 		}
@@ -229,7 +229,7 @@ export class Sim {
 		return result;
 	}
 
-	async runRaidSim(eventID: EventID, onProgress: (_?: any) => void): Promise<SimResult> {
+	async runRaidSim(eventID: EventID, onProgress: (_?: any) => void, signal?: AbortSignal): Promise<SimResult> {
 		if (this.raid.isEmpty()) {
 			throw new Error('Raid is empty! Try adding some players first.');
 		} else if (this.encounter.targets.length < 1) {
@@ -240,7 +240,7 @@ export class Sim {
 
 		const request = this.makeRaidSimRequest(false);
 
-		const result = await this.workerPool.raidSimAsync(request, onProgress);
+		const result = await this.workerPool.raidSimAsync(request, onProgress, signal);
 		if (result.errorResult != '') {
 			throw new SimError(result.errorResult);
 		}
@@ -326,6 +326,7 @@ export class Sim {
 		epPseudoStats: Array<PseudoStat>,
 		epReferenceStat: Stat,
 		onProgress: (_?: any) => void,
+		signal?: AbortSignal,
 	): Promise<StatWeightsResult> {
 		if (this.raid.isEmpty()) {
 			throw new Error('Raid is empty! Try adding some players first.');
@@ -362,7 +363,7 @@ export class Sim {
 				pseudoStatsToWeigh: epPseudoStats,
 				epReferenceStat: epReferenceStat,
 			});
-			const result = await this.workerPool.statWeightsAsync(request, onProgress);
+			const result = await this.workerPool.statWeightsAsync(request, onProgress, signal);
 			return result;
 		}
 	}
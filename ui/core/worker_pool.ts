@@ -43,12 +43,14 @@ export class WorkerPool {
 		return `${id}progress`;
 	}
 
-	async statWeightsAsync(request: StatWeightsRequest, onProgress: WorkerProgressCallback): Promise<StatWeightsResult> {
+	async statWeightsAsync(request: StatWeightsRequest, onProgress: WorkerProgressCallback, signal?: AbortSignal): Promise<StatWeightsResult> {
 		console.log('Stat weights request: ' + StatWeightsRequest.toJsonString(request));
 		const worker = this.getLeastBusyWorker();
 		const id = worker.makeTaskId();
 		// Add handler for the progress events
 		worker.addPromiseFunc(this.getProgressName(id), this.newProgressHandler(id, worker, onProgress), noop);
+		// Aborting stops the sim, which still resolves with its partial results.
+		signal?.addEventListener('abort', () => worker.cancelTask(id), { once: true });
 
 		// Now start the async sim
 		const resultData = await worker.doApiCall(SimRequest.statWeightsAsync, StatWeightsRequest.toBinary(request), id);
@@ -57,12 +59,14 @@ export class WorkerPool {
 		return result.finalWeightResult!;
 	}
 
-	async bulkSimAsync(request: BulkSimRequest, onProgress: WorkerProgressCallback): Promise<BulkSimResult> {
+	async bulkSimAsync(request: BulkSimRequest, onProgress: WorkerProgressCallback, signal?: AbortSignal): Promise<BulkSimResult> {
 		console.log('bulk sim request: ' + BulkSimRequest.toJsonString(request, { enumAsInteger: true }));
 		const worker = this.getLeastBusyWorker();
 		const id = worker.makeTaskId();
 		// Add handler for the progress events
 		worker.addPromiseFunc(this.getProgressName(id), this.newProgressHandler(id, worker, onProgress), noop);
+		// Aborting stops the sim, which still resolves with its partial results.
+		signal?.addEventListener('abort', () => worker.cancelTask(id), { once: true });
 
 		// Now start the async sim
 		const resultData = await worker.doApiCall(SimRequest.bulkSimAsync, BulkSimRequest.toBinary(request), id);
@@ -72,12 +76,14 @@ export class WorkerPool {
 		return result.finalBulkResult!;
 	}
 
-	async raidSimAsync(request: RaidSimRequest, onProgress: WorkerProgressCallback): Promise<RaidSimResult> {
+	async raidSimAsync(request: RaidSimRequest, onProgress: WorkerProgressCallback, signal?: AbortSignal): Promise<RaidSimResult> {
 		console.log('Raid sim request: ' + RaidSimRequest.toJsonString(request));
 		const worker = this.getLeastBusyWorker();
 		const id = worker.makeTaskId();
 		// Add handler for the progress events
 		worker.addPromiseFunc(this.getProgressName(id), this.newProgressHandler(id, worker, onProgress), noop);
+		// Aborting stops the sim, which still resolves with its partial results.
+		signal?.addEventListener('abort', () => worker.cancelTask(id), { once: true });
 
 		// Now start the async sim
 		const resultData = await worker.doApiCall(SimRequest.raidSimAsync, RaidSimRequest.toBinary(request), id);
@@ -147,6 +153,10 @@ class SimWorker {
 		this.taskIdsToPromiseFuncs[id] = [callback, onError];
 	}
 
+	cancelTask(id: string) {
+		this.postMessage({ msg: 'cancel', id });
+	}
+
 	makeTaskId(): string {
 		let id = '';
 		const characters = 'ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789';
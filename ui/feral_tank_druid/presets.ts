@@ -1,22 +1,10 @@
 import { Phase } from '../core/constants/other.js';
-import {
-	Consumes,
-	Flask,
-	Food,
-	UnitReference
-} from '../core/proto/common.js';
-import { SavedTalents } from '../core/proto/ui.js';
-
-import {
-	FeralTankDruid_Options as DruidOptions,
-	FeralTankDruid_Rotation as DruidRotation,
-} from '../core/proto/druid.js';
-
 import * as PresetUtils from '../core/preset_utils.js';
-
-import BlankGear from './gear_sets/blank.gear.json';
-
-import DefaultApl from './apls/default.apl.json';
+import { AgilityElixir, ArmorElixir, Consumes, Flask, Food, StrengthBuff, UnitReference } from '../core/proto/common.js';
+import { FeralTankDruid_Options as DruidOptions, FeralTankDruid_Rotation as DruidRotation } from '../core/proto/druid.js';
+import { SavedTalents } from '../core/proto/ui.js';
+import Phase4APLTank from './apls/phase_4_tank.apl.json';
+import Phase4TankGear from './gear_sets/phase_4_tank.gear.json';
 
 // Preset options for this spec.
 // Eventually we will import these values for the raid sim too, so its good to
@@ -26,43 +14,42 @@ import DefaultApl from './apls/default.apl.json';
 //                                 Gear Presets
 ///////////////////////////////////////////////////////////////////////////
 
-export const GearBlank = PresetUtils.makePresetGear('Blank', BlankGear);
+export const GearTankPhase4 = PresetUtils.makePresetGear('P4 Tank', Phase4TankGear);
 
 export const GearPresets = {
-  [Phase.Phase1]: [
-    GearBlank,
-  ],
-  [Phase.Phase2]: [
-  ]
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [GearTankPhase4],
+	[Phase.Phase5]: [],
 };
 
-// TODO: Add Phase 2 preset and pull from map
-export const DefaultGear = GearPresets[Phase.Phase1][0];
+export const DefaultGear = GearPresets[Phase.Phase4][0];
 
 ///////////////////////////////////////////////////////////////////////////
 //                                 APL Presets
 ///////////////////////////////////////////////////////////////////////////
 
 export const DefaultRotation = DruidRotation.create({
-	maulRageThreshold: 25,
+	maulRageThreshold: 30,
 	maintainDemoralizingRoar: true,
-	lacerateTime: 8.0,
+	lacerateTime: 4.0,
 });
 
-export const DefaultAPL = PresetUtils.makePresetAPLRotation('Default', DefaultApl);
+export const APLPhase4Tank = PresetUtils.makePresetAPLRotation('P4 Tank', Phase4APLTank);
 
 export const APLPresets = {
-  [Phase.Phase1]: [
-    DefaultAPL,
-  ],
-  [Phase.Phase2]: [
-  ]
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [APLPhase4Tank],
+	[Phase.Phase5]: [],
 };
 
-// TODO: Add Phase 2 preset and pull from map
+export const DefaultAPL = APLPresets[Phase.Phase4][0];
+
 export const DefaultAPLs: Record<number, PresetUtils.PresetRotation> = {
-  25: APLPresets[Phase.Phase1][0],
-  40: APLPresets[Phase.Phase1][0],
+	60: APLPresets[Phase.Phase4][0],
 };
 
 ///////////////////////////////////////////////////////////////////////////
@@ -72,23 +59,20 @@ export const DefaultAPLs: Record<number, PresetUtils.PresetRotation> = {
 // Default talents. Uses the wowhead calculator format, make the talents on
 // https://wowhead.com/classic/talent-calc and copy the numbers in the url.
 
-export const StandardTalents = {
-	name: 'Standard',
-	data: SavedTalents.create({
-		talentsString: '-503232132322010353120300313511-20350001',
-	}),
-};
+export const TalentsPhase4Bear = PresetUtils.makePresetTalents('60 Bear', SavedTalents.create({ talentsString: '500005001-5050501303022151-0502' }), {
+	customCondition: player => player.getLevel() === 60,
+});
 
 export const TalentPresets = {
-  [Phase.Phase1]: [
-    StandardTalents,
-  ],
-  [Phase.Phase2]: [
-  ]
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [TalentsPhase4Bear],
+	[Phase.Phase5]: [],
 };
 
-// TODO: Add Phase 2 preset and pull from map
-export const DefaultTalents = TalentPresets[Phase.Phase1][0];
+export const StandardTalents = TalentPresets[Phase.Phase4][0];
+export const DefaultTalents = StandardTalents;
 
 ///////////////////////////////////////////////////////////////////////////
 //                                 Options
@@ -96,10 +80,14 @@ export const DefaultTalents = TalentPresets[Phase.Phase1][0];
 
 export const DefaultOptions = DruidOptions.create({
 	innervateTarget: UnitReference.create(),
-	startingRage: 20,
+	startingRage: 0,
 });
 
 export const DefaultConsumes = Consumes.create({
-	flask: Flask.FlaskUnknown,
-	food: Food.FoodUnknown,
+	agilityElixir: AgilityElixir.ElixirOfTheMongoose,
+	armorElixir: ArmorElixir.ElixirOfSuperiorDefense,
+	dragonBreathChili: true,
+	flask: Flask.FlaskOfTheTitans,
+	food: Food.FoodSmokedDesertDumpling,
+	strengthBuff: StrengthBuff.JujuPower,
 });
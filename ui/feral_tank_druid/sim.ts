@@ -136,15 +136,15 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecFeralTankDruid, {
 
 	presets: {
 		// Preset talents that the user can quickly select.
-		talents: [...Presets.TalentPresets[Phase.Phase2], ...Presets.TalentPresets[Phase.Phase1]],
+		talents: [...Presets.TalentPresets[Phase.Phase4]],
 		// Preset rotations that the user can quickly select.
-		rotations: [...Presets.APLPresets[Phase.Phase2], ...Presets.APLPresets[Phase.Phase1]],
+		rotations: [...Presets.APLPresets[Phase.Phase4]],
 		// Preset gear configurations that the user can quickly select.
-		gear: [...Presets.GearPresets[Phase.Phase2], ...Presets.GearPresets[Phase.Phase1]],
+		gear: [...Presets.GearPresets[Phase.Phase4]],
 	},
 
 	autoRotation: player => {
-		return Presets.DefaultAPLs[player.getLevel()].rotation.rotation!;
+		return (Presets.DefaultAPLs[player.getLevel()] ?? Presets.DefaultAPL).rotation.rotation!;
 	},
 
 	raidSimPresets: [
@@ -165,10 +165,10 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecFeralTankDruid, {
 			defaultGear: {
 				[Faction.Unknown]: {},
 				[Faction.Alliance]: {
-					1: Presets.DefaultGear.gear,
+					4: Presets.DefaultGear.gear,
 				},
 				[Faction.Horde]: {
-					1: Presets.DefaultGear.gear,
+					4: Presets.DefaultGear.gear,
 				},
 			},
 		},
@@ -1,73 +1,84 @@
+import { Phase } from '../core/constants/other.js';
 import * as PresetUtils from '../core/preset_utils.js';
-import {
-	Consumes,
-	Debuffs,
-	Flask,
-	Food,
-	IndividualBuffs,
-	RaidBuffs,
-	TristateEffect,
-	UnitReference,
-} from '../core/proto/common.js';
-import {
-	HealingPriest_Options as Options,
-} from '../core/proto/priest.js';
+import { Consumes, Flask, Food, Potions, WeaponImbue } from '../core/proto/common.js';
+import { HealingPriest_Options as Options } from '../core/proto/priest.js';
 import { SavedTalents } from '../core/proto/ui.js';
 import DiscApl from './apls/disc.apl.json';
 import HolyApl from './apls/holy.apl.json';
-import BlankGear from './gear_sets/blank.gear.json';
+import Phase4DiscGear from './gear_sets/phase_4_disc.gear.json';
+import Phase4HolyGear from './gear_sets/phase_4_holy.gear.json';
 
 // Preset options for this spec.
 // Eventually we will import these values for the raid sim too, so its good to
 // keep them in a separate file.
 
-export const DiscDefaultGear = PresetUtils.makePresetGear('Blank', BlankGear, { talentTree: 0 });
-export const HolyDefaultGear = PresetUtils.makePresetGear('Blank', BlankGear, { talentTree: 1 });
+///////////////////////////////////////////////////////////////////////////
+//                                 Gear Presets
+///////////////////////////////////////////////////////////////////////////
 
-export const ROTATION_PRESET_DISC = PresetUtils.makePresetAPLRotation('Disc', DiscApl);
-export const ROTATION_PRESET_HOLY = PresetUtils.makePresetAPLRotation('Holy', HolyApl);
+export const GearDiscPhase4 = PresetUtils.makePresetGear('Phase 4 Disc', Phase4DiscGear, { talentTree: 0 });
+export const GearHolyPhase4 = PresetUtils.makePresetGear('Phase 4 Holy', Phase4HolyGear, { talentTree: 1 });
 
-// Default talents. Uses the wowhead calculator format, make the talents on
-// https://wowhead.com/classic/talent-calc and copy the numbers in the url.
-export const DiscTalents = {
-	name: 'Disc',
-	data: SavedTalents.create({
-		talentsString: '0503203130300512301313231251-2351010303',
-	}),
+export const GearPresets = {
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [GearDiscPhase4, GearHolyPhase4],
+	[Phase.Phase5]: [],
 };
-export const HolyTalents = {
-	name: 'Holy',
-	data: SavedTalents.create({
-		talentsString: '05032031103-234051032002152530004311051',
-	}),
+
+export const DefaultGear = GearPresets[Phase.Phase4][0];
+
+///////////////////////////////////////////////////////////////////////////
+//                                 APL Presets
+///////////////////////////////////////////////////////////////////////////
+
+export const APLDisc = PresetUtils.makePresetAPLRotation('Disc', DiscApl, { talentTree: 0 });
+export const APLHoly = PresetUtils.makePresetAPLRotation('Holy', HolyApl, { talentTree: 1 });
+
+export const APLPresets = {
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [APLDisc, APLHoly],
+	[Phase.Phase5]: [],
 };
 
-export const DefaultOptions = Options.create({
-	useInnerFire: true,
-	useShadowfiend: true,
-	rapturesPerMinute: 5,
+export const DefaultAPL = APLPresets[Phase.Phase4][0];
 
-	powerInfusionTarget: UnitReference.create(),
-});
+///////////////////////////////////////////////////////////////////////////
+//                                 Talent Presets
+///////////////////////////////////////////////////////////////////////////
 
-export const DefaultConsumes = Consumes.create({
-	flask: Flask.FlaskUnknown,
-	food: Food.FoodUnknown,
-});
+// Default talents. Uses the wowhead calculator format, make the talents on
+// https://wowhead.com/classic/talent-calc and copy the numbers in the url.
 
-export const DefaultRaidBuffs = RaidBuffs.create({
-	giftOfTheWild: TristateEffect.TristateEffectImproved,
-	powerWordFortitude: TristateEffect.TristateEffectImproved,
-	strengthOfEarthTotem: TristateEffect.TristateEffectRegular,
-	arcaneBrilliance: true,
-	divineSpirit: true,
-	moonkinAura: true,
+export const TalentsDiscPhase4 = PresetUtils.makePresetTalents('60 Disc', SavedTalents.create({ talentsString: '000030135050515-03505003030215' }), {
+	customCondition: player => player.getLevel() === 60,
 });
-
-export const DefaultIndividualBuffs = IndividualBuffs.create({
-	blessingOfKings: true,
-	blessingOfWisdom: TristateEffect.TristateEffectImproved,
+export const TalentsHolyPhase4 = PresetUtils.makePresetTalents('60 Holy', SavedTalents.create({ talentsString: '0000001305051-035050030301155' }), {
+	customCondition: player => player.getLevel() === 60,
 });
 
-export const DefaultDebuffs = Debuffs.create({
+export const TalentPresets = {
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [TalentsDiscPhase4, TalentsHolyPhase4],
+	[Phase.Phase5]: [],
+};
+
+export const DefaultTalents = TalentPresets[Phase.Phase4][0];
+
+///////////////////////////////////////////////////////////////////////////
+//                                 Options
+///////////////////////////////////////////////////////////////////////////
+
+export const DefaultOptions = Options.create({});
+
+export const DefaultConsumes = Consumes.create({
+	defaultPotion: Potions.MajorManaPotion,
+	flask: Flask.FlaskOfDistilledWisdom,
+	food: Food.FoodNightfinSoup,
+	mainHandImbue: WeaponImbue.BrilliantManaOil,
 });
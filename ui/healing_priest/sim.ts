@@ -1,39 +1,20 @@
+import * as OtherInputs from '../core/components/other_inputs.js';
+import { Phase } from '../core/constants/other.js';
 import { IndividualSimUI, registerSpecConfig } from '../core/individual_sim_ui.js';
 import { Player } from '../core/player.js';
-import {
-	APLRotation,
-} from '../core/proto/apl.js';
-import {
-	Class,
-	Faction,
-	PartyBuffs,
-	Race,
-	Spec,
-	Stat,
-} from '../core/proto/common.js';
+import { Class, Debuffs, Faction, IndividualBuffs, PartyBuffs, Race, RaidBuffs, Spec, Stat, TristateEffect } from '../core/proto/common.js';
 import { Stats } from '../core/proto_utils/stats.js';
 import { getSpecIcon } from '../core/proto_utils/utils.js';
-import * as HealingPriestInputs from './inputs.js';
 import * as Presets from './presets.js';
 
 const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 	cssClass: 'healing-priest-sim-ui',
 	cssScheme: 'priest',
 	// List any known bugs / issues here and they'll be shown on the site.
-	knownIssues: [
-		'Talents that apply to, "friendly targets at or below 50% health" are not implemented.',
-		'Prayer of Mending always bounces the maximum number of times.',
-	],
+	knownIssues: [],
 
 	// All stats for which EP should be calculated.
-	epStats: [
-		Stat.StatIntellect,
-		Stat.StatSpirit,
-		Stat.StatSpellPower,
-		Stat.StatSpellCrit,
-		Stat.StatSpellHaste,
-		Stat.StatMP5,
-	],
+	epStats: [Stat.StatIntellect, Stat.StatSpirit, Stat.StatSpellPower, Stat.StatHealingPower, Stat.StatSpellCrit, Stat.StatSpellHaste, Stat.StatMP5],
 	// Reference stat against which to calculate EP. I think all classes use either spell power or attack power.
 	epReferenceStat: Stat.StatSpellPower,
 	// Which stats to display in the Character Stats section, at the bottom of the left-hand sidebar.
@@ -44,6 +25,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 		Stat.StatIntellect,
 		Stat.StatSpirit,
 		Stat.StatSpellPower,
+		Stat.StatHealingPower,
 		Stat.StatSpellCrit,
 		Stat.StatSpellHaste,
 		Stat.StatMP5,
@@ -51,12 +33,13 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 
 	defaults: {
 		// Default equipped gear.
-		gear: Presets.DiscDefaultGear.gear,
+		gear: Presets.DefaultGear.gear,
 		// Default EP weights for sorting gear in the gear picker.
 		epWeights: Stats.fromMap({
 			[Stat.StatIntellect]: 2.73,
 			[Stat.StatSpirit]: 1.63,
 			[Stat.StatSpellPower]: 1,
+			[Stat.StatHealingPower]: 1,
 			[Stat.StatSpellCrit]: 0.75,
 			[Stat.StatSpellHaste]: 0.28,
 			[Stat.StatMP5]: 2.05,
@@ -64,30 +47,35 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 		// Default consumes settings.
 		consumes: Presets.DefaultConsumes,
 		// Default talents.
-		talents: Presets.DiscTalents.data,
+		talents: Presets.DefaultTalents.data,
 		// Default spec-specific settings.
 		specOptions: Presets.DefaultOptions,
 		// Default raid/party buffs settings.
-		raidBuffs: Presets.DefaultRaidBuffs,
+		raidBuffs: RaidBuffs.create({
+			giftOfTheWild: TristateEffect.TristateEffectImproved,
+			powerWordFortitude: TristateEffect.TristateEffectImproved,
+			strengthOfEarthTotem: TristateEffect.TristateEffectRegular,
+			arcaneBrilliance: true,
+			divineSpirit: true,
+			moonkinAura: true,
+			manaSpringTotem: TristateEffect.TristateEffectRegular,
+		}),
 		partyBuffs: PartyBuffs.create({}),
-		individualBuffs: Presets.DefaultIndividualBuffs,
-		debuffs: Presets.DefaultDebuffs,
+		individualBuffs: IndividualBuffs.create({
+			blessingOfKings: true,
+			blessingOfWisdom: TristateEffect.TristateEffectImproved,
+		}),
+		debuffs: Debuffs.create({}),
 	},
 
 	// IconInputs to include in the 'Player' section on the settings tab.
-	playerIconInputs: [
-		HealingPriestInputs.SelfPowerInfusion,
-		HealingPriestInputs.InnerFire,
-		HealingPriestInputs.Shadowfiend,
-	],
+	playerIconInputs: [],
 	// Buff and Debuff inputs to include/exclude, overriding the EP-based defaults.
-	includeBuffDebuffInputs: [
-	],
-	excludeBuffDebuffInputs: [
-	],
+	includeBuffDebuffInputs: [],
+	excludeBuffDebuffInputs: [],
 	// Inputs to include in the 'Other' section on the settings tab.
 	otherInputs: {
-		inputs: [],
+		inputs: [OtherInputs.TankAssignment, OtherInputs.InspirationUptime],
 	},
 	encounterPicker: {
 		// Whether to include 'Execute Duration (%)' in the 'Encounter' section of the settings tab.
@@ -96,29 +84,15 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 
 	presets: {
 		// Preset talents that the user can quickly select.
-		talents: [
-			Presets.DiscTalents,
-			Presets.HolyTalents,
-		],
+		talents: [...Presets.TalentPresets[Phase.Phase4]],
 		// Preset rotations that the user can quickly select.
-		rotations: [
-			Presets.ROTATION_PRESET_DISC,
-			Presets.ROTATION_PRESET_HOLY,
-		],
+		rotations: [...Presets.APLPresets[Phase.Phase4]],
 		// Preset gear configurations that the user can quickly select.
-		gear: [
-			Presets.DiscDefaultGear,
-			Presets.HolyDefaultGear,
-		],
+		gear: [...Presets.GearPresets[Phase.Phase4]],
 	},
 
-	autoRotation: (player: Player<Spec.SpecHealingPriest>): APLRotation => {
-		const talentTree = player.getTalentTree();
-		if (talentTree == 0) {
-			return Presets.ROTATION_PRESET_DISC.rotation.rotation!;
-		} else {
-			return Presets.ROTATION_PRESET_HOLY.rotation.rotation!;
-		}
+	autoRotation: player => {
+		return (player.getTalentTree() == 1 ? Presets.APLHoly : Presets.APLDisc).rotation.rotation!;
 	},
 
 	raidSimPresets: [
@@ -128,7 +102,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 			defaultName: 'Discipline',
 			iconUrl: getSpecIcon(Class.ClassPriest, 0),
 
-			talents: Presets.DiscTalents.data,
+			talents: Presets.TalentsDiscPhase4.data,
 			specOptions: Presets.DefaultOptions,
 			consumes: Presets.DefaultConsumes,
 			defaultFactionRaces: {
@@ -139,10 +113,10 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 			defaultGear: {
 				[Faction.Unknown]: {},
 				[Faction.Alliance]: {
-					1: Presets.DiscDefaultGear.gear,
+					4: Presets.GearDiscPhase4.gear,
 				},
 				[Faction.Horde]: {
-					1: Presets.DiscDefaultGear.gear,
+					4: Presets.GearDiscPhase4.gear,
 				},
 			},
 		},
@@ -152,7 +126,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 			defaultName: 'Holy',
 			iconUrl: getSpecIcon(Class.ClassPriest, 1),
 
-			talents: Presets.HolyTalents.data,
+			talents: Presets.TalentsHolyPhase4.data,
 			specOptions: Presets.DefaultOptions,
 			consumes: Presets.DefaultConsumes,
 			defaultFactionRaces: {
@@ -163,10 +137,10 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHealingPriest, {
 			defaultGear: {
 				[Faction.Unknown]: {},
 				[Faction.Alliance]: {
-					1: Presets.HolyDefaultGear.gear,
+					4: Presets.GearHolyPhase4.gear,
 				},
 				[Faction.Horde]: {
-					1: Presets.HolyDefaultGear.gear,
+					4: Presets.GearHolyPhase4.gear,
 				},
 			},
 		},
@@ -1,40 +1,83 @@
-import {
-	Consumes,
-	Flask,
-	Food,
-} from '../core/proto/common.js';
-import { SavedTalents } from '../core/proto/ui.js';
-
-import {
-	PaladinAura,
-	HolyPaladin_Options as HolyPaladinOptions,
-} from '../core/proto/paladin.js';
-
+import { Phase } from '../core/constants/other.js';
 import * as PresetUtils from '../core/preset_utils.js';
-
-import BlankGear from './gear_sets/blank.gear.json';
+import { Consumes, Flask, Food, Potions, WeaponImbue } from '../core/proto/common.js';
+import { HolyPaladin_Options as HolyPaladinOptions, PaladinAura } from '../core/proto/paladin.js';
+import { SavedTalents } from '../core/proto/ui.js';
+import Phase4APL from './apls/phase_4.apl.json';
+import Phase4Gear from './gear_sets/phase_4.gear.json';
 
 // Preset options for this spec.
 // Eventually we will import these values for the raid sim too, so its good to
 // keep them in a separate file.
 
-export const DefaultGear = PresetUtils.makePresetGear('Blank', BlankGear);
+///////////////////////////////////////////////////////////////////////////
+//                                 Gear Presets
+///////////////////////////////////////////////////////////////////////////
+
+export const GearPhase4 = PresetUtils.makePresetGear('Phase 4', Phase4Gear);
+
+export const GearPresets = {
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [GearPhase4],
+	[Phase.Phase5]: [],
+};
+
+export const DefaultGear = GearPresets[Phase.Phase4][0];
+
+///////////////////////////////////////////////////////////////////////////
+//                                 APL Presets
+///////////////////////////////////////////////////////////////////////////
+
+export const APLPhase4 = PresetUtils.makePresetAPLRotation('Phase 4', Phase4APL);
+
+export const APLPresets = {
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [APLPhase4],
+	[Phase.Phase5]: [],
+};
+
+export const DefaultAPL = APLPresets[Phase.Phase4][0];
+
+export const DefaultAPLs: Record<number, PresetUtils.PresetRotation> = {
+	60: APLPresets[Phase.Phase4][0],
+};
+
+///////////////////////////////////////////////////////////////////////////
+//                                 Talent Presets
+///////////////////////////////////////////////////////////////////////////
 
 // Default talents. Uses the wowhead calculator format, make the talents on
 // https://wowhead.com/classic/talent-calc and copy the numbers in the url.
 
-export const StandardTalents = {
-	name: 'Standard',
-	data: SavedTalents.create({
-		talentsString: '50350151020013053100515221-50023131203',
-	}),
+export const TalentsPhase4 = PresetUtils.makePresetTalents('60 Holy', SavedTalents.create({ talentsString: '05503100520051--052030512' }), {
+	customCondition: player => player.getLevel() === 60,
+});
+
+export const TalentPresets = {
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [TalentsPhase4],
+	[Phase.Phase5]: [],
 };
 
+export const DefaultTalents = TalentPresets[Phase.Phase4][0];
+
+///////////////////////////////////////////////////////////////////////////
+//                                 Options
+///////////////////////////////////////////////////////////////////////////
+
 export const DefaultOptions = HolyPaladinOptions.create({
 	aura: PaladinAura.DevotionAura,
 });
 
 export const DefaultConsumes = Consumes.create({
-	flask: Flask.FlaskUnknown,
-	food: Food.FoodUnknown,
+	defaultPotion: Potions.MajorManaPotion,
+	flask: Flask.FlaskOfDistilledWisdom,
+	food: Food.FoodNightfinSoup,
+	mainHandImbue: WeaponImbue.BrilliantManaOil,
 });
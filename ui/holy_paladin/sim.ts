@@ -1,7 +1,7 @@
 import * as OtherInputs from '../core/components/other_inputs.js';
+import { Phase } from '../core/constants/other.js';
 import { IndividualSimUI, registerSpecConfig } from '../core/individual_sim_ui.js';
 import { Player } from '../core/player.js';
-import { APLRotation } from '../core/proto/apl.js';
 import { Class, Debuffs, Faction, IndividualBuffs, PartyBuffs, Race, RaidBuffs, Spec, Stat, TristateEffect } from '../core/proto/common.js';
 import { Stats } from '../core/proto_utils/stats.js';
 import { getSpecIcon } from '../core/proto_utils/utils.js';
@@ -15,7 +15,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 	knownIssues: [],
 
 	// All stats for which EP should be calculated.
-	epStats: [Stat.StatIntellect, Stat.StatSpirit, Stat.StatSpellPower, Stat.StatSpellCrit, Stat.StatSpellHaste, Stat.StatMP5],
+	epStats: [Stat.StatIntellect, Stat.StatSpirit, Stat.StatSpellPower, Stat.StatHealingPower, Stat.StatSpellCrit, Stat.StatSpellHaste, Stat.StatMP5],
 	// Reference stat against which to calculate EP. I think all classes use either spell power or attack power.
 	epReferenceStat: Stat.StatSpellPower,
 	// Which stats to display in the Character Stats section, at the bottom of the left-hand sidebar.
@@ -26,6 +26,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 		Stat.StatIntellect,
 		Stat.StatSpirit,
 		Stat.StatSpellPower,
+		Stat.StatHealingPower,
 		Stat.StatSpellCrit,
 		Stat.StatSpellHaste,
 		Stat.StatMP5,
@@ -38,6 +39,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 			[Stat.StatIntellect]: 0.38,
 			[Stat.StatSpirit]: 0.34,
 			[Stat.StatSpellPower]: 1,
+			[Stat.StatHealingPower]: 1,
 			[Stat.StatSpellCrit]: 0.69,
 			[Stat.StatSpellHaste]: 0.77,
 			[Stat.StatMP5]: 0.0,
@@ -45,7 +47,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 		// Default consumes settings.
 		consumes: Presets.DefaultConsumes,
 		// Default talents.
-		talents: Presets.StandardTalents.data,
+		talents: Presets.DefaultTalents.data,
 		// Default spec-specific settings.
 		specOptions: Presets.DefaultOptions,
 		// Default raid/party buffs settings.
@@ -94,14 +96,15 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 
 	presets: {
 		// Preset talents that the user can quickly select.
-		talents: [Presets.StandardTalents],
-		rotations: [],
+		talents: [...Presets.TalentPresets[Phase.Phase4]],
+		// Preset rotations that the user can quickly select.
+		rotations: [...Presets.APLPresets[Phase.Phase4]],
 		// Preset gear configurations that the user can quickly select.
-		gear: [Presets.DefaultGear],
+		gear: [...Presets.GearPresets[Phase.Phase4]],
 	},
 
-	autoRotation: (_player: Player<Spec.SpecHolyPaladin>): APLRotation => {
-		return APLRotation.create();
+	autoRotation: player => {
+		return (Presets.DefaultAPLs[player.getLevel()] ?? Presets.DefaultAPL).rotation.rotation!;
 	},
 
 	raidSimPresets: [
@@ -111,7 +114,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 			defaultName: 'Holy',
 			iconUrl: getSpecIcon(Class.ClassPaladin, 0),
 
-			talents: Presets.StandardTalents.data,
+			talents: Presets.DefaultTalents.data,
 			specOptions: Presets.DefaultOptions,
 			consumes: Presets.DefaultConsumes,
 			defaultFactionRaces: {
@@ -122,10 +125,10 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecHolyPaladin, {
 			defaultGear: {
 				[Faction.Unknown]: {},
 				[Faction.Alliance]: {
-					1: Presets.DefaultGear.gear,
+					4: Presets.DefaultGear.gear,
 				},
 				[Faction.Horde]: {
-					1: Presets.DefaultGear.gear,
+					4: Presets.DefaultGear.gear,
 				},
 			},
 		},
@@ -1,19 +1,24 @@
 import { Phase } from '../core/constants/other.js';
+import * as PresetUtils from '../core/preset_utils.js';
 import {
+	AgilityElixir,
+	ArmorElixir,
 	Consumes,
 	Flask,
 	Food,
 	Spec,
+	StrengthBuff,
+	WeaponImbue,
 } from '../core/proto/common.js';
 import { SavedTalents } from '../core/proto/ui.js';
-
 import {
-	WarriorShout,
-	ProtectionWarrior_Rotation as ProtectionWarriorRotation,
 	ProtectionWarrior_Options as ProtectionWarriorOptions,
+	ProtectionWarrior_Rotation as ProtectionWarriorRotation,
+	WarriorShout,
+	WarriorStance,
 } from '../core/proto/warrior.js';
-
-import * as PresetUtils from '../core/preset_utils.js';
+import Phase4APLTank from './apls/phase_4_tank.apl.json';
+import Phase4TankGear from './gear_sets/phase_4_tank.gear.json';
 
 // Preset options for this spec.
 // Eventually we will import these values for the raid sim too, so its good to
@@ -23,41 +28,36 @@ import * as PresetUtils from '../core/preset_utils.js';
 //                                 Gear Presets
 ///////////////////////////////////////////////////////////////////////////
 
-import BlankGear from './gear_sets/blank.gear.json';
-
-export const GearBlank = PresetUtils.makePresetGear('Blank', BlankGear);
+export const GearTankPhase4 = PresetUtils.makePresetGear('P4 Tank', Phase4TankGear);
 
 export const GearPresets = {
-  [Phase.Phase1]: [
-    GearBlank,
-  ],
-  [Phase.Phase2]: [
-  ]
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [GearTankPhase4],
+	[Phase.Phase5]: [],
 };
 
-// TODO: Add Phase 2 preset and pull from map
-export const DefaultGear = GearPresets[Phase.Phase1][0];
+export const DefaultGear = GearPresets[Phase.Phase4][0];
 
 ///////////////////////////////////////////////////////////////////////////
 //                                 APL Presets
 ///////////////////////////////////////////////////////////////////////////
 
-import DefaultApl from './apls/default.apl.json';
-
-export const DefaultAPL = PresetUtils.makePresetAPLRotation('Default', DefaultApl);
+export const APLPhase4Tank = PresetUtils.makePresetAPLRotation('P4 Tank', Phase4APLTank);
 
 export const APLPresets = {
-  [Phase.Phase1]: [
-    DefaultAPL,
-  ],
-  [Phase.Phase2]: [
-  ]
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [APLPhase4Tank],
+	[Phase.Phase5]: [],
 };
 
-// TODO: Add Phase 2 preset and pull from map
+export const DefaultAPL = APLPresets[Phase.Phase4][0];
+
 export const DefaultAPLs: Record<number, PresetUtils.PresetRotation> = {
-  25: APLPresets[Phase.Phase1][0],
-  40: APLPresets[Phase.Phase1][0],
+	60: APLPresets[Phase.Phase4][0],
 };
 
 export const ROTATION_PRESET_SIMPLE = PresetUtils.makePresetSimpleRotation('Simple Cooldowns', Spec.SpecProtectionWarrior, ProtectionWarriorRotation.create());
@@ -69,41 +69,37 @@ export const ROTATION_PRESET_SIMPLE = PresetUtils.makePresetSimpleRotation('Simp
 // Default talents. Uses the wowhead calculator format, make the talents on
 // https://wowhead.com/classic/talent-calc and copy the numbers in the url.
 
-export const StandardTalents = {
-	name: 'Standard',
-	data: SavedTalents.create({
-		talentsString: '2500030023-302-053351225000012521030113321',
-	}),
-};
-
-export const UATalents = {
-	name: 'UA',
-	data: SavedTalents.create({
-		talentsString: '35023301230051002020120002-2-05035122500000252',
-	}),
-};
+export const TalentsPhase4Prot = PresetUtils.makePresetTalents('60 Prot', SavedTalents.create({ talentsString: '-0505-55250133530021051' }), {
+	customCondition: player => player.getLevel() === 60,
+});
 
 export const TalentPresets = {
-  [Phase.Phase1]: [
-    StandardTalents,
-  ],
-  [Phase.Phase2]: [
-  ]
+	[Phase.Phase1]: [],
+	[Phase.Phase2]: [],
+	[Phase.Phase3]: [],
+	[Phase.Phase4]: [TalentsPhase4Prot],
+	[Phase.Phase5]: [],
 };
 
-// TODO: Add Phase 2 preset and pull from map
-export const DefaultTalents = TalentPresets[Phase.Phase1][0];
+export const StandardTalents = TalentPresets[Phase.Phase4][0];
+export const DefaultTalents = StandardTalents;
 
 ///////////////////////////////////////////////////////////////////////////
 //                                 Options
 ///////////////////////////////////////////////////////////////////////////
 
 export const DefaultOptions = ProtectionWarriorOptions.create({
-	shout: WarriorShout.WarriorShoutCommanding,
+	shout: WarriorShout.WarriorShoutBattle,
+	stance: WarriorStance.WarriorStanceDefensive,
 	startingRage: 0,
 });
 
 export const DefaultConsumes = Consumes.create({
-	flask: Flask.FlaskUnknown,
-	food: Food.FoodUnknown,
+	agilityElixir: AgilityElixir.ElixirOfTheMongoose,
+	armorElixir: ArmorElixir.ElixirOfSuperiorDefense,
+	dragonBreathChili: true,
+	flask: Flask.FlaskOfTheTitans,
+	food: Food.FoodSmokedDesertDumpling,
+	mainHandImbue: WeaponImbue.ElementalSharpeningStone,
+	strengthBuff: StrengthBuff.JujuPower,
 });
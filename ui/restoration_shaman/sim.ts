@@ -1,7 +1,7 @@
 import * as OtherInputs from '../core/components/other_inputs.js';
+import { Phase } from '../core/constants/other.js';
 import { IndividualSimUI, registerSpecConfig } from '../core/individual_sim_ui.js';
 import { Player } from '../core/player.js';
-import { APLRotation } from '../core/proto/apl.js';
 import { Class, Debuffs, Faction, IndividualBuffs, PartyBuffs, Race, RaidBuffs, Spec, Stat, TristateEffect } from '../core/proto/common.js';
 import { Stats } from '../core/proto_utils/stats.js';
 import { getSpecIcon, specNames } from '../core/proto_utils/utils.js';
@@ -16,7 +16,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 	warnings: [],
 
 	// All stats for which EP should be calculated.
-	epStats: [Stat.StatIntellect, Stat.StatSpirit, Stat.StatSpellPower, Stat.StatSpellCrit, Stat.StatSpellHaste, Stat.StatMP5],
+	epStats: [Stat.StatIntellect, Stat.StatSpirit, Stat.StatSpellPower, Stat.StatHealingPower, Stat.StatSpellCrit, Stat.StatSpellHaste, Stat.StatMP5],
 	// Reference stat against which to calculate EP. I think all classes use either spell power or attack power.
 	epReferenceStat: Stat.StatSpellPower,
 	// Which stats to display in the Character Stats section, at the bottom of the left-hand sidebar.
@@ -27,6 +27,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 		Stat.StatIntellect,
 		Stat.StatSpirit,
 		Stat.StatSpellPower,
+		Stat.StatHealingPower,
 		Stat.StatSpellCrit,
 		Stat.StatSpellHaste,
 		Stat.StatMP5,
@@ -40,6 +41,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 			[Stat.StatIntellect]: 0.22,
 			[Stat.StatSpirit]: 0.05,
 			[Stat.StatSpellPower]: 1,
+			[Stat.StatHealingPower]: 1,
 			[Stat.StatSpellCrit]: 0.67,
 			[Stat.StatSpellHaste]: 1.29,
 			[Stat.StatMP5]: 0.08,
@@ -47,7 +49,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 		// Default consumes settings.
 		consumes: Presets.DefaultConsumes,
 		// Default talents.
-		talents: Presets.RaidHealingTalents.data,
+		talents: Presets.DefaultTalents.data,
 		// Default spec-specific settings.
 		specOptions: Presets.DefaultOptions,
 		// Default raid/party buffs settings.
@@ -85,14 +87,15 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 
 	presets: {
 		// Preset talents that the user can quickly select.
-		talents: [Presets.RaidHealingTalents, Presets.TankHealingTalents],
-		rotations: [],
+		talents: [...Presets.TalentPresets[Phase.Phase4]],
+		// Preset rotations that the user can quickly select.
+		rotations: [...Presets.APLPresets[Phase.Phase4]],
 		// Preset gear configurations that the user can quickly select.
-		gear: [Presets.DefaultGear],
+		gear: [...Presets.GearPresets[Phase.Phase4]],
 	},
 
-	autoRotation: (_player: Player<Spec.SpecRestorationShaman>): APLRotation => {
-		return APLRotation.create();
+	autoRotation: player => {
+		return (Presets.DefaultAPLs[player.getLevel()] ?? Presets.DefaultAPL).rotation.rotation!;
 	},
 
 	raidSimPresets: [
@@ -102,7 +105,7 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 			defaultName: 'Restoration',
 			iconUrl: getSpecIcon(Class.ClassShaman, 2),
 
-			talents: Presets.RaidHealingTalents.data,
+			talents: Presets.DefaultTalents.data,
 			specOptions: Presets.DefaultOptions,
 			consumes: Presets.DefaultConsumes,
 			defaultFactionRaces: {
@@ -113,10 +116,10 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecRestorationShaman, {
 			defaultGear: {
 				[Faction.Unknown]: {},
 				[Faction.Alliance]: {
-					1: Presets.DefaultGear.gear,
+					4: Presets.DefaultGear.gear,
 				},
 				[Faction.Horde]: {
-					1: Presets.DefaultGear.gear,
+					4: Presets.DefaultGear.gear,
 				},
 			},
 		},
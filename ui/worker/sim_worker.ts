@@ -1,6 +1,6 @@
 import { WorkerInterface } from './worker_interface';
 
-type SimRequestAsync = (data: Uint8Array, progress: (result: Uint8Array) => void) => Uint8Array;
+type SimRequestAsync = (data: Uint8Array, progress: (result: Uint8Array) => void, id: string) => Promise<Uint8Array>;
 type SimRequestSync = (data: Uint8Array) => Uint8Array;
 
 // Functions provided or used by the wasm lib.
@@ -14,21 +14,25 @@ declare global {
 	const raidSimAsync: SimRequestAsync;
 	const statWeights: SimRequestSync;
 	const statWeightsAsync: SimRequestAsync;
+	const cancel: (id: string) => boolean;
 }
 
 // Wasm binary calls this function when its done loading.
 // eslint-disable-next-line @typescript-eslint/no-unused-vars
 globalThis.wasmready = function () {
-	new WorkerInterface({
-		bulkSimAsync: (data, progress) => bulkSimAsync(data, progress),
-		computeStats: computeStats,
-		computeStatsJson: computeStatsJson,
-		raidSim: raidSim,
-		raidSimJson: raidSimJson,
-		raidSimAsync: (data, progress) => raidSimAsync(data, progress),
-		statWeights: statWeights,
-		statWeightsAsync: (data, progress) => statWeightsAsync(data, progress),
-	}).ready();
+	new WorkerInterface(
+		{
+			bulkSimAsync: (data, progress, _, id) => bulkSimAsync(data, progress, id),
+			computeStats: computeStats,
+			computeStatsJson: computeStatsJson,
+			raidSim: raidSim,
+			raidSimJson: raidSimJson,
+			raidSimAsync: (data, progress, _, id) => raidSimAsync(data, progress, id),
+			statWeights: statWeights,
+			statWeightsAsync: (data, progress, _, id) => statWeightsAsync(data, progress, id),
+		},
+		id => cancel(id),
+	).ready();
 };
 
 const go = new Go();
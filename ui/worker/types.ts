@@ -15,7 +15,7 @@ export enum SimRequest {
 /**
  * What the Worker receives from the UI
  */
-export type WorkerReceiveMessageType = keyof typeof SimRequest | 'setID';
+export type WorkerReceiveMessageType = keyof typeof SimRequest | 'setID' | 'cancel';
 
 export interface WorkerReceiveMessageBodyBase {
 	id: string;
@@ -27,11 +27,18 @@ export interface WorkerReceiveMessageSetId extends WorkerReceiveMessageBodyBase
 	msg: 'setID';
 }
 
+/**
+ * Stops the async sim with the given id, which then sends its partial results as final.
+ */
+export interface WorkerReceiveMessageCancel extends WorkerReceiveMessageBodyBase {
+	msg: 'cancel';
+}
+
 export interface WorkerReceiveMessageSimRequest extends Required<WorkerReceiveMessageBodyBase> {
 	msg: SimRequest;
 }
 
-export type WorkerReceiveMessage = WorkerReceiveMessageSetId | WorkerReceiveMessageSimRequest;
+export type WorkerReceiveMessage = WorkerReceiveMessageSetId | WorkerReceiveMessageCancel | WorkerReceiveMessageSimRequest;
 
 /**
  * What the Worker sends to the UI
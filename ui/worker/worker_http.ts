@@ -21,8 +21,12 @@ export const setupHttpWorker = (baseURL: string) => {
 		return new Uint8Array(ab);
 	};
 
-	const asyncHandler: HandlerFunction = async (inputData, progress, msg) => {
-		const asyncApiResult = await syncHandler(inputData, noop, msg);
+	// The async API results of running sims by task id, which identify them to /cancelAsync.
+	const runningSims: Record<string, Uint8Array> = {};
+
+	const asyncHandler: HandlerFunction = async (inputData, progress, msg, id) => {
+		const asyncApiResult = await syncHandler(inputData, noop, msg, id);
+		runningSims[id] = asyncApiResult;
 		let outputData = new Uint8Array();
 		while (true) {
 			const progressResponse = await makeHttpApiRequest('asyncProgress', asyncApiResult);
@@ -37,17 +41,27 @@ export const setupHttpWorker = (baseURL: string) => {
 			progress?.(outputData);
 			await sleep(500);
 		}
+		delete runningSims[id];
 		return outputData;
 	};
 
-	new WorkerInterface({
-		bulkSimAsync: asyncHandler,
-		computeStats: syncHandler,
-		computeStatsJson: syncHandler,
-		raidSim: syncHandler,
-		raidSimJson: syncHandler,
-		raidSimAsync: asyncHandler,
-		statWeights: syncHandler,
-		statWeightsAsync: asyncHandler,
-	}).ready();
+	const cancel = (id: string) => {
+		if (runningSims[id]) {
+			makeHttpApiRequest('cancelAsync', runningSims[id]);
+		}
+	};
+
+	new WorkerInterface(
+		{
+			bulkSimAsync: asyncHandler,
+			computeStats: syncHandler,
+			computeStatsJson: syncHandler,
+			raidSim: syncHandler,
+			raidSimJson: syncHandler,
+			raidSimAsync: asyncHandler,
+			statWeights: syncHandler,
+			statWeightsAsync: asyncHandler,
+		},
+		cancel,
+	).ready();
 };
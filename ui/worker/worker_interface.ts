@@ -1,8 +1,9 @@
 import type { SimRequest, WorkerReceiveMessage, WorkerSendMessage } from './types';
 
 export type HandlerProgressCallback = (outputData: Uint8Array) => void;
-export type HandlerFunction = (data: Uint8Array, progress: HandlerProgressCallback, msg: SimRequest) => Uint8Array | Promise<Uint8Array>;
+export type HandlerFunction = (data: Uint8Array, progress: HandlerProgressCallback, msg: SimRequest, id: string) => Uint8Array | Promise<Uint8Array>;
 export type Handlers = Record<SimRequest, HandlerFunction>;
+export type CancelFunction = (id: string) => void;
 
 /**
  * Communication with the UI.
@@ -10,9 +11,11 @@ export type Handlers = Record<SimRequest, HandlerFunction>;
 export class WorkerInterface {
 	private _workerId = '';
 	private readonly handlers: Handlers;
+	private readonly cancel: CancelFunction;
 
-	constructor(handlers: Handlers) {
+	constructor(handlers: Handlers, cancel: CancelFunction) {
 		this.handlers = handlers;
+		this.cancel = cancel;
 
 		addEventListener('message', async ({ data }: MessageEvent<WorkerReceiveMessage>) => {
 			const { id, msg, inputData } = data;
@@ -23,6 +26,11 @@ export class WorkerInterface {
 				return;
 			}
 
+			if (msg === 'cancel') {
+				this.cancel(id);
+				return;
+			}
+
 			const handlerFunc = this.handlers?.[msg];
 
 			if (!handlerFunc) {
@@ -38,7 +46,7 @@ export class WorkerInterface {
 				});
 			};
 
-			const outputData = await handlerFunc(inputData, progressCallback, msg);
+			const outputData = await handlerFunc(inputData, progressCallback, msg, id);
 			this.postMessage({ msg, id, outputData });
 		});
 	}